@@ -3,13 +3,24 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"github.com/patrikcze/terraform-provider-veeam/internal"
+	"github.com/patrikcze/terraform-provider-veeam/internal/export"
 )
 
 func main() {
+	// `terraform-provider-veeam export` generates configuration from a live
+	// server; any other invocation serves the plugin protocol.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Run(context.Background(), os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	if err := providerserver.Serve(context.Background(), internal.New("dev"), providerserver.ServeOpts{
 		Address: "registry.terraform.io/patrikcze/veeam",
 	}); err != nil {
@@ -315,6 +315,26 @@ const (
 	PathReplicaPointByID = "/api/v1/replicaPoints/%s"
 )
 
+// ---------------------------------------------------------------------------
+// Failover Plans
+// ---------------------------------------------------------------------------
+
+const (
+	PathFailoverPlans    = "/api/v1/failoverPlans"
+	PathFailoverPlanByID = "/api/v1/failoverPlans/%s"
+)
+
+// ---------------------------------------------------------------------------
+// SureBackup — Virtual Labs and Application Groups
+// ---------------------------------------------------------------------------
+
+const (
+	PathVirtualLabs          = "/api/v1/virtualLabs"
+	PathVirtualLabByID       = "/api/v1/virtualLabs/%s"
+	PathApplicationGroups    = "/api/v1/applicationGroups"
+	PathApplicationGroupByID = "/api/v1/applicationGroups/%s"
+)
+
 // ---------------------------------------------------------------------------
 // Proxy States
 // ---------------------------------------------------------------------------
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 )
 
 // APIClient defines the interface for interacting with the Veeam V13 REST API.
@@ -26,6 +27,10 @@ type APIClient interface {
 	// sessionID is the ID returned by 202 Accepted responses.
 	// Returns nil on success, error on failure or timeout.
 	WaitForTask(ctx context.Context, sessionID string) error
+
+	// WaitForTaskWithOptions is like WaitForTask with a caller-chosen poll
+	// interval and timeout, for long-running sessions such as job runs.
+	WaitForTaskWithOptions(ctx context.Context, sessionID string, pollInterval, timeout time.Duration) error
 }
 
 // Compile-time check: VeeamClient must satisfy APIClient.
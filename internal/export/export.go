@@ -0,0 +1,409 @@
+// Package export implements the "export" subcommand of the provider binary.
+// It walks a live Veeam Backup & Replication server through the provider's
+// list resources and writes Terraform configuration with matching import
+// blocks, so existing objects can be brought under management in one step.
+package export
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/pkg/resources"
+)
+
+// providerTypeName is the provider type name used to build resource type names.
+const providerTypeName = "veeam"
+
+// fileHeader is written at the top of every generated file.
+const fileHeader = "# Generated by terraform-provider-veeam export. Review before applying.\n\n"
+
+// target pairs a list resource with the managed resource it enumerates.
+type target struct {
+	newList     func() list.ListResource
+	newResource func() resource.Resource
+}
+
+// targets are exported in dependency order: objects referenced by others
+// (credentials, servers, repositories) come first.
+var targets = []target{
+	{resources.NewCredentialListResource, resources.NewCredential},
+	{resources.NewManagedServerListResource, resources.NewManagedServer},
+	{resources.NewRepositoryListResource, resources.NewRepository},
+	{resources.NewProxyListResource, resources.NewProxy},
+	{resources.NewProtectionGroupListResource, resources.NewProtectionGroup},
+	{resources.NewBackupJobListResource, resources.NewBackupJob},
+}
+
+// Exporter renders VBR objects as Terraform configuration.
+type Exporter struct {
+	client client.APIClient
+	// Types restricts the export to these resource types (e.g.
+	// "veeam_backup_job"). Empty exports every supported type.
+	Types []string
+}
+
+// Result holds the generated files keyed by file name.
+type Result struct {
+	Files map[string][]byte
+	// Resources is the number of exported objects.
+	Resources int
+	// Warnings lists objects that were skipped and why.
+	Warnings []string
+}
+
+// object is one exported VBR object.
+type object struct {
+	typeName  string
+	localName string
+	id        string
+	schema    schema.Schema
+	state     tftypes.Value
+}
+
+// New returns an Exporter using c for all API calls.
+func New(c client.APIClient) *Exporter {
+	return &Exporter{client: c}
+}
+
+// SupportedTypes returns the resource types the exporter can generate.
+func SupportedTypes() []string {
+	names := make([]string, 0, len(targets))
+	for _, t := range targets {
+		names = append(names, typeName(t.newResource()))
+	}
+	return names
+}
+
+// Export lists and reads every supported object and renders one file per
+// resource type, plus variables.tf for values the API never returns.
+func (e *Exporter) Export(ctx context.Context) (*Result, error) {
+	for _, t := range e.Types {
+		if !contains(SupportedTypes(), t) {
+			return nil, fmt.Errorf("unsupported resource type %q; supported types: %s", t, strings.Join(SupportedTypes(), ", "))
+		}
+	}
+
+	result := &Result{Files: map[string][]byte{}}
+	var objects []object
+	used := map[string]map[string]bool{}
+
+	for _, t := range targets {
+		r := t.newResource()
+		name := typeName(r)
+		if len(e.Types) > 0 && !contains(e.Types, name) {
+			continue
+		}
+		found, warnings, err := e.listObjects(ctx, t, r)
+		if err != nil {
+			return nil, err
+		}
+		result.Warnings = append(result.Warnings, warnings...)
+
+		if used[name] == nil {
+			used[name] = map[string]bool{}
+		}
+		for i := range found {
+			found[i].localName = uniqueLocalName(used[name], found[i].localName)
+		}
+		objects = append(objects, found...)
+	}
+
+	// Every exported UUID becomes a Terraform reference wherever it appears
+	// in another object's configuration.
+	refs := map[string]hcl.Traversal{}
+	for _, o := range objects {
+		refs[o.id] = hcl.Traversal{
+			hcl.TraverseRoot{Name: o.typeName},
+			hcl.TraverseAttr{Name: o.localName},
+			hcl.TraverseAttr{Name: "id"},
+		}
+	}
+
+	files := map[string]*hclwrite.File{}
+	var order []string
+	var variables []variable
+	for _, o := range objects {
+		f, ok := files[o.typeName]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[o.typeName] = f
+			order = append(order, o.typeName)
+		} else {
+			f.Body().AppendNewline()
+		}
+
+		imp := f.Body().AppendNewBlock("import", nil).Body()
+		imp.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: o.typeName},
+			hcl.TraverseAttr{Name: o.localName},
+		})
+		imp.SetAttributeValue("id", cty.StringVal(o.id))
+		f.Body().AppendNewline()
+
+		rw := &renderer{
+			refs:      refs,
+			selfID:    o.id,
+			varPrefix: strings.TrimPrefix(o.typeName, providerTypeName+"_") + "_" + o.localName,
+		}
+		body := f.Body().AppendNewBlock("resource", []string{o.typeName, o.localName}).Body()
+		if err := rw.writeBody(body, o.schema.Attributes, o.state); err != nil {
+			return nil, fmt.Errorf("failed to render %s.%s: %w", o.typeName, o.localName, err)
+		}
+		variables = append(variables, rw.variables...)
+	}
+
+	for _, name := range order {
+		result.Files[name+".tf"] = append([]byte(fileHeader), hclwrite.Format(files[name].Bytes())...)
+	}
+	if len(variables) > 0 {
+		result.Files["variables.tf"] = renderVariables(variables)
+	}
+	result.Resources = len(objects)
+	return result, nil
+}
+
+// listObjects runs the list resource of t with resource data included.
+// Objects whose read fails are skipped with a warning; a failing list call
+// aborts the export.
+func (e *Exporter) listObjects(ctx context.Context, t target, r resource.Resource) ([]object, []string, error) {
+	name := typeName(r)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	if ir, ok := r.(resource.ResourceWithIdentity); ok {
+		ir.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	}
+
+	lr := t.newList()
+	if lc, ok := lr.(list.ListResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		lc.Configure(ctx, resource.ConfigureRequest{ProviderData: e.client}, &configureResp)
+		if configureResp.Diagnostics.HasError() {
+			return nil, nil, fmt.Errorf("failed to configure %s list: %s", name, diagSummary(configureResp.Diagnostics))
+		}
+	}
+
+	var listSchemaResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+	configType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nullFilters := map[string]tftypes.Value{}
+	for k, attrType := range configType.AttributeTypes {
+		nullFilters[k] = tftypes.NewValue(attrType, nil)
+	}
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchemaResp.Schema, Raw: tftypes.NewValue(configType, nullFilters)},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, req, stream)
+	if stream.Results == nil {
+		return nil, nil, nil
+	}
+
+	var objects []object
+	var warnings []string
+	for res := range stream.Results {
+		if res.Resource == nil {
+			// A result without resource data carries list-level diagnostics.
+			return nil, nil, fmt.Errorf("failed to list %s: %s", name, diagSummary(res.Diagnostics))
+		}
+		if res.Diagnostics.HasError() || res.Resource.Raw.IsNull() {
+			warnings = append(warnings, fmt.Sprintf("skipped %s %q: %s", name, res.DisplayName, diagSummary(res.Diagnostics)))
+			continue
+		}
+		var id string
+		attrs := map[string]tftypes.Value{}
+		if err := res.Resource.Raw.As(&attrs); err != nil {
+			return nil, nil, fmt.Errorf("unexpected %s state: %w", name, err)
+		}
+		if err := attrs["id"].As(&id); err != nil || id == "" {
+			warnings = append(warnings, fmt.Sprintf("skipped %s %q: no id after read", name, res.DisplayName))
+			continue
+		}
+		objects = append(objects, object{
+			typeName:  name,
+			localName: localName(res.DisplayName),
+			id:        id,
+			schema:    schemaResp.Schema,
+			state:     res.Resource.Raw,
+		})
+	}
+	return objects, warnings, nil
+}
+
+// ---------------------------------------------------------------------------
+// Command line
+// ---------------------------------------------------------------------------
+
+// Run implements `terraform-provider-veeam export [flags]`. Connection
+// settings are read from the same environment variables as the provider:
+// VEEAM_HOST, VEEAM_PORT, VEEAM_USERNAME, VEEAM_PASSWORD and VEEAM_INSECURE.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	outDir := fs.String("out", "veeam-export", "Directory to write the generated .tf files to.")
+	typeList := fs.String("types", "", "Comma-separated resource types to export (default: all). Supported: "+
+		strings.Join(SupportedTypes(), ", "))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	c, err := clientFromEnv(ctx)
+	if err != nil {
+		return err
+	}
+
+	e := New(c)
+	if *typeList != "" {
+		for _, t := range strings.Split(*typeList, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				e.Types = append(e.Types, t)
+			}
+		}
+	}
+
+	result, err := e.Export(ctx)
+	if err != nil {
+		return err
+	}
+	if err := result.WriteDir(*outDir); err != nil {
+		return err
+	}
+
+	for _, w := range result.Warnings {
+		fmt.Fprintf(stderr, "warning: %s\n", w)
+	}
+	fmt.Fprintf(stdout, "Exported %d resources to %s\n", result.Resources, *outDir)
+	return nil
+}
+
+// WriteDir writes the generated files into dir, creating it if needed.
+func (r *Result) WriteDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	names := make([]string, 0, len(r.Files))
+	for name := range r.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), r.Files[name], 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// clientFromEnv authenticates against the server configured in the
+// environment, applying the same defaults as the provider.
+func clientFromEnv(ctx context.Context) (client.APIClient, error) {
+	host := os.Getenv("VEEAM_HOST")
+	username := os.Getenv("VEEAM_USERNAME")
+	password := os.Getenv("VEEAM_PASSWORD")
+
+	var missing []string
+	for env, v := range map[string]string{"VEEAM_HOST": host, "VEEAM_USERNAME": username, "VEEAM_PASSWORD": password} {
+		if v == "" {
+			missing = append(missing, env)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required environment variables: %s", strings.Join(missing, ", "))
+	}
+
+	port := 9419
+	if envPort := os.Getenv("VEEAM_PORT"); envPort != "" {
+		p, err := strconv.Atoi(envPort)
+		if err != nil {
+			return nil, fmt.Errorf("invalid VEEAM_PORT %q: %w", envPort, err)
+		}
+		port = p
+	}
+	insecure := os.Getenv("VEEAM_INSECURE") == "true"
+
+	c, err := client.NewVeeamClient(ctx, host, port, username, password, insecure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with Veeam server at %s:%d: %w", host, port, err)
+	}
+	return c, nil
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+func typeName(r resource.Resource) string {
+	var resp resource.MetadataResponse
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+	return resp.TypeName
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// localName turns a VBR display name into a Terraform resource name.
+func localName(displayName string) string {
+	name := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		return "object"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+	return name
+}
+
+// uniqueLocalName returns name, suffixed with _2, _3, ... when already used.
+func uniqueLocalName(used map[string]bool, name string) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[candidate] = true
+	return candidate
+}
+
+func contains(values []string, v string) bool {
+	for _, candidate := range values {
+		if candidate == v {
+			return true
+		}
+	}
+	return false
+}
+
+// diagSummary joins the error diagnostics into one line.
+func diagSummary(diags diag.Diagnostics) string {
+	var parts []string
+	for _, d := range diags.Errors() {
+		parts = append(parts, d.Summary()+": "+d.Detail())
+	}
+	if len(parts) == 0 {
+		return "no details"
+	}
+	return strings.Join(parts, "; ")
+}
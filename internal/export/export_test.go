@@ -0,0 +1,199 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+// fakeVBR serves a small, fixed VBR estate: one credential, one Windows
+// server using it, one repository on that server and one backup job
+// writing to the repository. Unknown paths return 404.
+func fakeVBR(t *testing.T) *httptest.Server {
+	t.Helper()
+	credential := map[string]interface{}{"id": "cred-1", "username": `CORP\svc`, "type": "Standard", "description": "Service account"}
+	server := map[string]interface{}{"id": "srv-1", "name": "win01.corp", "type": "WindowsHost", "description": "Repo host", "credentialsId": "cred-1"}
+	repository := map[string]interface{}{
+		"id": "repo-1", "name": "Repo 01", "type": "WinLocal", "description": "Primary",
+		"hostId":     "srv-1",
+		"repository": map[string]interface{}{"path": `D:\Backups`, "maxTaskCount": 4},
+	}
+	job := map[string]interface{}{
+		"id": "job-1", "name": "Daily-SQL", "type": "VSphereBackup", "description": "SQL VMs",
+		"storage": map[string]interface{}{
+			"backupRepositoryId": "repo-1",
+			"retentionPolicy":    map[string]interface{}{"type": "RestorePoints", "quantity": 7},
+		},
+	}
+	list := func(entries ...map[string]interface{}) map[string]interface{} {
+		data := make([]interface{}, 0, len(entries))
+		for _, e := range entries {
+			data = append(data, e)
+		}
+		return map[string]interface{}{"data": data}
+	}
+	routes := map[string]interface{}{
+		client.PathCredentials:               list(credential),
+		client.PathCredentials + "/cred-1":   credential,
+		client.PathManagedServers:            list(server),
+		client.PathManagedServers + "/srv-1": server,
+		client.PathRepositories:              list(repository),
+		client.PathRepositories + "/repo-1":  repository,
+		client.PathProxies:                   list(),
+		client.PathProtectionGroups:          list(),
+		client.PathJobs:                      list(job, map[string]interface{}{"id": "job-2", "name": "Replica", "type": "VSphereReplica"}),
+		client.PathJobs + "/job-1":           job,
+	}
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/oauth2/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "token", "token_type": "bearer", "refresh_token": "refresh", "expires_in": 900,
+			})
+			return
+		}
+		body, ok := routes[r.URL.Path]
+		if !ok || r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"errorCode": "NotFound", "message": "not found"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+}
+
+func newTestExporter(t *testing.T) *Exporter {
+	t.Helper()
+	srv := fakeVBR(t)
+	t.Cleanup(srv.Close)
+	c, err := client.NewVeeamClientWithHTTPClient(context.Background(), srv.URL, "admin", "secret", srv.Client())
+	require.NoError(t, err)
+	return New(c)
+}
+
+// parseHCL asserts that src is valid HCL and returns it.
+func parseHCL(t *testing.T, name string, src []byte) string {
+	t.Helper()
+	_, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos)
+	require.False(t, diags.HasErrors(), "%s is not valid HCL: %s\n%s", name, diags.Error(), src)
+	return string(src)
+}
+
+func TestExport_GeneratesResourcesImportsAndReferences(t *testing.T) {
+	result, err := newTestExporter(t).Export(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, result.Resources)
+	assert.ElementsMatch(t, []string{
+		"veeam_credential.tf", "veeam_managed_server.tf", "veeam_repository.tf", "veeam_backup_job.tf", "variables.tf",
+	}, keys(result.Files))
+
+	credential := parseHCL(t, "veeam_credential.tf", result.Files["veeam_credential.tf"])
+	assert.Contains(t, credential, `resource "veeam_credential" "corp_svc"`)
+	assert.Contains(t, credential, `to = veeam_credential.corp_svc`)
+	assert.Contains(t, credential, `id = "cred-1"`)
+	assert.Contains(t, credential, `password`)
+	assert.Contains(t, credential, `var.credential_corp_svc_password`)
+
+	server := parseHCL(t, "veeam_managed_server.tf", result.Files["veeam_managed_server.tf"])
+	assert.Contains(t, server, `resource "veeam_managed_server" "win01_corp"`)
+
+	repo := parseHCL(t, "veeam_repository.tf", result.Files["veeam_repository.tf"])
+	assert.Contains(t, repo, `resource "veeam_repository" "repo_01"`)
+	assert.Contains(t, repo, `host_id`)
+	assert.Contains(t, repo, `veeam_managed_server.win01_corp.id`)
+	assert.NotContains(t, repo, `"srv-1"`)
+
+	job := parseHCL(t, "veeam_backup_job.tf", result.Files["veeam_backup_job.tf"])
+	assert.Contains(t, job, `resource "veeam_backup_job" "daily_sql"`)
+	assert.Contains(t, job, `veeam_repository.repo_01.id`)
+	assert.NotContains(t, job, `"repo-1"`)
+	assert.NotContains(t, job, "Replica", "job types the resource cannot manage must be skipped")
+
+	variables := parseHCL(t, "variables.tf", result.Files["variables.tf"])
+	assert.Contains(t, variables, `variable "credential_corp_svc_password"`)
+	assert.Contains(t, variables, `sensitive = true`)
+}
+
+func TestExport_TypesFilter(t *testing.T) {
+	e := newTestExporter(t)
+	e.Types = []string{"veeam_repository"}
+
+	result, err := e.Export(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Resources)
+	assert.Equal(t, []string{"veeam_repository.tf"}, keys(result.Files))
+
+	// The server is not exported, so its ID stays a literal.
+	assert.Contains(t, string(result.Files["veeam_repository.tf"]), `"srv-1"`)
+}
+
+func TestExport_UnsupportedType(t *testing.T) {
+	e := New(nil)
+	e.Types = []string{"veeam_kms_server"}
+
+	_, err := e.Export(context.Background())
+	assert.ErrorContains(t, err, "unsupported resource type")
+}
+
+func TestRun_WritesFiles(t *testing.T) {
+	srv := fakeVBR(t)
+	defer srv.Close()
+
+	t.Setenv("VEEAM_HOST", strings.TrimPrefix(srv.URL, "https://"))
+	t.Setenv("VEEAM_USERNAME", "admin")
+	t.Setenv("VEEAM_PASSWORD", "secret")
+	t.Setenv("VEEAM_INSECURE", "true")
+
+	dir := filepath.Join(t.TempDir(), "out")
+	var stdout, stderr bytes.Buffer
+	err := Run(context.Background(), []string{"-out", dir, "-types", "veeam_credential, veeam_repository"}, &stdout, &stderr)
+	require.NoError(t, err, stderr.String())
+
+	assert.Contains(t, stdout.String(), "Exported 2 resources")
+	for _, name := range []string{"veeam_credential.tf", "veeam_repository.tf", "variables.tf"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestRun_MissingEnvironment(t *testing.T) {
+	t.Setenv("VEEAM_HOST", "")
+	t.Setenv("VEEAM_USERNAME", "")
+	t.Setenv("VEEAM_PASSWORD", "")
+
+	err := Run(context.Background(), nil, &bytes.Buffer{}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "VEEAM_HOST, VEEAM_PASSWORD, VEEAM_USERNAME")
+}
+
+func TestLocalName(t *testing.T) {
+	assert.Equal(t, "corp_svc", localName(`CORP\svc`))
+	assert.Equal(t, "r_01_daily", localName("01 Daily"))
+	assert.Equal(t, "object", localName("***"))
+
+	used := map[string]bool{}
+	assert.Equal(t, "job", uniqueLocalName(used, "job"))
+	assert.Equal(t, "job_2", uniqueLocalName(used, "job"))
+}
+
+func keys(m map[string][]byte) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,225 @@
+package export
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// variable is an input variable generated for a value the API does not
+// return (passwords, secrets) or that must not be written in clear text.
+type variable struct {
+	name      string
+	sensitive bool
+}
+
+// renderer converts one resource state into HCL using the resource schema.
+// Only configurable attributes are written: computed-only attributes and
+// nulls are skipped, sensitive values become variables, and strings equal to
+// the ID of another exported object become references to it.
+type renderer struct {
+	refs      map[string]hcl.Traversal
+	selfID    string
+	varPrefix string
+	variables []variable
+}
+
+// writeBody writes the configurable attributes of val into body, in
+// alphabetical order so output is stable.
+func (rw *renderer) writeBody(body *hclwrite.Body, attrs map[string]schema.Attribute, val tftypes.Value) error {
+	values := map[string]tftypes.Value{}
+	if err := val.As(&values); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(attrs) {
+		tokens, ok, err := rw.attribute(attrs[name], values[name], []string{name})
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			body.SetAttributeRaw(name, tokens)
+		}
+	}
+	return nil
+}
+
+// attribute renders one schema attribute. It reports false when the
+// attribute should be omitted from the configuration.
+func (rw *renderer) attribute(attr schema.Attribute, val tftypes.Value, path []string) (hclwrite.Tokens, bool, error) {
+	if attr.IsComputed() && !attr.IsOptional() && !attr.IsRequired() {
+		return nil, false, nil
+	}
+	secret := attr.IsSensitive() || attr.IsWriteOnly()
+	if !val.IsKnown() || val.IsNull() {
+		// Required values the API never returns (passwords, keys) become
+		// variables; optional ones are left out.
+		if attr.IsRequired() {
+			return rw.variable(path, secret), true, nil
+		}
+		return nil, false, nil
+	}
+	if secret {
+		return rw.variable(path, true), true, nil
+	}
+
+	switch a := attr.(type) {
+	case schema.SingleNestedAttribute:
+		return rw.object(a.Attributes, val, path)
+	case schema.ListNestedAttribute:
+		return rw.objects(a.NestedObject.Attributes, val, path)
+	case schema.SetNestedAttribute:
+		return rw.objects(a.NestedObject.Attributes, val, path)
+	}
+	tokens, err := rw.value(val, path[len(path)-1])
+	return tokens, err == nil, err
+}
+
+func (rw *renderer) object(attrs map[string]schema.Attribute, val tftypes.Value, path []string) (hclwrite.Tokens, bool, error) {
+	values := map[string]tftypes.Value{}
+	if err := val.As(&values); err != nil {
+		return nil, false, err
+	}
+	var items []hclwrite.ObjectAttrTokens
+	for _, name := range sortedKeys(attrs) {
+		tokens, ok, err := rw.attribute(attrs[name], values[name], append(path, name))
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %w", name, err)
+		}
+		if ok {
+			items = append(items, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: tokens})
+		}
+	}
+	if len(items) == 0 {
+		return nil, false, nil
+	}
+	return hclwrite.TokensForObject(items), true, nil
+}
+
+func (rw *renderer) objects(attrs map[string]schema.Attribute, val tftypes.Value, path []string) (hclwrite.Tokens, bool, error) {
+	var elems []tftypes.Value
+	if err := val.As(&elems); err != nil {
+		return nil, false, err
+	}
+	tuple := make([]hclwrite.Tokens, 0, len(elems))
+	for i, elem := range elems {
+		tokens, ok, err := rw.object(attrs, elem, append(path, fmt.Sprint(i)))
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			tokens = hclwrite.TokensForObject(nil)
+		}
+		tuple = append(tuple, tokens)
+	}
+	return hclwrite.TokensForTuple(tuple), true, nil
+}
+
+// value renders a primitive or collection value. name is the attribute the
+// value belongs to; an object's own "id" is never turned into a reference.
+func (rw *renderer) value(val tftypes.Value, name string) (hclwrite.Tokens, error) {
+	typ := val.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := val.As(&s); err != nil {
+			return nil, err
+		}
+		if ref, ok := rw.refs[s]; ok && s != rw.selfID && name != "id" {
+			return hclwrite.TokensForTraversal(ref), nil
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := val.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(n)), nil
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := val.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return nil, err
+		}
+		tuple := make([]hclwrite.Tokens, 0, len(elems))
+		for _, elem := range elems {
+			tokens, err := rw.value(elem, name)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, tokens)
+		}
+		return hclwrite.TokensForTuple(tuple), nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		values := map[string]tftypes.Value{}
+		if err := val.As(&values); err != nil {
+			return nil, err
+		}
+		var items []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(values) {
+			if values[key].IsNull() {
+				continue
+			}
+			tokens, err := rw.value(values[key], key)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(items), nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", typ)
+}
+
+// variable records an input variable for path and returns a reference to it.
+func (rw *renderer) variable(path []string, sensitive bool) hclwrite.Tokens {
+	name := rw.varPrefix + "_" + strings.Join(path, "_")
+	rw.variables = append(rw.variables, variable{name: name, sensitive: sensitive})
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// renderVariables renders variables.tf.
+func renderVariables(variables []variable) []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, v := range variables {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		body := f.Body().AppendNewBlock("variable", []string{v.name}).Body()
+		body.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+		if v.sensitive {
+			body.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+	return append([]byte(fileHeader), hclwrite.Format(f.Bytes())...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -35,3 +36,8 @@ func (m *MockVeeamClient) WaitForTask(ctx context.Context, sessionID string) err
 	args := m.Called(ctx, sessionID)
 	return args.Error(0)
 }
+
+func (m *MockVeeamClient) WaitForTaskWithOptions(ctx context.Context, sessionID string, pollInterval, timeout time.Duration) error {
+	args := m.Called(ctx, sessionID, pollInterval, timeout)
+	return args.Error(0)
+}
@@ -0,0 +1,97 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Agent Backup Policies — V13 REST API: /api/v1/jobs
+//   type="WindowsAgentBackupServerPolicy"       → AgentBackupPolicySpec / AgentBackupPolicyModel
+//   type="LinuxAgentBackupServerPolicy"         → AgentBackupPolicySpec / AgentBackupPolicyModel
+//   type="WindowsAgentBackupWorkstationPolicy"  → AgentBackupPolicySpec / AgentBackupPolicyModel
+//   type="LinuxAgentBackupWorkstationPolicy"    → AgentBackupPolicySpec / AgentBackupPolicyModel
+//
+// Unlike agent jobs managed by the backup server, a policy is pulled by the
+// agents of its protection groups, which then run the backups themselves. The
+// destination may therefore be a backup repository, a local folder of the
+// protected computer or a shared folder.
+//
+// Saving a policy may return a session instead of the job while the backup
+// server applies the policy to the protection groups; callers poll it with
+// WaitForTask.
+// ---------------------------------------------------------------------------
+
+// EAgentBackupPolicyDestinationType selects where agents write their backups.
+type EAgentBackupPolicyDestinationType string
+
+const (
+	AgentBackupPolicyDestinationRepository EAgentBackupPolicyDestinationType = "BackupRepository"
+	AgentBackupPolicyDestinationLocal      EAgentBackupPolicyDestinationType = "LocalStorage"
+	AgentBackupPolicyDestinationShare      EAgentBackupPolicyDestinationType = "SharedFolder"
+)
+
+// AgentBackupPolicySpec is the request body for creating an agent backup policy.
+type AgentBackupPolicySpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// Computers lists the protection groups the policy is applied to.
+	Computers []AgentObjectSpec `json:"computers"`
+	// BackupMode selects the backup scope (EntireComputer, Volumes, or FileLevel).
+	BackupMode EAgentBackupJobMode `json:"backupMode"`
+	// Volumes is used when BackupMode = "Volumes".
+	Volumes *AgentBackupJobVolumesModel `json:"volumes,omitempty"`
+	// Files is used when BackupMode = "FileLevel".
+	Files *AgentBackupJobFilesModel `json:"files,omitempty"`
+	// Destination configures the backup target and retention.
+	Destination *AgentBackupPolicyDestinationModel `json:"destination"`
+	// Schedule configures when agents run the policy.
+	Schedule *AgentBackupPolicyScheduleModel `json:"schedule,omitempty"`
+}
+
+// AgentBackupPolicyModel is the full response/update body for an agent backup policy.
+type AgentBackupPolicyModel struct {
+	JobModel
+	Description string                             `json:"description"`
+	Computers   []AgentObjectSpec                  `json:"computers,omitempty"`
+	BackupMode  EAgentBackupJobMode                `json:"backupMode,omitempty"`
+	Volumes     *AgentBackupJobVolumesModel        `json:"volumes,omitempty"`
+	Files       *AgentBackupJobFilesModel          `json:"files,omitempty"`
+	Destination *AgentBackupPolicyDestinationModel `json:"destination,omitempty"`
+	Schedule    *AgentBackupPolicyScheduleModel    `json:"schedule,omitempty"`
+}
+
+// AgentBackupPolicyDestinationModel is the backup target of a policy. Only the
+// field matching Type is set.
+type AgentBackupPolicyDestinationModel struct {
+	Type EAgentBackupPolicyDestinationType `json:"type"`
+	// BackupRepositoryID is the UUID of the target repository (BackupRepository).
+	BackupRepositoryID string `json:"backupRepositoryId,omitempty"`
+	// LocalPath is a folder on the protected computer (LocalStorage).
+	LocalPath string `json:"localPath,omitempty"`
+	// SharedFolder is an SMB or NFS share (SharedFolder).
+	SharedFolder *AgentBackupPolicySharedFolderModel `json:"sharedFolder,omitempty"`
+	// RetentionPolicy controls how many restore points or days of backups are kept.
+	RetentionPolicy *BackupJobRetentionPolicySettings `json:"retentionPolicy,omitempty"`
+}
+
+// AgentBackupPolicySharedFolderModel is a network share used as policy destination.
+type AgentBackupPolicySharedFolderModel struct {
+	// Path is the UNC or NFS path of the share.
+	Path string `json:"path"`
+	// CredentialsID is the UUID of the credentials used to access the share.
+	CredentialsID string `json:"credentialsId,omitempty"`
+}
+
+// AgentBackupPolicyScheduleModel extends the job schedule with the event
+// triggers of workstation policies.
+type AgentBackupPolicyScheduleModel struct {
+	BackupScheduleModel
+	// AtLogOff starts a backup when the user logs off.
+	AtLogOff *AgentBackupPolicyTriggerModel `json:"atLogOff,omitempty"`
+	// AtLock starts a backup when the user locks the workstation.
+	AtLock *AgentBackupPolicyTriggerModel `json:"atLock,omitempty"`
+	// AtTargetConnection starts a backup when the backup target becomes available.
+	AtTargetConnection *AgentBackupPolicyTriggerModel `json:"atTargetConnection,omitempty"`
+}
+
+// AgentBackupPolicyTriggerModel enables one event trigger.
+type AgentBackupPolicyTriggerModel struct {
+	IsEnabled bool `json:"isEnabled"`
+}
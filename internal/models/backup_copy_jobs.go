@@ -0,0 +1,95 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Backup Copy Jobs — V13 REST API: /api/v1/jobs (type="BackupCopy")
+//
+// Backup copy jobs share the polymorphic /api/v1/jobs endpoint with backup
+// jobs, so create / read / update / delete and the enable/disable endpoints
+// behave exactly as described in jobs.go.
+//
+// A copy job runs in one of two modes, fixed at creation:
+//   Immediate → copies every new restore point as soon as it appears; the
+//               backup window restricts when copying may run.
+//   Periodic  → copies the latest restore point on a daily or interval
+//               schedule.
+// ---------------------------------------------------------------------------
+
+// EBackupCopyJobMode selects how a backup copy job picks up restore points.
+type EBackupCopyJobMode string
+
+const (
+	BackupCopyJobModeImmediate EBackupCopyJobMode = "Immediate"
+	BackupCopyJobModePeriodic  EBackupCopyJobMode = "Periodic"
+)
+
+// BackupCopyJobSpec is the request body for creating a BackupCopy job.
+// API discriminator mapping: type="BackupCopy" → BackupCopyJobSpec.
+type BackupCopyJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// Mode is Immediate or Periodic and cannot be changed after creation.
+	Mode EBackupCopyJobMode `json:"mode"`
+	// SourceObjects selects the backup jobs or repositories to copy from.
+	SourceObjects *BackupCopyJobSourceObjectsModel `json:"sourceObjects"`
+	// Storage configures the target repository, retention and encryption.
+	Storage *BackupCopyJobStorageModel `json:"storage"`
+	// DataTransfer configures direct or WAN-accelerated transfer.
+	DataTransfer *BackupCopyJobDataTransferModel `json:"dataTransfer,omitempty"`
+	// Schedule configures the periodic schedule or the copy window.
+	Schedule *BackupCopyJobScheduleModel `json:"schedule,omitempty"`
+}
+
+// BackupCopyJobModel is the full response/update body for a BackupCopy job.
+type BackupCopyJobModel struct {
+	JobModel
+	Description   string                           `json:"description"`
+	Mode          EBackupCopyJobMode               `json:"mode"`
+	SourceObjects *BackupCopyJobSourceObjectsModel `json:"sourceObjects,omitempty"`
+	Storage       *BackupCopyJobStorageModel       `json:"storage,omitempty"`
+	DataTransfer  *BackupCopyJobDataTransferModel  `json:"dataTransfer,omitempty"`
+	Schedule      *BackupCopyJobScheduleModel      `json:"schedule,omitempty"`
+}
+
+// BackupCopyJobSourceObjectsModel selects what the job copies. Exactly one
+// of JobIDs or RepositoryIDs is set.
+type BackupCopyJobSourceObjectsModel struct {
+	// JobIDs lists the backup jobs whose restore points are copied.
+	JobIDs []string `json:"jobIds,omitempty"`
+	// RepositoryIDs lists repositories whose backups are all copied.
+	RepositoryIDs []string `json:"repositoryIds,omitempty"`
+}
+
+// BackupCopyJobStorageModel configures the copy target.
+type BackupCopyJobStorageModel struct {
+	// TargetRepositoryID is the UUID of the target repository or SOBR.
+	TargetRepositoryID string `json:"targetRepositoryId"`
+	// RetentionPolicy controls how many restore points or days are kept.
+	RetentionPolicy *BackupJobRetentionPolicySettings `json:"retentionPolicy,omitempty"`
+	// GFSPolicy optionally configures long-term retention of the copies.
+	GFSPolicy *GFSPolicySettingsModel `json:"gfsPolicy,omitempty"`
+	// Encryption encrypts the backup copy files.
+	Encryption *BackupStorageEncryptionModel `json:"encryption,omitempty"`
+}
+
+// BackupCopyJobDataTransferModel configures how data reaches the target.
+type BackupCopyJobDataTransferModel struct {
+	// UseWanAccelerators sends data through a WAN accelerator pair; when
+	// false, data is transferred directly.
+	UseWanAccelerators bool `json:"useWanAccelerators"`
+	// SourceWANAcceleratorID is the WAN accelerator at the source site.
+	SourceWANAcceleratorID string `json:"sourceWANAcceleratorId,omitempty"`
+	// TargetWANAcceleratorID is the WAN accelerator at the target site.
+	TargetWANAcceleratorID string `json:"targetWANAcceleratorId,omitempty"`
+}
+
+// BackupCopyJobScheduleModel configures when the copy job runs. Periodic
+// jobs use Daily or Periodically; Immediate jobs only use BackupWindow.
+type BackupCopyJobScheduleModel struct {
+	// Daily runs the periodic copy at a fixed time.
+	Daily *ScheduleDailyModel `json:"daily,omitempty"`
+	// Periodically runs the periodic copy every N hours or minutes.
+	Periodically *SchedulePeriodicallyModel `json:"periodically,omitempty"`
+	// BackupWindow restricts the hours in which data may be copied.
+	BackupWindow *ScheduleBackupWindowModel `json:"backupWindow,omitempty"`
+}
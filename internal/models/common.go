@@ -144,6 +144,7 @@ const (
 	JobTypeFileBackup                          EJobType = "FileBackup"
 	JobTypeObjectStorageBackup                 EJobType = "ObjectStorageBackup"
 	JobTypeEntraIDTenantBackupCopy             EJobType = "EntraIDTenantBackupCopy"
+	JobTypeSureBackup                          EJobType = "SureBackup"
 	JobTypeSureBackupContentScan               EJobType = "SureBackupContentScan"
 	JobTypeWindowsAgentBackupWorkstationPolicy EJobType = "WindowsAgentBackupWorkstationPolicy"
 	JobTypeLinuxAgentBackupWorkstationPolicy   EJobType = "LinuxAgentBackupWorkstationPolicy"
@@ -239,6 +240,20 @@ const (
 	SennightLast   ESennightOfMonth = "Last"
 )
 
+// EDayNumberInMonth selects the week of the month for monthly advanced
+// storage schedules (synthetic/active full, health check, compact).
+type EDayNumberInMonth string
+
+const (
+	DayNumberFirst  EDayNumberInMonth = "First"
+	DayNumberSecond EDayNumberInMonth = "Second"
+	DayNumberThird  EDayNumberInMonth = "Third"
+	DayNumberFourth EDayNumberInMonth = "Fourth"
+	DayNumberLast   EDayNumberInMonth = "Last"
+	// DayNumberOnDay runs on a fixed day of the month (DayOfMonths).
+	DayNumberOnDay EDayNumberInMonth = "OnDay"
+)
+
 // EPeriodicallyKinds defines time units for periodic job scheduling.
 type EPeriodicallyKinds string
 
@@ -308,6 +323,31 @@ const (
 	VmwareTypeTag              EVmwareInventoryType = "Tag"
 	VmwareTypeCategory         EVmwareInventoryType = "Category"
 	VmwareTypeVirtualApp       EVmwareInventoryType = "VirtualApp"
+	VmwareTypeNetwork          EVmwareInventoryType = "Network"
+)
+
+// EHyperVInventoryType is the type of a Microsoft Hyper-V inventory object.
+type EHyperVInventoryType string
+
+const (
+	HyperVTypeUnknown        EHyperVInventoryType = "Unknown"
+	HyperVTypeVirtualMachine EHyperVInventoryType = "VirtualMachine"
+	HyperVTypeHost           EHyperVInventoryType = "Host"
+	HyperVTypeCluster        EHyperVInventoryType = "Cluster"
+	HyperVTypeSCVMM          EHyperVInventoryType = "SCVMM"
+	HyperVTypeHostGroup      EHyperVInventoryType = "HostGroup"
+	HyperVTypeTag            EHyperVInventoryType = "Tag"
+)
+
+// ECloudDirectorInventoryType is the type of a VMware Cloud Director inventory object.
+type ECloudDirectorInventoryType string
+
+const (
+	CloudDirectorTypeServer          ECloudDirectorInventoryType = "CloudDirectorServer"
+	CloudDirectorTypeOrganization    ECloudDirectorInventoryType = "Organization"
+	CloudDirectorTypeOrganizationVDC ECloudDirectorInventoryType = "OrganizationVDC"
+	CloudDirectorTypeVApp            ECloudDirectorInventoryType = "vApp"
+	CloudDirectorTypeVirtualMachine  ECloudDirectorInventoryType = "VirtualMachine"
 )
 
 // EAgentInventoryObjectType is the type of an agent-managed inventory object.
@@ -0,0 +1,87 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Entra ID Jobs — V13 REST API: /api/v1/jobs
+//   type="EntraIDTenantBackup"    → EntraIDTenantBackupJobSpec / EntraIDTenantBackupJobModel
+//   type="EntraIDAuditLogBackup"  → EntraIDAuditLogBackupJobSpec / EntraIDAuditLogBackupJobModel
+//
+// Both job types protect a tenant registered at
+// /api/v1/inventory/entraId/tenants (entra_id.go), referenced by its Veeam
+// UUID. Tenant backups are kept in the backup server database, so they have
+// a retention policy but no repository. Audit log backups are written to a
+// backup repository.
+//
+// Retention uses the days / months / years model of unstructured data jobs
+// (file_backup_jobs.go).
+// ---------------------------------------------------------------------------
+
+// EEntraIDObjectType is a class of tenant objects protected by a tenant backup.
+type EEntraIDObjectType string
+
+const (
+	EntraIDObjectUsers                     EEntraIDObjectType = "Users"
+	EntraIDObjectGroups                    EEntraIDObjectType = "Groups"
+	EntraIDObjectAdministrativeUnits       EEntraIDObjectType = "AdministrativeUnits"
+	EntraIDObjectApplications              EEntraIDObjectType = "Applications"
+	EntraIDObjectServicePrincipals         EEntraIDObjectType = "ServicePrincipals"
+	EntraIDObjectDirectoryRoles            EEntraIDObjectType = "DirectoryRoles"
+	EntraIDObjectConditionalAccessPolicies EEntraIDObjectType = "ConditionalAccessPolicies"
+)
+
+// EntraIDTenantBackupJobSpec is the request body for creating an
+// EntraIDTenantBackup job.
+type EntraIDTenantBackupJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// TenantID is the Veeam UUID of the registered tenant.
+	TenantID string `json:"tenantId"`
+	// Objects selects the protected object types.
+	Objects *EntraIDTenantBackupJobObjectsModel `json:"objects"`
+	// RetentionPolicy controls how long tenant restore points are kept.
+	RetentionPolicy *UnstructuredRetentionPolicyModel `json:"retentionPolicy"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// EntraIDTenantBackupJobModel is the full response/update body for an
+// EntraIDTenantBackup job.
+type EntraIDTenantBackupJobModel struct {
+	JobModel
+	Description     string                              `json:"description"`
+	TenantID        string                              `json:"tenantId"`
+	Objects         *EntraIDTenantBackupJobObjectsModel `json:"objects,omitempty"`
+	RetentionPolicy *UnstructuredRetentionPolicyModel   `json:"retentionPolicy,omitempty"`
+	Schedule        *BackupScheduleModel                `json:"schedule,omitempty"`
+}
+
+// EntraIDTenantBackupJobObjectsModel selects the object types of a tenant
+// backup. IsEntireTenant protects every supported type.
+type EntraIDTenantBackupJobObjectsModel struct {
+	IsEntireTenant bool                 `json:"isEntireTenant"`
+	ObjectTypes    []EEntraIDObjectType `json:"objectTypes,omitempty"`
+}
+
+// EntraIDAuditLogBackupJobSpec is the request body for creating an
+// EntraIDAuditLogBackup job.
+type EntraIDAuditLogBackupJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// TenantID is the Veeam UUID of the registered tenant.
+	TenantID string `json:"tenantId"`
+	// Storage configures the target repository and retention.
+	Storage *UnstructuredBackupStorageModel `json:"storage"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// EntraIDAuditLogBackupJobModel is the full response/update body for an
+// EntraIDAuditLogBackup job.
+type EntraIDAuditLogBackupJobModel struct {
+	JobModel
+	Description string                          `json:"description"`
+	TenantID    string                          `json:"tenantId"`
+	Storage     *UnstructuredBackupStorageModel `json:"storage,omitempty"`
+	Schedule    *BackupScheduleModel            `json:"schedule,omitempty"`
+}
@@ -0,0 +1,58 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Failover Plans — V13 REST API: /api/v1/failoverPlans
+//
+// A failover plan groups replica VMs that are failed over together. The VMs
+// start one after another in boot order; each VM waits for its boot delay
+// before the next one starts. Optional scripts on the backup server run
+// before and after the failover.
+//
+// CRUD is synchronous: POST returns the created plan, PUT the updated plan,
+// DELETE 204. Running a failover is not part of plan management.
+// ---------------------------------------------------------------------------
+
+// EFailoverPlanType is the platform of the replicas in a failover plan.
+type EFailoverPlanType string
+
+const (
+	FailoverPlanTypeVSphere EFailoverPlanType = "VSphere"
+	FailoverPlanTypeHyperV  EFailoverPlanType = "HyperV"
+)
+
+// FailoverPlanSpec is the request body for creating or updating a failover plan.
+type FailoverPlanSpec struct {
+	Name               string                   `json:"name"`
+	Description        string                   `json:"description"`
+	Type               EFailoverPlanType        `json:"type"`
+	VirtualMachines    []FailoverPlanVMModel    `json:"virtualMachines"`
+	PreFailoverScript  *FailoverPlanScriptModel `json:"preFailoverScript,omitempty"`
+	PostFailoverScript *FailoverPlanScriptModel `json:"postFailoverScript,omitempty"`
+}
+
+// FailoverPlanModel is the API response body for a failover plan.
+type FailoverPlanModel struct {
+	ID                 string                   `json:"id"`
+	Name               string                   `json:"name"`
+	Description        string                   `json:"description"`
+	Type               EFailoverPlanType        `json:"type"`
+	VirtualMachines    []FailoverPlanVMModel    `json:"virtualMachines"`
+	PreFailoverScript  *FailoverPlanScriptModel `json:"preFailoverScript,omitempty"`
+	PostFailoverScript *FailoverPlanScriptModel `json:"postFailoverScript,omitempty"`
+}
+
+// FailoverPlanVMModel is one replica VM of a failover plan.
+type FailoverPlanVMModel struct {
+	// ReplicaID is the UUID of the replica (see /api/v1/replicas).
+	ReplicaID string `json:"replicaId"`
+	// BootOrder is the 1-based position in the start sequence.
+	BootOrder int `json:"bootOrder"`
+	// BootDelaySec is the wait after this VM starts before the next one.
+	BootDelaySec int `json:"bootDelaySec"`
+}
+
+// FailoverPlanScriptModel is a script run on the backup server.
+type FailoverPlanScriptModel struct {
+	IsEnabled  bool   `json:"isEnabled"`
+	ScriptPath string `json:"scriptPath,omitempty"`
+}
@@ -0,0 +1,105 @@
+package models
+
+// ---------------------------------------------------------------------------
+// File Backup Jobs — V13 REST API: /api/v1/jobs (type="FileBackup")
+//
+// File backup jobs protect shares and paths of unstructured data servers
+// (/api/v1/inventory/unstructuredDataServers). They share the polymorphic
+// /api/v1/jobs endpoint with backup jobs, so create / read / update / delete
+// and the enable/disable endpoints behave exactly as described in jobs.go.
+//
+// Retention is two-tier:
+//   short-term  all file versions are kept in the backup repository for the
+//               configured period;
+//   long-term   older versions are moved to an optional archive repository
+//               and kept there for a longer period.
+//
+// A linked FileBackupCopy job is created by the server when a backup copy
+// target is set; it is managed through the parent file backup job.
+//
+// The storage, retention and archive models are shared with object storage
+// backup jobs (object_storage_backup_jobs.go).
+// ---------------------------------------------------------------------------
+
+// EUnstructuredRetentionType is the unit of unstructured data retention.
+type EUnstructuredRetentionType string
+
+const (
+	UnstructuredRetentionDays   EUnstructuredRetentionType = "Days"
+	UnstructuredRetentionMonths EUnstructuredRetentionType = "Months"
+	UnstructuredRetentionYears  EUnstructuredRetentionType = "Years"
+)
+
+// FileBackupJobSpec is the request body for creating a FileBackup job.
+// API discriminator mapping: type="FileBackup" → FileBackupJobSpec.
+type FileBackupJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// Objects lists the shares and paths to protect.
+	Objects []FileBackupJobObjectModel `json:"objects"`
+	// Storage configures the backup repository and short-term retention.
+	Storage *UnstructuredBackupStorageModel `json:"storage"`
+	// Archive configures long-term retention in an archive repository.
+	Archive *UnstructuredBackupArchiveModel `json:"archive,omitempty"`
+	// BackupCopy configures the linked FileBackupCopy job.
+	BackupCopy *FileBackupJobCopyModel `json:"backupCopy,omitempty"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// FileBackupJobModel is the full response/update body for a FileBackup job.
+type FileBackupJobModel struct {
+	JobModel
+	Description string                          `json:"description"`
+	Objects     []FileBackupJobObjectModel      `json:"objects,omitempty"`
+	Storage     *UnstructuredBackupStorageModel `json:"storage,omitempty"`
+	Archive     *UnstructuredBackupArchiveModel `json:"archive,omitempty"`
+	BackupCopy  *FileBackupJobCopyModel         `json:"backupCopy,omitempty"`
+	Schedule    *BackupScheduleModel            `json:"schedule,omitempty"`
+}
+
+// FileBackupJobObjectModel is one protected share or path.
+type FileBackupJobObjectModel struct {
+	// FileServerID is the UUID of the unstructured data server.
+	FileServerID string `json:"fileServerId"`
+	// Path is the share or folder to protect; empty protects the whole server.
+	Path string `json:"path,omitempty"`
+	// InclusionMask limits the backup to files matching these masks.
+	InclusionMask []string `json:"inclusionMask,omitempty"`
+	// ExclusionMask skips files matching these masks.
+	ExclusionMask []string `json:"exclusionMask,omitempty"`
+}
+
+// UnstructuredBackupStorageModel is the primary target of an unstructured
+// data backup job.
+type UnstructuredBackupStorageModel struct {
+	BackupRepositoryID string                            `json:"backupRepositoryId"`
+	RetentionPolicy    *UnstructuredRetentionPolicyModel `json:"retentionPolicy"`
+}
+
+// UnstructuredRetentionPolicyModel keeps file or object versions for
+// Quantity days, months or years.
+type UnstructuredRetentionPolicyModel struct {
+	Type     EUnstructuredRetentionType `json:"type"`
+	Quantity int                        `json:"quantity"`
+}
+
+// UnstructuredBackupArchiveModel configures long-term retention. Versions
+// older than the short-term retention are moved to ArchiveRepositoryID.
+type UnstructuredBackupArchiveModel struct {
+	IsEnabled           bool                              `json:"isEnabled"`
+	ArchiveRepositoryID string                            `json:"archiveRepositoryId,omitempty"`
+	RetentionPolicy     *UnstructuredRetentionPolicyModel `json:"retentionPolicy,omitempty"`
+	// InclusionMask limits archiving to files or objects matching these masks.
+	InclusionMask []string `json:"inclusionMask,omitempty"`
+	// ExclusionMask skips archiving of files or objects matching these masks.
+	ExclusionMask []string `json:"exclusionMask,omitempty"`
+}
+
+// FileBackupJobCopyModel configures the linked FileBackupCopy job that
+// copies the backups to a second repository.
+type FileBackupJobCopyModel struct {
+	IsEnabled    bool   `json:"isEnabled"`
+	RepositoryID string `json:"repositoryId,omitempty"`
+}
@@ -9,22 +9,31 @@ package models
 // Supported job types (EJobType):
 //   VSphereBackup                      → BackupJobSpec / BackupJobModel
 //   HyperVBackup                       → HyperVBackupJobSpec / HyperVBackupJobModel
-//   BackupCopy                         → BackupCopyJobSpec / BackupCopyJobModel
+//   CloudDirectorBackup                → BackupJobSpec / BackupJobModel (platform "CloudDirector" objects)
+//   BackupCopy                         → BackupCopyJobSpec / BackupCopyJobModel (backup_copy_jobs.go)
 //   WindowsAgentBackup                 → WindowsAgentBackupJobSpec / WindowsAgentBackupJobModel
 //   LinuxAgentBackup                   → LinuxAgentBackupJobSpec / LinuxAgentBackupJobModel
-//   WindowsAgentBackupServerPolicy     → (uses WindowsAgentBackupJobSpec with server policy dest.)
-//   LinuxAgentBackupServerPolicy       → (uses LinuxAgentBackupJobSpec with server policy dest.)
-//   VSphereReplica                     → (not yet implemented in Terraform resource)
+//   WindowsAgentBackupServerPolicy     → AgentBackupPolicySpec / AgentBackupPolicyModel (agent_backup_policies.go)
+//   LinuxAgentBackupServerPolicy       → AgentBackupPolicySpec / AgentBackupPolicyModel (agent_backup_policies.go)
+//   WindowsAgentBackupWorkstationPolicy → AgentBackupPolicySpec / AgentBackupPolicyModel (agent_backup_policies.go)
+//   LinuxAgentBackupWorkstationPolicy  → AgentBackupPolicySpec / AgentBackupPolicyModel (agent_backup_policies.go)
+//   VSphereReplica                     → ReplicaJobSpec / ReplicaJobModel (replication_jobs.go)
+//   FileBackup                         → FileBackupJobSpec / FileBackupJobModel (file_backup_jobs.go)
+//   ObjectStorageBackup                → ObjectStorageBackupJobSpec / ObjectStorageBackupJobModel (object_storage_backup_jobs.go)
+//   EntraIDTenantBackup                → EntraIDTenantBackupJobSpec / EntraIDTenantBackupJobModel (entra_id_jobs.go)
+//   EntraIDAuditLogBackup              → EntraIDAuditLogBackupJobSpec / EntraIDAuditLogBackupJobModel (entra_id_jobs.go)
+//   SureBackup                         → SureBackupJobSpec / SureBackupJobModel (surebackup.go)
 //
-// CRUD behaviour (all synchronous — no async polling needed for job management):
+// CRUD behaviour (synchronous, except that saving an agent backup policy may
+// return a session — see agent_backup_policies.go):
 //   POST   /api/v1/jobs          → 201 Created, body: JobModel  (create)
 //   GET    /api/v1/jobs/{id}     → 200 OK,      body: JobModel  (read)
 //   PUT    /api/v1/jobs/{id}     → 200 OK,      body: JobModel  (update; sends full JobModel)
 //   DELETE /api/v1/jobs/{id}     → 204 No Content                (delete)
 //
 // Async job control endpoints (start/stop/retry/clone) return SessionModel and
-// are intentionally NOT managed by the Terraform resource — use them via the
-// Veeam console or separate automation.
+// are not called by the backup job resource itself; job starts are driven by
+// the separate veeam_job_run resource.
 // ---------------------------------------------------------------------------
 
 // ---------------------------------------------------------------------------
@@ -51,15 +60,36 @@ type JobSpec struct {
 // Inventory Object References
 //
 // Veeam uses a polymorphic InventoryObjectModel discriminated by "platform":
-//   VSphere  → VmwareObjectSpec  (hostName, name, type, objectId)
-//   HyperV   → (HyperV variant, reserved for future use)
-//   Agent    → AgentObjectSpec   (id, name, type, protectionGroupId)
+//   VSphere       → VmwareObjectModel        (hostName, name, type, objectId)
+//   HyperV        → HyperVObjectModel        (same shape, EHyperVInventoryType)
+//   CloudDirector → CloudDirectorObjectModel (same shape, ECloudDirectorInventoryType)
+//   Agent         → AgentObjectSpec          (id, name, type, protectionGroupId)
 //
-// In Go, we represent these as concrete structs that marshal to the correct
-// JSON shape so the server can route them to the right sub-handler.
+// The three hypervisor variants share one JSON shape and differ only in the
+// values allowed for type. Backup jobs accept all three and use
+// InventoryObjectSpec; resources that only handle vSphere objects (replicas,
+// virtual labs) use VmwareObjectSpec.
 // ---------------------------------------------------------------------------
 
-// VmwareObjectSpec is a VMware vSphere inventory object used in job includes/excludes.
+// InventoryObjectSpec is a vSphere, Hyper-V or Cloud Director inventory object
+// used in backup job includes, excludes and per-object guest settings.
+type InventoryObjectSpec struct {
+	// Platform is "VSphere", "HyperV" or "CloudDirector" — required discriminator for the API.
+	Platform string `json:"platform"`
+	// HostName is the server that owns this object: a vCenter Server or ESXi
+	// host, an SCVMM server, Hyper-V cluster or host, or a Cloud Director server.
+	HostName string `json:"hostName"`
+	// Name is the display name of the object (VM name, folder name, etc.).
+	Name string `json:"name"`
+	// Type identifies the object class. It holds an EVmwareInventoryType,
+	// EHyperVInventoryType or ECloudDirectorInventoryType value, depending on
+	// Platform.
+	Type string `json:"type,omitempty"`
+	// ObjectID is the platform object ID (e.g. vSphere MoRef "vm-101").
+	ObjectID string `json:"objectId,omitempty"`
+}
+
+// VmwareObjectSpec is a VMware vSphere inventory object.
 // Corresponds to API schema VmwareObjectModel with platform="VSphere".
 type VmwareObjectSpec struct {
 	// Platform must be "VSphere" — required discriminator for the API.
@@ -121,7 +151,7 @@ type BackupJobSpec struct {
 type BackupJobModel struct {
 	JobModel
 	Description     string                         `json:"description,omitempty"`
-	IsHighPriority  bool                           `json:"isHighPriority,omitempty"`
+	IsHighPriority  bool                           `json:"isHighPriority"`
 	VirtualMachines *BackupJobVirtualMachinesModel `json:"virtualMachines,omitempty"`
 	Storage         *BackupJobStorageModel         `json:"storage,omitempty"`
 	GuestProcessing *BackupJobGuestProcessingModel `json:"guestProcessing,omitempty"`
@@ -136,21 +166,21 @@ type BackupJobModel struct {
 // Required: includes (at least one entry).
 type BackupJobVirtualMachinesSpec struct {
 	// Includes is the list of VMs or containers to protect. At least one entry required.
-	Includes []VmwareObjectSpec `json:"includes"`
+	Includes []InventoryObjectSpec `json:"includes"`
 	// Excludes optionally removes specific VMs or disks from the backup scope.
 	Excludes *BackupJobExclusionsSpec `json:"excludes,omitempty"`
 }
 
 // BackupJobVirtualMachinesModel is the VM scope returned in GET/PUT responses.
 type BackupJobVirtualMachinesModel struct {
-	Includes []VmwareObjectSpec   `json:"includes"`
-	Excludes *BackupJobExclusions `json:"excludes,omitempty"`
+	Includes []InventoryObjectSpec `json:"includes"`
+	Excludes *BackupJobExclusions  `json:"excludes,omitempty"`
 }
 
 // BackupJobExclusionsSpec defines objects to exclude from backup (request body).
 type BackupJobExclusionsSpec struct {
 	// VMs lists individual VMs to exclude from the backup scope.
-	VMs []VmwareObjectSpec `json:"vms,omitempty"`
+	VMs []InventoryObjectSpec `json:"vms,omitempty"`
 	// Disks lists specific VM disks to exclude (with per-disk selection mode).
 	Disks []VmwareObjectDiskExclusion `json:"disks,omitempty"`
 	// Templates configures template VM exclusion behaviour.
@@ -159,7 +189,7 @@ type BackupJobExclusionsSpec struct {
 
 // BackupJobExclusions is the response model for job exclusions.
 type BackupJobExclusions struct {
-	VMs       []VmwareObjectSpec            `json:"vms,omitempty"`
+	VMs       []InventoryObjectSpec         `json:"vms,omitempty"`
 	Disks     []VmwareObjectDiskExclusion   `json:"disks,omitempty"`
 	Templates *BackupJobExclusionsTemplates `json:"templates,omitempty"`
 }
@@ -167,16 +197,16 @@ type BackupJobExclusions struct {
 // BackupJobExclusionsTemplates configures VM template exclusion within a job.
 type BackupJobExclusionsTemplates struct {
 	// IsEnabled excludes ALL VM templates from the job when true.
-	IsEnabled bool `json:"isEnabled,omitempty"`
+	IsEnabled bool `json:"isEnabled"`
 	// ExcludeFromIncremental excludes templates from incremental backup passes only.
-	ExcludeFromIncremental bool `json:"excludeFromIncremental,omitempty"`
+	ExcludeFromIncremental bool `json:"excludeFromIncremental"`
 }
 
 // VmwareObjectDiskExclusion excludes specific disks from a VM backup.
 // Corresponds to API schema VmwareObjectDiskModel.
 type VmwareObjectDiskExclusion struct {
 	// VMObject identifies the VM whose disks are being excluded.
-	VMObject VmwareObjectSpec `json:"vmObject"`
+	VMObject InventoryObjectSpec `json:"vmObject"`
 	// DisksToProcess controls whether all, system-only, or selected disks are used.
 	DisksToProcess EVmwareDisksTypeToProcess `json:"disksToProcess"`
 	// Disks lists specific disk IDs when DisksToProcess = "SelectedDisks".
@@ -284,24 +314,76 @@ type GFSPolicySettingsYearlyModel struct {
 // Corresponds to API schema BackupJobAdvancedSettingsModel.
 type BackupJobAdvancedSettingsModel struct {
 	// BackupModeType controls how restore points are created (Incremental, Full, etc.).
-	BackupModeType EBackupModeType `json:"backupModeType"`
+	// Omitted when empty so that a partial update keeps the server value.
+	BackupModeType EBackupModeType `json:"backupModeType,omitempty"`
+	// SyntheticFulls schedules synthetic full backups. The field name matches
+	// the (misspelt) property of the VBR REST API.
+	SyntheticFulls *AdvancedStorageScheduleModel `json:"synthenticFulls,omitempty"`
+	// ActiveFulls schedules active full backups.
+	ActiveFulls *AdvancedStorageScheduleModel `json:"activeFulls,omitempty"`
+	// BackupHealth schedules storage-level corruption guard checks.
+	BackupHealth *AdvancedStorageScheduleModel `json:"backupHealth,omitempty"`
+	// FullBackupMaintenance configures defragmentation and compaction of full backups.
+	FullBackupMaintenance *FullBackupMaintenanceModel `json:"fullBackupMaintenance,omitempty"`
 	// StorageData configures compression, dedup, and encryption.
 	StorageData *BackupStorageSettingModel `json:"storageData,omitempty"`
 	// Notifications configures SNMP and email alerts for the job.
 	Notifications *NotificationSettingsModel `json:"notifications,omitempty"`
 	// VSphere provides vSphere-specific settings (CBT, VMware Tools quiescence).
 	VSphere *BackupJobAdvancedSettingsVSphereModel `json:"vSphere,omitempty"`
+	// HyperV provides Hyper-V-specific settings (CBT, guest quiescence, snapshots).
+	HyperV *BackupJobAdvancedSettingsHyperVModel `json:"hyperV,omitempty"`
+}
+
+// AdvancedStorageScheduleModel is the weekly / monthly schedule shared by
+// synthetic fulls, active fulls, health checks and compaction.
+type AdvancedStorageScheduleModel struct {
+	// IsEnabled activates the scheduled operation.
+	IsEnabled bool `json:"isEnabled"`
+	// Weekly runs the operation on selected days of the week.
+	Weekly *AdvancedStorageScheduleWeeklyModel `json:"weekly,omitempty"`
+	// Monthly runs the operation once a month.
+	Monthly *AdvancedStorageScheduleMonthlyModel `json:"monthly,omitempty"`
+}
+
+// AdvancedStorageScheduleWeeklyModel selects the days of the week.
+type AdvancedStorageScheduleWeeklyModel struct {
+	// IsEnabled activates the weekly schedule.
+	IsEnabled bool `json:"isEnabled"`
+	// Days lists the days on which the operation runs.
+	Days []EDayOfWeek `json:"days,omitempty"`
+}
+
+// AdvancedStorageScheduleMonthlyModel selects one day per month.
+type AdvancedStorageScheduleMonthlyModel struct {
+	// IsEnabled activates the monthly schedule.
+	IsEnabled bool `json:"isEnabled"`
+	// DayOfWeek is the day within the week selected by DayNumberInMonth.
+	DayOfWeek EDayOfWeek `json:"dayOfWeek,omitempty"`
+	// DayNumberInMonth selects the week (First…Last) or OnDay.
+	DayNumberInMonth EDayNumberInMonth `json:"dayNumberInMonth,omitempty"`
+	// DayOfMonths is the day of the month when DayNumberInMonth is OnDay.
+	DayOfMonths int `json:"dayOfMonths,omitempty"`
+	// Months limits the schedule to the listed months.
+	Months []EMonth `json:"months,omitempty"`
+}
+
+// FullBackupMaintenanceModel configures maintenance of full backup files.
+type FullBackupMaintenanceModel struct {
+	// DefragmentAndCompact schedules compaction of the full backup file.
+	DefragmentAndCompact *AdvancedStorageScheduleModel `json:"defragmentAndCompact,omitempty"`
 }
 
 // BackupStorageSettingModel configures storage-level data reduction and encryption.
-// Corresponds to API schema BackupStorageSettingModel.
+// Corresponds to API schema BackupStorageSettingModel. The boolean switches are
+// always sent so that turning one off takes effect on update.
 type BackupStorageSettingModel struct {
 	// InlineDataDedupEnabled deduplicates VM data before writing to the repository.
-	InlineDataDedupEnabled bool `json:"inlineDataDedupEnabled,omitempty"`
+	InlineDataDedupEnabled bool `json:"inlineDataDedupEnabled"`
 	// ExcludeSwapFileBlocks skips swap file blocks to reduce backup size.
-	ExcludeSwapFileBlocks bool `json:"excludeSwapFileBlocks,omitempty"`
+	ExcludeSwapFileBlocks bool `json:"excludeSwapFileBlocks"`
 	// ExcludeDeletedFileBlocks skips blocks for deleted files.
-	ExcludeDeletedFileBlocks bool `json:"excludeDeletedFileBlocks,omitempty"`
+	ExcludeDeletedFileBlocks bool `json:"excludeDeletedFileBlocks"`
 	// CompressionLevel sets the compression algorithm (Auto, None, Optimal, High, Extreme…).
 	CompressionLevel ECompressionLevel `json:"compressionLevel,omitempty"`
 	// StorageOptimization sets the block size (256KB, 512KB, 1MB, 4MB).
@@ -323,12 +405,21 @@ type BackupStorageEncryptionModel struct {
 // NotificationSettingsModel configures job notifications.
 // Corresponds to API schema NotificationSettingsModel.
 type NotificationSettingsModel struct {
-	// SendSNMPNotifications enables SNMP traps for job events.
-	SendSNMPNotifications bool `json:"sendSNMPNotifications,omitempty"`
+	// SendSNMPNotifications enables SNMP traps for job events. Always sent so
+	// that disabling it takes effect on update.
+	SendSNMPNotifications bool `json:"sendSNMPNotifications"`
 	// EmailNotifications configures recipient addresses and trigger conditions.
 	EmailNotifications *EmailNotificationSettingsModel `json:"emailNotifications,omitempty"`
 }
 
+// EEmailNotificationType selects between the global and per-job email settings.
+type EEmailNotificationType string
+
+const (
+	EmailNotificationUseGlobal EEmailNotificationType = "UseGlobalNotificationSettings"
+	EmailNotificationUseCustom EEmailNotificationType = "UseCustomNotificationSettings"
+)
+
 // EmailNotificationSettingsModel configures email alerts for a job.
 // Corresponds to API schema EmailNotificationSettingsModel.
 type EmailNotificationSettingsModel struct {
@@ -336,12 +427,26 @@ type EmailNotificationSettingsModel struct {
 	IsEnabled bool `json:"isEnabled"`
 	// Recipients is a list of destination email addresses.
 	Recipients []string `json:"recipients,omitempty"`
+	// NotificationType selects the global or the custom trigger settings.
+	NotificationType EEmailNotificationType `json:"notificationType,omitempty"`
+	// CustomNotificationSettings applies when NotificationType is UseCustomNotificationSettings.
+	CustomNotificationSettings *EmailCustomNotificationSettingsModel `json:"customNotificationSettings,omitempty"`
+}
+
+// EmailCustomNotificationSettingsModel holds the per-job email triggers.
+// Corresponds to API schema EmailCustomNotificationType.
+type EmailCustomNotificationSettingsModel struct {
+	// Subject is the message subject; supports %JobResult%, %JobName% and similar variables.
+	Subject string `json:"subject,omitempty"`
 	// NotifyOnSuccess sends an email when the job succeeds.
-	NotifyOnSuccess bool `json:"notifyOnSuccess,omitempty"`
+	NotifyOnSuccess bool `json:"notifyOnSuccess"`
 	// NotifyOnWarning sends an email when the job completes with warnings.
-	NotifyOnWarning bool `json:"notifyOnWarning,omitempty"`
+	NotifyOnWarning bool `json:"notifyOnWarning"`
 	// NotifyOnError sends an email when the job fails.
-	NotifyOnError bool `json:"notifyOnError,omitempty"`
+	NotifyOnError bool `json:"notifyOnError"`
+	// SuppressNotificationUntilLastRetry only notifies after the final retry.
+	// The capitalised property name matches the VBR REST API.
+	SuppressNotificationUntilLastRetry bool `json:"SuppressNotificationUntilLastRetry"`
 }
 
 // BackupJobAdvancedSettingsVSphereModel holds vSphere-specific advanced settings.
@@ -350,6 +455,20 @@ type BackupJobAdvancedSettingsVSphereModel struct {
 	EnableVMWareToolsQuiescence bool `json:"enableVMWareToolsQuiescence,omitempty"`
 }
 
+// BackupJobAdvancedSettingsHyperVModel holds Hyper-V-specific advanced settings.
+type BackupJobAdvancedSettingsHyperVModel struct {
+	// GuestQuiescence uses Hyper-V integration services to quiesce the guest
+	// when application-aware processing is disabled.
+	GuestQuiescence bool `json:"guestQuiescence"`
+	// CrashConsistent takes a crash-consistent backup instead of suspending
+	// VMs that cannot be quiesced. Requires GuestQuiescence.
+	CrashConsistent bool `json:"crashConsistent"`
+	// ChangedBlockTracking uses Hyper-V resilient change tracking for incrementals.
+	ChangedBlockTracking bool `json:"changedBlockTracking"`
+	// VolumeSnapshot processes multiple VMs with a single volume snapshot.
+	VolumeSnapshot bool `json:"volumeSnapshot"`
+}
+
 // ---------------------------------------------------------------------------
 // Guest Processing
 // ---------------------------------------------------------------------------
@@ -371,20 +490,184 @@ type BackupJobGuestProcessingModel struct {
 // GuestOsCredentialsModel identifies the credential record used for guest OS interaction.
 // The credential must already exist; create it via the veeam_credential resource.
 type GuestOsCredentialsModel struct {
-	// CredentialsID is the UUID of the credential record.
-	CredentialsID string `json:"credentialsId"`
+	// CredentialsID is the UUID of the credential record. Empty when only
+	// per-machine credentials are configured.
+	CredentialsID string `json:"credentialsId,omitempty"`
+	// CredentialsPerMachine overrides the job-wide credentials for individual VMs.
+	CredentialsPerMachine []GuestOsCredentialsPerMachineModel `json:"credentialsPerMachine,omitempty"`
+}
+
+// GuestOsCredentialsPerMachineModel assigns guest OS credentials to a single VM.
+type GuestOsCredentialsPerMachineModel struct {
+	// VMObject identifies the VM the credentials apply to.
+	VMObject InventoryObjectSpec `json:"vmObject"`
+	// WindowsCredsID is the UUID of the credential record used for Windows guests.
+	WindowsCredsID string `json:"windowsCredsId,omitempty"`
+	// LinuxCredsID is the UUID of the credential record used for Linux guests.
+	LinuxCredsID string `json:"linuxCredsId,omitempty"`
 }
 
 // BackupApplicationAwareProcessingModel controls application-aware processing.
 type BackupApplicationAwareProcessingModel struct {
 	// IsEnabled activates application-aware processing for the job.
 	IsEnabled bool `json:"isEnabled"`
+	// AppSettings holds per-VM application-aware processing overrides.
+	AppSettings []BackupApplicationSettingsModel `json:"appSettings,omitempty"`
+}
+
+// EApplicationSettingsVSS controls VSS behaviour for a VM.
+type EApplicationSettingsVSS string
+
+const (
+	ApplicationVSSRequireSuccess EApplicationSettingsVSS = "RequireSuccess"
+	ApplicationVSSIgnoreFailures EApplicationSettingsVSS = "IgnoreFailures"
+	ApplicationVSSDisabled       EApplicationSettingsVSS = "Disabled"
+)
+
+// ETransactionLogsSettings selects how application transaction logs are handled.
+type ETransactionLogsSettings string
+
+const (
+	TransactionLogsProcess  ETransactionLogsSettings = "Process"
+	TransactionLogsCopyOnly ETransactionLogsSettings = "CopyOnly"
+)
+
+// ESQLLogsProcessing selects how Microsoft SQL Server transaction logs are processed.
+type ESQLLogsProcessing string
+
+const (
+	SQLLogsTruncate           ESQLLogsProcessing = "TruncateLogs"
+	SQLLogsPreventTruncation  ESQLLogsProcessing = "PreventTruncation"
+	SQLLogsBackupPeriodically ESQLLogsProcessing = "BackupLogsPeriodically"
+)
+
+// ELogBackupRetention controls how long log backups are kept.
+type ELogBackupRetention string
+
+const (
+	LogBackupRetentionUntilBackupDeleted ELogBackupRetention = "UntilBackupIsDeleted"
+	LogBackupRetentionKeepOnlyLastDays   ELogBackupRetention = "KeepOnlyLastDays"
+)
+
+// EArchiveLogsSettings selects how Oracle archived logs are handled.
+type EArchiveLogsSettings string
+
+const (
+	ArchiveLogsDoNotDelete        EArchiveLogsSettings = "DoNotDelete"
+	ArchiveLogsDeleteOlderThanHrs EArchiveLogsSettings = "DeleteLogsOlderThanHours"
+	ArchiveLogsDeleteOverGB       EArchiveLogsSettings = "DeleteLogsOverGb"
+)
+
+// EScriptProcessingMode controls how pre-freeze / post-thaw script failures are treated.
+type EScriptProcessingMode string
+
+const (
+	ScriptProcessingDisableExec        EScriptProcessingMode = "DisableExec"
+	ScriptProcessingIgnoreExecFailures EScriptProcessingMode = "IgnoreExecFailures"
+	ScriptProcessingRequireSuccess     EScriptProcessingMode = "RequireSuccess"
+)
+
+// BackupApplicationSettingsModel holds the application-aware processing
+// settings for a single VM.
+type BackupApplicationSettingsModel struct {
+	// VMObject identifies the VM the settings apply to.
+	VMObject InventoryObjectSpec `json:"vmObject"`
+	// VSS controls VSS behaviour (RequireSuccess, IgnoreFailures, Disabled).
+	VSS EApplicationSettingsVSS `json:"vss,omitempty"`
+	// TransactionLogs selects Process or CopyOnly log handling.
+	TransactionLogs ETransactionLogsSettings `json:"transactionLogs,omitempty"`
+	// SQL holds Microsoft SQL Server log settings.
+	SQL *BackupSQLSettingsModel `json:"sql,omitempty"`
+	// Oracle holds Oracle archived log settings.
+	Oracle *BackupOracleSettingsModel `json:"oracle,omitempty"`
+	// Scripts holds pre-freeze / post-thaw script settings.
+	Scripts *BackupScriptSettingsModel `json:"scripts,omitempty"`
+}
+
+// BackupSQLSettingsModel configures Microsoft SQL Server transaction log processing.
+type BackupSQLSettingsModel struct {
+	// LogsProcessing selects TruncateLogs, PreventTruncation or BackupLogsPeriodically.
+	LogsProcessing ESQLLogsProcessing `json:"logsProcessing"`
+	// BackupMinsCount is the log backup interval in minutes (BackupLogsPeriodically only).
+	BackupMinsCount int `json:"backupMinsCount,omitempty"`
+	// RetainLogBackups controls log backup retention (BackupLogsPeriodically only).
+	RetainLogBackups ELogBackupRetention `json:"retainLogBackups,omitempty"`
+	// KeepDaysCount is the log retention in days when RetainLogBackups is KeepOnlyLastDays.
+	KeepDaysCount int `json:"keepDaysCount,omitempty"`
+}
+
+// BackupOracleSettingsModel configures Oracle archived log processing.
+type BackupOracleSettingsModel struct {
+	// UseGuestCredentials uses the guest OS credentials to connect to Oracle.
+	UseGuestCredentials bool `json:"useGuestCredentials"`
+	// CredentialsID is the UUID of the Oracle credential record when
+	// UseGuestCredentials is false.
+	CredentialsID string `json:"credentialsId,omitempty"`
+	// ArchiveLogs selects DoNotDelete, DeleteLogsOlderThanHours or DeleteLogsOverGb.
+	ArchiveLogs EArchiveLogsSettings `json:"archiveLogs"`
+	// DeleteHoursCount is the archived log age limit for DeleteLogsOlderThanHours.
+	DeleteHoursCount int `json:"deleteHoursCount,omitempty"`
+	// DeleteGBsCount is the archived log size limit for DeleteLogsOverGb.
+	DeleteGBsCount int `json:"deleteGBsCount,omitempty"`
+	// BackupLogs enables periodic archived log backups.
+	BackupLogs bool `json:"backupLogs"`
+	// BackupMinsCount is the log backup interval in minutes.
+	BackupMinsCount int `json:"backupMinsCount,omitempty"`
+}
+
+// BackupScriptSettingsModel configures pre-freeze and post-thaw scripts for a VM.
+type BackupScriptSettingsModel struct {
+	// ScriptProcessingMode controls how script failures are treated.
+	ScriptProcessingMode EScriptProcessingMode `json:"scriptProcessingMode"`
+	// WindowsScripts holds the script paths used for Windows guests.
+	WindowsScripts *ScriptSettingsModel `json:"windowsScripts,omitempty"`
+	// LinuxScripts holds the script paths used for Linux guests.
+	LinuxScripts *ScriptSettingsModel `json:"linuxScripts,omitempty"`
+}
+
+// ScriptSettingsModel holds pre-freeze and post-thaw script paths.
+type ScriptSettingsModel struct {
+	// PreFreezeScript is the path to the script run before the VM snapshot.
+	PreFreezeScript string `json:"preFreezeScript,omitempty"`
+	// PostThawScript is the path to the script run after the VM snapshot.
+	PostThawScript string `json:"postThawScript,omitempty"`
 }
 
 // GuestFileSystemIndexingModel controls VM guest OS file indexing.
 type GuestFileSystemIndexingModel struct {
 	// IsEnabled activates file-level indexing for search inside VMs.
 	IsEnabled bool `json:"isEnabled"`
+	// IndexingSettings holds per-VM indexing scope overrides.
+	IndexingSettings []BackupIndexingSettingsModel `json:"indexingSettings,omitempty"`
+}
+
+// EGuestFSIndexingType selects which guest files are indexed.
+type EGuestFSIndexingType string
+
+const (
+	GuestFSIndexingDisabled       EGuestFSIndexingType = "Disabled"
+	GuestFSIndexingIndexAll       EGuestFSIndexingType = "IndexAll"
+	GuestFSIndexingIndexAllExcept EGuestFSIndexingType = "IndexAllExcept"
+	GuestFSIndexingIndexOnly      EGuestFSIndexingType = "IndexOnly"
+)
+
+// BackupIndexingSettingsModel holds the guest file indexing scope for a single VM.
+type BackupIndexingSettingsModel struct {
+	// VMObject identifies the VM the settings apply to.
+	VMObject InventoryObjectSpec `json:"vmObject"`
+	// WindowsIndexing is the indexing scope for Windows guests. The API
+	// capitalises this key and LinuxIndexing.
+	WindowsIndexing *GuestOsIndexingModel `json:"WindowsIndexing,omitempty"`
+	// LinuxIndexing is the indexing scope for Linux guests.
+	LinuxIndexing *GuestOsIndexingModel `json:"LinuxIndexing,omitempty"`
+}
+
+// GuestOsIndexingModel is the indexing mode and path list for one guest OS family.
+type GuestOsIndexingModel struct {
+	// GuestFSIndexingMode selects which files are indexed.
+	GuestFSIndexingMode EGuestFSIndexingType `json:"guestFSIndexingMode"`
+	// IndexingList lists the paths for IndexAllExcept / IndexOnly.
+	IndexingList []string `json:"indexingList,omitempty"`
 }
 
 // GuestInteractionProxiesSettingsModel configures guest interaction proxy selection.
@@ -481,6 +764,23 @@ type ScheduleRetryModel struct {
 type ScheduleBackupWindowModel struct {
 	// IsEnabled activates backup window enforcement.
 	IsEnabled bool `json:"isEnabled"`
+	// BackupWindow holds the hour grid; jobs running outside it are stopped.
+	BackupWindow *BackupWindowSettingModel `json:"backupWindow,omitempty"`
+}
+
+// BackupWindowSettingModel is the weekly hour grid of a backup window.
+type BackupWindowSettingModel struct {
+	// Days holds one entry per day of the week.
+	Days []BackupWindowDayHoursModel `json:"days"`
+}
+
+// BackupWindowDayHoursModel lists the permitted hours of one day.
+type BackupWindowDayHoursModel struct {
+	// Day is the day of the week.
+	Day EDayOfWeek `json:"day"`
+	// Hours is 24 comma-separated flags, one per hour from 00:00: 1 permits
+	// the job to run, 0 denies it.
+	Hours string `json:"hours"`
 }
 
 // ---------------------------------------------------------------------------
@@ -565,6 +865,8 @@ type AgentBackupJobStorageModel struct {
 	RetentionPolicy *BackupJobRetentionPolicySettings `json:"retentionPolicy,omitempty"`
 	// GFSPolicy optionally configures Grandfather-Father-Son long-term retention.
 	GFSPolicy *GFSPolicySettingsModel `json:"gfsPolicy,omitempty"`
+	// AdvancedSettings provides compression, dedup and encryption options.
+	AdvancedSettings *BackupJobAdvancedSettingsModel `json:"advancedSettings,omitempty"`
 }
 
 // AgentBackupJobVolumesModel configures which volumes to back up for agent jobs.
@@ -643,6 +945,14 @@ type JobStartSpec struct {
 	StartChainedJobs bool `json:"startChainedJobs,omitempty"`
 }
 
+// JobCloneSpec names the job created by PathJobClone.
+type JobCloneSpec struct {
+	// Name is the name of the new job; the API generates one when empty.
+	Name string `json:"name,omitempty"`
+	// Description is the description of the new job.
+	Description string `json:"description,omitempty"`
+}
+
 // JobStopSpec configures a graceful job stop.
 type JobStopSpec struct {
 	// GracefulStop produces a restore point for already-processed VMs.
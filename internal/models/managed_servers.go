@@ -2,7 +2,7 @@ package models
 
 // ---------------------------------------------------------------------------
 // Managed Servers — V13 API: /api/v1/backupInfrastructure/managedServers
-// Polymorphic: discriminator "type" → WindowsHost | LinuxHost | ViHost | ...
+// Polymorphic: discriminator "type" → WindowsHost | LinuxHost | ViHost | CloudDirectorHost | ...
 // ---------------------------------------------------------------------------
 
 // ManagedServerModel is the base response model for managed servers.
@@ -48,6 +48,23 @@ type ViHostSpec struct {
 	CertificateThumbprint string `json:"certificateThumbprint,omitempty"`
 }
 
+// CloudDirectorHostSpec adds VMware Cloud Director fields. The vCenter Servers
+// attached to Cloud Director are registered together with it.
+type CloudDirectorHostSpec struct {
+	ManagedServerSpec
+	CredentialsID         string                    `json:"credentialsId,omitempty"`
+	Port                  int                       `json:"port,omitempty"`
+	CertificateThumbprint string                    `json:"certificateThumbprint,omitempty"`
+	VCenterServers        []CloudDirectorViHostSpec `json:"vCenterServers,omitempty"`
+}
+
+// CloudDirectorViHostSpec is a vCenter Server attached to Cloud Director.
+type CloudDirectorViHostSpec struct {
+	Name                  string `json:"name"`
+	CredentialsID         string `json:"credentialsId,omitempty"`
+	CertificateThumbprint string `json:"certificateThumbprint,omitempty"`
+}
+
 // WindowsHostSpec adds Windows-specific fields.
 type WindowsHostSpec struct {
 	ManagedServerSpec
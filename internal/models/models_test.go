@@ -219,12 +219,12 @@ func TestBackupJobSpec_RoundTrip(t *testing.T) {
 		Description:    "Daily VM backup",
 		IsHighPriority: true,
 		VirtualMachines: &BackupJobVirtualMachinesSpec{
-			Includes: []VmwareObjectSpec{
+			Includes: []InventoryObjectSpec{
 				{
 					Platform: "VSphere",
 					HostName: "vcenter.lab",
 					Name:     "vm-prod-01",
-					Type:     VmwareTypeVirtualMachine,
+					Type:     string(VmwareTypeVirtualMachine),
 					ObjectID: "vm-101",
 				},
 			},
@@ -276,7 +276,7 @@ func TestBackupJobSpec_RoundTrip(t *testing.T) {
 }
 
 func TestBackupJobModel_RoundTrip(t *testing.T) {
-	// API now returns VmwareObjectSpec entries directly in includes (no nested inventoryObject).
+	// API now returns InventoryObjectSpec entries directly in includes (no nested inventoryObject).
 	jsonData := `{
 		"id": "job-abc",
 		"name": "Test-Job",
@@ -300,7 +300,7 @@ func TestBackupJobModel_RoundTrip(t *testing.T) {
 	require.Len(t, model.VirtualMachines.Includes, 1)
 	assert.Equal(t, "vm-01", model.VirtualMachines.Includes[0].Name)
 	assert.Equal(t, "vcenter.lab", model.VirtualMachines.Includes[0].HostName)
-	assert.Equal(t, VmwareTypeVirtualMachine, model.VirtualMachines.Includes[0].Type)
+	assert.Equal(t, string(VmwareTypeVirtualMachine), model.VirtualMachines.Includes[0].Type)
 }
 
 // ---------------------------------------------------------------------------
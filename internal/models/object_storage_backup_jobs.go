@@ -0,0 +1,60 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Object Storage Backup Jobs — V13 REST API: /api/v1/jobs (type="ObjectStorageBackup")
+//
+// Object storage backup jobs protect buckets and containers of object storage
+// sources registered as unstructured data servers. Each object selects a
+// source, optionally narrowed to one bucket and prefixes within it. The job
+// reads the source with a cloud credential (/api/v1/cloudCredentials).
+//
+// Storage, retention and archive use the shared unstructured data models
+// from file_backup_jobs.go.
+// ---------------------------------------------------------------------------
+
+// ObjectStorageBackupJobSpec is the request body for creating an
+// ObjectStorageBackup job.
+// API discriminator mapping: type="ObjectStorageBackup" → ObjectStorageBackupJobSpec.
+type ObjectStorageBackupJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// Objects lists the sources, buckets and prefixes to protect.
+	Objects []ObjectStorageBackupJobObjectModel `json:"objects"`
+	// Storage configures the backup repository and short-term retention.
+	Storage *UnstructuredBackupStorageModel `json:"storage"`
+	// Archive configures long-term retention in an archive repository.
+	Archive *UnstructuredBackupArchiveModel `json:"archive,omitempty"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// ObjectStorageBackupJobModel is the full response/update body for an
+// ObjectStorageBackup job.
+type ObjectStorageBackupJobModel struct {
+	JobModel
+	Description string                              `json:"description"`
+	Objects     []ObjectStorageBackupJobObjectModel `json:"objects,omitempty"`
+	Storage     *UnstructuredBackupStorageModel     `json:"storage,omitempty"`
+	Archive     *UnstructuredBackupArchiveModel     `json:"archive,omitempty"`
+	Schedule    *BackupScheduleModel                `json:"schedule,omitempty"`
+}
+
+// ObjectStorageBackupJobObjectModel is one protected object storage source,
+// bucket or set of prefixes.
+type ObjectStorageBackupJobObjectModel struct {
+	// ObjectStorageServerID is the UUID of the object storage source.
+	ObjectStorageServerID string `json:"objectStorageServerId"`
+	// CloudCredentialsID is the cloud credential used to read the source.
+	// Empty uses the credential of the source.
+	CloudCredentialsID string `json:"cloudCredentialsId,omitempty"`
+	// Bucket limits the object to one bucket or container; empty protects
+	// every bucket of the source.
+	Bucket string `json:"bucket,omitempty"`
+	// InclusionPrefixes limits the backup to objects under these prefixes.
+	InclusionPrefixes []string `json:"inclusionPrefixes,omitempty"`
+	// ExclusionPrefixes skips objects under these prefixes.
+	ExclusionPrefixes []string `json:"exclusionPrefixes,omitempty"`
+	// ExcludedBuckets skips these buckets when Bucket is empty.
+	ExcludedBuckets []string `json:"excludedBuckets,omitempty"`
+}
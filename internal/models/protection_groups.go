@@ -89,7 +89,7 @@ type CloudMachinesAccount struct {
 	SubscriptionID string                           `json:"subscriptionId,omitempty"`
 	RegionType     string                           `json:"regionType,omitempty"`
 	RegionID       string                           `json:"regionId,omitempty"`
-	AssignIAMRole  bool                             `json:"assignIamRole,omitempty"`
+	AssignIAMRole  bool                             `json:"assignIamRole"`
 }
 
 // CloudMachineObject references a cloud object selector in a CloudMachines group.
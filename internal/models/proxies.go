@@ -67,9 +67,11 @@ type GeneralPurposeProxySpec struct {
 type ProxyServerSettings struct {
 	HostID                string                    `json:"hostId"`
 	TransportMode         EBackupProxyTransportMode `json:"transportMode,omitempty"`
-	FailoverToNetwork     bool                      `json:"failoverToNetwork,omitempty"`
-	HostToProxyEncryption bool                      `json:"hostToProxyEncryption,omitempty"`
-	MaxTaskCount          int                       `json:"maxTaskCount,omitempty"`
+	FailoverToNetwork     bool                      `json:"failoverToNetwork"`
+	HostToProxyEncryption bool                      `json:"hostToProxyEncryption"`
+	// MaxTaskCount keeps omitempty: 0 is not a valid task count, so an unset
+	// value is left to the server.
+	MaxTaskCount int `json:"maxTaskCount,omitempty"`
 }
 
 // HvProxyServerSettings configures a Hyper-V proxy server.
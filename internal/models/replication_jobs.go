@@ -0,0 +1,116 @@
+package models
+
+// ---------------------------------------------------------------------------
+// Replication Jobs — V13 REST API: /api/v1/jobs (type="VSphereReplica")
+//
+// Replication jobs share the polymorphic /api/v1/jobs endpoint with backup
+// jobs, so create / read / update / delete and the enable/disable endpoints
+// behave exactly as described in jobs.go.
+//
+// A replica job copies source VMs to a target host or cluster and keeps a
+// chain of replica restore points there. Networks of the replica can be
+// remapped, and Windows replicas can be re-addressed (re-IP) on failover.
+// The initial full copy can be seeded from an existing backup.
+// ---------------------------------------------------------------------------
+
+// ReplicaJobSpec is the request body for creating a VSphereReplica job.
+// API discriminator mapping: type="VSphereReplica" → ReplicaJobSpec.
+type ReplicaJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// VirtualMachines selects the source VMs.
+	VirtualMachines *ReplicaJobVirtualMachinesModel `json:"virtualMachines"`
+	// Destination places the replicas on the target infrastructure.
+	Destination *ReplicaJobDestinationModel `json:"destination"`
+	// Network maps source networks to target networks.
+	Network *ReplicaJobNetworkModel `json:"network,omitempty"`
+	// ReIP re-addresses Windows replicas on failover.
+	ReIP *ReplicaJobReIPModel `json:"reIp,omitempty"`
+	// JobSettings holds the replica name suffix and restore point count.
+	JobSettings *ReplicaJobSettingsModel `json:"jobSettings"`
+	// Seeding configures initial seeding from a backup.
+	Seeding *ReplicaJobSeedingModel `json:"seeding,omitempty"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// ReplicaJobModel is the full response/update body for a VSphereReplica job.
+type ReplicaJobModel struct {
+	JobModel
+	Description     string                          `json:"description"`
+	VirtualMachines *ReplicaJobVirtualMachinesModel `json:"virtualMachines,omitempty"`
+	Destination     *ReplicaJobDestinationModel     `json:"destination,omitempty"`
+	Network         *ReplicaJobNetworkModel         `json:"network,omitempty"`
+	ReIP            *ReplicaJobReIPModel            `json:"reIp,omitempty"`
+	JobSettings     *ReplicaJobSettingsModel        `json:"jobSettings,omitempty"`
+	Seeding         *ReplicaJobSeedingModel         `json:"seeding,omitempty"`
+	Schedule        *BackupScheduleModel            `json:"schedule,omitempty"`
+}
+
+// ReplicaJobVirtualMachinesModel selects the VMs to replicate. It uses the
+// same object and exclusion shapes as backup jobs; template exclusion does
+// not apply to replicas.
+type ReplicaJobVirtualMachinesModel struct {
+	Includes []VmwareObjectSpec   `json:"includes"`
+	Excludes *BackupJobExclusions `json:"excludes,omitempty"`
+}
+
+// ReplicaJobDestinationModel places the replicas. Host is an ESXi host or a
+// cluster; ResourcePool and Folder are optional.
+type ReplicaJobDestinationModel struct {
+	Host         VmwareObjectSpec  `json:"host"`
+	ResourcePool *VmwareObjectSpec `json:"resourcePool,omitempty"`
+	Folder       *VmwareObjectSpec `json:"folder,omitempty"`
+	Datastore    VmwareObjectSpec  `json:"datastore"`
+}
+
+// ReplicaJobNetworkModel lists the network mapping rules.
+type ReplicaJobNetworkModel struct {
+	Mappings []ReplicaJobNetworkMappingModel `json:"mappings"`
+}
+
+// ReplicaJobNetworkMappingModel connects replicas attached to SourceNetwork
+// to TargetNetwork instead.
+type ReplicaJobNetworkMappingModel struct {
+	SourceNetwork VmwareObjectSpec `json:"sourceNetwork"`
+	TargetNetwork VmwareObjectSpec `json:"targetNetwork"`
+}
+
+// ReplicaJobReIPModel configures re-IP of Windows replicas on failover.
+type ReplicaJobReIPModel struct {
+	IsEnabled bool                      `json:"isEnabled"`
+	Rules     []ReplicaJobReIPRuleModel `json:"rules,omitempty"`
+}
+
+// ReplicaJobReIPRuleModel maps a source address range to a target range.
+// Addresses may use "*" as a wildcard octet (e.g. "172.16.1.*").
+type ReplicaJobReIPRuleModel struct {
+	SourceIPAddress      string   `json:"sourceIPAddress"`
+	SourceSubnetMask     string   `json:"sourceSubnetMask"`
+	TargetIPAddress      string   `json:"targetIPAddress"`
+	TargetSubnetMask     string   `json:"targetSubnetMask"`
+	TargetDefaultGateway string   `json:"targetDefaultGateway,omitempty"`
+	TargetDNSAddresses   []string `json:"targetDNSAddresses,omitempty"`
+	Description          string   `json:"description,omitempty"`
+}
+
+// ReplicaJobSettingsModel holds the replica naming and retention settings.
+type ReplicaJobSettingsModel struct {
+	// ReplicaNameSuffix is appended to the source VM name (e.g. "_replica").
+	ReplicaNameSuffix string `json:"replicaNameSuffix"`
+	// RestorePointsToKeep is the number of replica restore points retained.
+	RestorePointsToKeep int `json:"restorePointsToKeep"`
+}
+
+// ReplicaJobSeedingModel configures how the first full replica is created.
+type ReplicaJobSeedingModel struct {
+	InitialSeeding *ReplicaJobInitialSeedingModel `json:"initialSeeding,omitempty"`
+}
+
+// ReplicaJobInitialSeedingModel seeds the replicas from the backups stored in
+// RepositoryID instead of copying the source VMs over the network.
+type ReplicaJobInitialSeedingModel struct {
+	IsEnabled    bool   `json:"isEnabled"`
+	RepositoryID string `json:"repositoryId,omitempty"`
+}
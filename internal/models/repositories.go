@@ -99,33 +99,33 @@ type SmbStorageSpec struct {
 // WindowsLocalRepositorySettings configures a Windows local path repository.
 type WindowsLocalRepositorySettings struct {
 	Path                  string                      `json:"path"`
-	MaxTaskCount          int                         `json:"maxTaskCount,omitempty"`
-	TaskLimitEnabled      bool                        `json:"taskLimitEnabled,omitempty"`
-	ReadWriteRate         int                         `json:"readWriteRate,omitempty"`
-	ReadWriteLimitEnabled bool                        `json:"readWriteLimitEnabled,omitempty"`
+	MaxTaskCount          int                         `json:"maxTaskCount"`
+	TaskLimitEnabled      bool                        `json:"taskLimitEnabled"`
+	ReadWriteRate         int                         `json:"readWriteRate"`
+	ReadWriteLimitEnabled bool                        `json:"readWriteLimitEnabled"`
 	AdvancedSettings      *RepositoryAdvancedSettings `json:"advancedSettings,omitempty"`
 }
 
 // LinuxLocalRepositorySettings configures a Linux local path repository.
 type LinuxLocalRepositorySettings struct {
 	Path                  string `json:"path"`
-	MaxTaskCount          int    `json:"maxTaskCount,omitempty"`
-	TaskLimitEnabled      bool   `json:"taskLimitEnabled,omitempty"`
-	ReadWriteRate         int    `json:"readWriteRate,omitempty"`
-	ReadWriteLimitEnabled bool   `json:"readWriteLimitEnabled,omitempty"`
+	MaxTaskCount          int    `json:"maxTaskCount"`
+	TaskLimitEnabled      bool   `json:"taskLimitEnabled"`
+	ReadWriteRate         int    `json:"readWriteRate"`
+	ReadWriteLimitEnabled bool   `json:"readWriteLimitEnabled"`
 	// UseFastCloningOnXFSVolumes enables fast cloning when the repository path is
 	// on an XFS filesystem. Corresponds to API field useFastCloningOnXFSVolumes.
-	UseFastCloningOnXFSVolumes bool                        `json:"useFastCloningOnXFSVolumes,omitempty"`
+	UseFastCloningOnXFSVolumes bool                        `json:"useFastCloningOnXFSVolumes"`
 	AdvancedSettings           *RepositoryAdvancedSettings `json:"advancedSettings,omitempty"`
 }
 
 // NetworkRepositorySettings configures NFS/SMB network repositories.
 type NetworkRepositorySettings struct {
 	Path                  string                      `json:"path,omitempty"`
-	MaxTaskCount          int                         `json:"maxTaskCount,omitempty"`
-	TaskLimitEnabled      bool                        `json:"taskLimitEnabled,omitempty"`
-	ReadWriteRate         int                         `json:"readWriteRate,omitempty"`
-	ReadWriteLimitEnabled bool                        `json:"readWriteLimitEnabled,omitempty"`
+	MaxTaskCount          int                         `json:"maxTaskCount"`
+	TaskLimitEnabled      bool                        `json:"taskLimitEnabled"`
+	ReadWriteRate         int                         `json:"readWriteRate"`
+	ReadWriteLimitEnabled bool                        `json:"readWriteLimitEnabled"`
 	AdvancedSettings      *RepositoryAdvancedSettings `json:"advancedSettings,omitempty"`
 }
 
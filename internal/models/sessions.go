@@ -24,6 +24,12 @@ type FullSessionModel struct {
 	USN             int            `json:"usn"`
 }
 
+// SessionsResult is the response from GET /api/v1/sessions.
+type SessionsResult struct {
+	Data       []FullSessionModel `json:"data"`
+	Pagination PaginationResult   `json:"pagination"`
+}
+
 // SessionResult holds the result of a completed session.
 type SessionResult struct {
 	Result     ESessionResult `json:"result"`
@@ -0,0 +1,203 @@
+package models
+
+// ---------------------------------------------------------------------------
+// SureBackup — V13 REST API
+//   /api/v1/virtualLabs        → VirtualLabSpec / VirtualLabModel
+//   /api/v1/applicationGroups  → ApplicationGroupSpec / ApplicationGroupModel
+//   /api/v1/jobs type="SureBackup" → SureBackupJobSpec / SureBackupJobModel
+//
+// A SureBackup job starts VMs from backups in an isolated virtual lab and
+// verifies that they boot and that their applications respond. The lab is
+// built on an ESXi host: a proxy appliance bridges the production network and
+// the isolated networks, and each production network is mapped to an
+// isolated copy. An application group lists the VMs the tested VMs depend on
+// (domain controllers, DNS, databases); they are started first, in order.
+// The VMs of the linked jobs are then tested against the running group.
+//
+// Lab and application group CRUD is synchronous: POST returns the created
+// object, PUT the updated object, DELETE 204.
+// ---------------------------------------------------------------------------
+
+// EVirtualLabType is the platform of a virtual lab.
+type EVirtualLabType string
+
+const (
+	VirtualLabTypeVSphere EVirtualLabType = "VSphere"
+)
+
+// EApplicationGroupVMRole is a predefined role whose verification tests are
+// run against a VM started by SureBackup.
+type EApplicationGroupVMRole string
+
+const (
+	ApplicationGroupRoleDNSServer        EApplicationGroupVMRole = "DNSServer"
+	ApplicationGroupRoleDomainController EApplicationGroupVMRole = "DomainController"
+	ApplicationGroupRoleGlobalCatalog    EApplicationGroupVMRole = "GlobalCatalog"
+	ApplicationGroupRoleMailServer       EApplicationGroupVMRole = "MailServer"
+	ApplicationGroupRoleSQLServer        EApplicationGroupVMRole = "SQLServer"
+	ApplicationGroupRoleWebServer        EApplicationGroupVMRole = "WebServer"
+)
+
+// ---------------------------------------------------------------------------
+// Virtual labs
+// ---------------------------------------------------------------------------
+
+// VirtualLabSpec is the request body for creating or updating a virtual lab.
+type VirtualLabSpec struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Type        EVirtualLabType `json:"type"`
+	// Host is the ESXi host or cluster that runs the lab VMs.
+	Host VmwareObjectSpec `json:"host"`
+	// Datastore keeps the redo logs of the VMs started in the lab.
+	Datastore VmwareObjectSpec `json:"datastore"`
+	// ProxyAppliance connects the lab to the production network.
+	ProxyAppliance *VirtualLabProxyApplianceModel `json:"proxyAppliance"`
+	// NetworkMappings maps each production network to an isolated network.
+	NetworkMappings []VirtualLabNetworkMappingModel `json:"networkMappings"`
+}
+
+// VirtualLabModel is the API response body for a virtual lab.
+type VirtualLabModel struct {
+	ID              string                          `json:"id"`
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description"`
+	Type            EVirtualLabType                 `json:"type"`
+	Host            VmwareObjectSpec                `json:"host"`
+	Datastore       VmwareObjectSpec                `json:"datastore"`
+	ProxyAppliance  *VirtualLabProxyApplianceModel  `json:"proxyAppliance,omitempty"`
+	NetworkMappings []VirtualLabNetworkMappingModel `json:"networkMappings,omitempty"`
+}
+
+// VirtualLabProxyApplianceModel is the appliance VM that routes traffic
+// between the production network and the isolated networks.
+type VirtualLabProxyApplianceModel struct {
+	// ProductionNetwork is the port group the appliance is connected to.
+	ProductionNetwork string `json:"productionNetwork"`
+	// ObtainIPAutomatically uses DHCP for the production address.
+	ObtainIPAutomatically bool   `json:"obtainIpAutomatically"`
+	IPAddress             string `json:"ipAddress,omitempty"`
+	SubnetMask            string `json:"subnetMask,omitempty"`
+	DefaultGateway        string `json:"defaultGateway,omitempty"`
+}
+
+// VirtualLabNetworkMappingModel maps one production network to an isolated
+// network of the lab.
+type VirtualLabNetworkMappingModel struct {
+	ProductionNetwork string `json:"productionNetwork"`
+	IsolatedNetwork   string `json:"isolatedNetwork"`
+	// VLANID tags the isolated network; 0 means untagged.
+	VLANID int `json:"vlanId"`
+	// ApplianceIPAddress is the appliance address in the isolated network,
+	// normally the production gateway address.
+	ApplianceIPAddress string `json:"applianceIpAddress"`
+	SubnetMask         string `json:"subnetMask"`
+	// MasqueradeIPAddress is the production-side address range used to reach
+	// lab VMs. Assigned by the server when omitted.
+	MasqueradeIPAddress string `json:"masqueradeIpAddress,omitempty"`
+	// DHCPEnabled runs a DHCP service on the appliance in this network.
+	DHCPEnabled bool `json:"dhcpEnabled"`
+}
+
+// ---------------------------------------------------------------------------
+// Application groups
+// ---------------------------------------------------------------------------
+
+// ApplicationGroupSpec is the request body for creating or updating an
+// application group. VMs start in the order of VirtualMachines.
+type ApplicationGroupSpec struct {
+	Name            string                    `json:"name"`
+	Description     string                    `json:"description"`
+	Type            EVirtualLabType           `json:"type"`
+	VirtualMachines []ApplicationGroupVMModel `json:"virtualMachines"`
+}
+
+// ApplicationGroupModel is the API response body for an application group.
+type ApplicationGroupModel struct {
+	ID              string                    `json:"id"`
+	Name            string                    `json:"name"`
+	Description     string                    `json:"description"`
+	Type            EVirtualLabType           `json:"type"`
+	VirtualMachines []ApplicationGroupVMModel `json:"virtualMachines"`
+}
+
+// ApplicationGroupVMModel is one VM of an application group.
+type ApplicationGroupVMModel struct {
+	VMObject VmwareObjectSpec          `json:"vmObject"`
+	Roles    []EApplicationGroupVMRole `json:"roles"`
+	// MemoryAllocationPercent is the share of the production memory given to
+	// the VM in the lab.
+	MemoryAllocationPercent int `json:"memoryAllocationPercent"`
+	// MaxBootTimeSec is how long SureBackup waits for the VM to start.
+	MaxBootTimeSec int                               `json:"maxBootTimeSec"`
+	TestScripts    []ApplicationGroupTestScriptModel `json:"testScripts"`
+}
+
+// ApplicationGroupTestScriptModel is a custom verification script run on the
+// backup server against a started VM.
+type ApplicationGroupTestScriptModel struct {
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+// ---------------------------------------------------------------------------
+// SureBackup jobs
+// ---------------------------------------------------------------------------
+
+// SureBackupJobSpec is the request body for creating a SureBackup job.
+type SureBackupJobSpec struct {
+	JobSpec
+	// Description is required by the Veeam API (may be empty).
+	Description string `json:"description"`
+	// VirtualLabID is the UUID of the lab the VMs are started in.
+	VirtualLabID string `json:"virtualLabId"`
+	// ApplicationGroup is started before the linked jobs are tested.
+	ApplicationGroup *SureBackupJobApplicationGroupModel `json:"applicationGroup,omitempty"`
+	// LinkedJobs selects the backup jobs whose VMs are tested.
+	LinkedJobs *SureBackupJobLinkedJobsModel `json:"linkedJobs"`
+	// VerificationOptions selects the tests run against every VM.
+	VerificationOptions *SureBackupJobVerificationModel `json:"verificationOptions"`
+	// Schedule uses the same model as backup jobs.
+	Schedule *BackupScheduleModel `json:"schedule,omitempty"`
+}
+
+// SureBackupJobModel is the full response/update body for a SureBackup job.
+// ApplicationGroup is sent as null on update when the job has no group.
+type SureBackupJobModel struct {
+	JobModel
+	Description         string                              `json:"description"`
+	VirtualLabID        string                              `json:"virtualLabId"`
+	ApplicationGroup    *SureBackupJobApplicationGroupModel `json:"applicationGroup"`
+	LinkedJobs          *SureBackupJobLinkedJobsModel       `json:"linkedJobs,omitempty"`
+	VerificationOptions *SureBackupJobVerificationModel     `json:"verificationOptions,omitempty"`
+	Schedule            *BackupScheduleModel                `json:"schedule,omitempty"`
+}
+
+// SureBackupJobApplicationGroupModel references the application group of a
+// SureBackup job.
+type SureBackupJobApplicationGroupModel struct {
+	ApplicationGroupID string `json:"applicationGroupId"`
+	// KeepRunning leaves the group running after the job so that the lab can
+	// be used for troubleshooting or testing.
+	KeepRunning bool `json:"keepRunningAfterJobCompletion"`
+}
+
+// SureBackupJobLinkedJobsModel selects the backup jobs whose VMs are tested.
+type SureBackupJobLinkedJobsModel struct {
+	IsEnabled bool     `json:"isEnabled"`
+	JobIDs    []string `json:"jobIds"`
+	// Roles are applied to every VM of the linked jobs.
+	Roles []EApplicationGroupVMRole `json:"roles"`
+	// MaxConcurrentVMs limits how many linked VMs run in the lab at once.
+	MaxConcurrentVMs int `json:"maxConcurrentVmsCount"`
+}
+
+// SureBackupJobVerificationModel selects the tests run against every VM.
+type SureBackupJobVerificationModel struct {
+	HeartbeatTest        bool `json:"heartbeatTest"`
+	PingTest             bool `json:"pingTest"`
+	ScriptTest           bool `json:"scriptTest"`
+	BackupFileValidation bool `json:"backupFileValidation"`
+	MalwareScan          bool `json:"malwareScan"`
+}
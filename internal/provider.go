@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +20,10 @@ import (
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.Provider                  = &Provider{}
+	_ provider.ProviderWithListResources = &Provider{}
+)
 
 // Provider defines the provider implementation.
 type Provider struct {
@@ -176,45 +180,71 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	// Make the client available to resources and data sources
 	resp.DataSourceData = veeamClient
 	resp.ResourceData = veeamClient
+	resp.ListResourceData = veeamClient
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewADDomain,
+		resources.NewAgentBackupPolicy,
+		resources.NewApplicationGroup,
+		resources.NewBackupCopyJob,
 		resources.NewBackupJob,
 		resources.NewCloudCredential,
 		resources.NewConfigurationBackup,
 		resources.NewCredential,
 		resources.NewEmailSettings,
 		resources.NewEncryptionPassword,
+		resources.NewEntraIDAuditLogBackupJob,
 		resources.NewEntraIDTenant,
+		resources.NewEntraIDTenantBackupJob,
 		resources.NewEventForwarding,
+		resources.NewFailoverPlan,
+		resources.NewFileBackupJob,
 		resources.NewGeneralOptions,
 		resources.NewGlobalVMExclusion,
+		resources.NewJobRun,
 		resources.NewKMSServer,
 		resources.NewManagedServer,
 		resources.NewMountServer,
 		resources.NewNotificationSettings,
+		resources.NewObjectStorageBackupJob,
 		resources.NewProtectionGroup,
 		resources.NewProxy,
 		resources.NewRecoveryToken,
+		resources.NewReplicationJob,
 		resources.NewRepository,
 		resources.NewScaleOutRepository,
 		resources.NewSecurityAnalyzerSchedule,
 		resources.NewSecuritySettings,
 		resources.NewSecurityUser,
 		resources.NewStorageLatency,
+		resources.NewSureBackupJob,
 		resources.NewTrafficRules,
 		resources.NewUnstructuredDataServer,
+		resources.NewVirtualLab,
 		resources.NewVSphereServer,
 	}
 }
 
+// ListResources defines the list resources used by `terraform query`.
+func (p *Provider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewBackupJobListResource,
+		resources.NewCredentialListResource,
+		resources.NewManagedServerListResource,
+		resources.NewProtectionGroupListResource,
+		resources.NewProxyListResource,
+		resources.NewRepositoryListResource,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewBackupsDataSource,
+		datasources.NewBackupJobDataSource,
 		datasources.NewBackupJobsDataSource,
 		datasources.NewCredentialsDataSource,
 		datasources.NewJobStatesDataSource,
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -36,6 +37,11 @@ func (m *MockVeeamClient) WaitForTask(ctx context.Context, sessionID string) err
 	return args.Error(0)
 }
 
+func (m *MockVeeamClient) WaitForTaskWithOptions(ctx context.Context, sessionID string, pollInterval, timeout time.Duration) error {
+	args := m.Called(ctx, sessionID, pollInterval, timeout)
+	return args.Error(0)
+}
+
 // TestHelper provides common test utilities
 type TestHelper struct {
 	MockClient *MockVeeamClient
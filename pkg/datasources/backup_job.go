@@ -0,0 +1,105 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/pkg/resources"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &BackupJobDataSource{}
+	_ datasource.DataSourceWithConfigure = &BackupJobDataSource{}
+)
+
+// BackupJobDataSource reads a single backup job with the nested structure of
+// the veeam_backup_job resource. The schema and the read are delegated to the
+// resource (see resources.ReadBackupJob) so the two cannot drift apart.
+type BackupJobDataSource struct {
+	client client.APIClient
+}
+
+// NewBackupJobDataSource is a helper function to simplify the provider implementation.
+func NewBackupJobDataSource() datasource.DataSource {
+	return &BackupJobDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BackupJobDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_job"
+}
+
+// Schema defines the schema for the data source.
+func (d *BackupJobDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs, diags := resources.BackupJobDataSourceAttributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "UUID of the job to read. Exactly one of `id` and `name` is required.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the job to read (case-insensitive). Exactly one of `id` and `name` is required.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a single backup job by ID or name and returns the same nested structure as the " +
+			"`veeam_backup_job` resource: storage, GFS retention, schedule, guest processing, and the included " +
+			"and excluded objects. Supported job types: `" + strings.Join(resources.BackupJobTypes(), "`, `") + "`.",
+		Attributes: attrs,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BackupJobDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected client.APIClient from provider configuration.",
+		)
+		return
+	}
+	d.client = c
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BackupJobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hasID := !id.IsNull() && !id.IsUnknown()
+	hasName := !name.IsNull() && !name.IsUnknown()
+	if hasID == hasName {
+		resp.Diagnostics.AddError("Invalid backup job lookup", "Exactly one of id and name must be set.")
+		return
+	}
+
+	raw, diags := resources.ReadBackupJob(ctx, d.client, id.ValueString(), name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ReadBackupJob returns the job in the layout of the schema built from
+	// BackupJobDataSourceAttributes.
+	resp.State.Raw = raw
+}
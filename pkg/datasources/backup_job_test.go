@@ -0,0 +1,114 @@
+package datasources
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+	"github.com/patrikcze/terraform-provider-veeam/pkg/resources"
+)
+
+func TestBackupJobDataSource_Metadata(t *testing.T) {
+	var resp datasource.MetadataResponse
+	NewBackupJobDataSource().Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "veeam"}, &resp)
+	assert.Equal(t, "veeam_backup_job", resp.TypeName)
+}
+
+// TestBackupJobDataSource_Schema verifies that the data source exposes the
+// resource structure without the inputs a lookup cannot fill.
+func TestBackupJobDataSource_Schema(t *testing.T) {
+	ctx := context.Background()
+	var dsResp datasource.SchemaResponse
+	NewBackupJobDataSource().Schema(ctx, datasource.SchemaRequest{}, &dsResp)
+	require.False(t, dsResp.Diagnostics.HasError())
+	var resResp resource.SchemaResponse
+	resources.NewBackupJob().Schema(ctx, resource.SchemaRequest{}, &resResp)
+
+	want := maps.Clone(resResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes)
+	delete(want, "clone_from_job_id")
+	delete(want, "advanced_settings")
+	assert.Equal(t, tftypes.Object{AttributeTypes: want}, dsResp.Schema.Type().TerraformType(ctx))
+	for name, a := range dsResp.Schema.Attributes {
+		if name == "id" || name == "name" {
+			assert.True(t, a.IsOptional() && a.IsComputed(), "%s is a lookup key", name)
+			continue
+		}
+		assert.True(t, a.IsComputed() && !a.IsOptional() && !a.IsRequired(), "%s must be read-only", name)
+	}
+}
+
+// backupJobConfig returns a config with the given lookup attributes set and
+// an empty state for the data source.
+func backupJobConfig(t *testing.T, attrs map[string]string) (tfsdk.Config, tfsdk.State) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp datasource.SchemaResponse
+	NewBackupJobDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+	for k, v := range attrs {
+		require.False(t, config.SetAttribute(ctx, path.Root(k), v).HasError())
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}, tfsdk.State{Schema: schemaResp.Schema, Raw: null}
+}
+
+func TestBackupJobDataSource_ReadByID(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	d := &BackupJobDataSource{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.AnythingOfType("*map[string]interface {}")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*map[string]interface{}) = map[string]interface{}{"id": "job-1", "type": "VSphereBackup"}
+		}).Return(nil)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.AnythingOfType("*models.BackupJobModel")).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*models.BackupJobModel) = models.BackupJobModel{
+				JobModel: models.JobModel{ID: "job-1", Name: "Daily-VMs", Type: models.JobTypeVSphereBackup},
+				Storage:  &models.BackupJobStorageModel{BackupRepositoryID: "repo-1"},
+			}
+		}).Return(nil)
+
+	config, state := backupJobConfig(t, map[string]string{"id": "job-1"})
+	resp := &datasource.ReadResponse{State: state}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var name, repo types.String
+	require.False(t, resp.State.GetAttribute(context.Background(), path.Root("name"), &name).HasError())
+	require.False(t, resp.State.GetAttribute(context.Background(),
+		path.Root("storage").AtName("repository_id"), &repo).HasError())
+	assert.Equal(t, "Daily-VMs", name.ValueString())
+	assert.Equal(t, "repo-1", repo.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestBackupJobDataSource_ReadLookupKeys(t *testing.T) {
+	tests := map[string]map[string]string{
+		"no lookup key":    {},
+		"both lookup keys": {"id": "job-1", "name": "Daily-VMs"},
+	}
+	for name, attrs := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			d := &BackupJobDataSource{client: mockClient}
+
+			config, state := backupJobConfig(t, attrs)
+			resp := &datasource.ReadResponse{State: state}
+			d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "Exactly one of id and name must be set.", resp.Diagnostics.Errors()[0].Detail())
+			mockClient.AssertNotCalled(t, "GetJSON", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
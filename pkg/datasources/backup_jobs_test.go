@@ -3,6 +3,7 @@ package datasources
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,11 @@ func (m *MockVeeamClient) WaitForTask(ctx context.Context, sessionID string) err
 	return args.Error(0)
 }
 
+func (m *MockVeeamClient) WaitForTaskWithOptions(ctx context.Context, sessionID string, pollInterval, timeout time.Duration) error {
+	args := m.Called(ctx, sessionID, pollInterval, timeout)
+	return args.Error(0)
+}
+
 func TestBackupJobsDataSource_ReadAllJobs(t *testing.T) {
 	// Setup mock client
 	mockClient := new(MockVeeamClient)
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &ADDomain{}
 	_ resource.ResourceWithConfigure   = &ADDomain{}
 	_ resource.ResourceWithImportState = &ADDomain{}
+	_ resource.ResourceWithIdentity    = &ADDomain{}
 )
 
 // ADDomain implements the veeam_ad_domain resource.
@@ -39,6 +39,17 @@ type ADDomainModel struct {
 
 func (r *ADDomain) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ad_domain"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// adDomainIdentity keys AD domains by UUID and domain name.
+var adDomainIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Active Directory domain name.",
+}
+
+func (r *ADDomain) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = adDomainIdentity.schema()
 }
 
 func (r *ADDomain) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -129,6 +140,7 @@ func (r *ADDomain) Create(ctx context.Context, req resource.CreateRequest, resp
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(adDomainIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ADDomain) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -151,6 +163,7 @@ func (r *ADDomain) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	r.syncModelFromAPI(&data, &result)
 	// Password is never returned by the API — keep current state value.
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(adDomainIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 // Update is intentionally not implemented. The RequiresReplace plan modifiers on
@@ -165,6 +178,7 @@ func (r *ADDomain) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(adDomainIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ADDomain) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -185,7 +199,7 @@ func (r *ADDomain) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *ADDomain) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	adDomainIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewADDomain returns a new veeam_ad_domain resource instance.
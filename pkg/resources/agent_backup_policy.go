@@ -0,0 +1,794 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &AgentBackupPolicy{}
+	_ resource.ResourceWithConfigure      = &AgentBackupPolicy{}
+	_ resource.ResourceWithImportState    = &AgentBackupPolicy{}
+	_ resource.ResourceWithIdentity       = &AgentBackupPolicy{}
+	_ resource.ResourceWithValidateConfig = &AgentBackupPolicy{}
+)
+
+// AgentBackupPolicy implements the veeam_agent_backup_policy resource.
+type AgentBackupPolicy struct {
+	client client.APIClient
+}
+
+// AgentBackupPolicyResourceModel is the Terraform state model for
+// veeam_agent_backup_policy.
+type AgentBackupPolicyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	ProtectionGroupIDs types.List `tfsdk:"protection_group_ids"`
+
+	// BackupMode is EntireComputer, Volumes or FileLevel.
+	BackupMode types.String `tfsdk:"backup_mode"`
+	// VolumesScope and FilesScope are the same blocks as in veeam_backup_job.
+	VolumesScope *AgentVolumesScope `tfsdk:"volumes_scope"`
+	FilesScope   *AgentFilesScope   `tfsdk:"files_scope"`
+
+	Destination       *AgentPolicyDestination `tfsdk:"destination"`
+	RetentionType     types.String            `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64             `tfsdk:"retention_quantity"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule            *JobScheduleSettings            `tfsdk:"schedule"`
+	WorkstationTriggers *AgentPolicyWorkstationTriggers `tfsdk:"workstation_triggers"`
+}
+
+// AgentPolicyDestination maps to AgentBackupPolicyDestinationModel.
+type AgentPolicyDestination struct {
+	// Type is BackupRepository, LocalStorage or SharedFolder.
+	Type              types.String `tfsdk:"type"`
+	RepositoryID      types.String `tfsdk:"repository_id"`
+	LocalPath         types.String `tfsdk:"local_path"`
+	SharePath         types.String `tfsdk:"share_path"`
+	ShareCredentialID types.String `tfsdk:"share_credential_id"`
+}
+
+// AgentPolicyWorkstationTriggers maps to the event triggers of
+// AgentBackupPolicyScheduleModel.
+type AgentPolicyWorkstationTriggers struct {
+	AtLogoff           types.Bool `tfsdk:"at_logoff"`
+	AtLock             types.Bool `tfsdk:"at_lock"`
+	AtTargetConnection types.Bool `tfsdk:"at_target_connection"`
+}
+
+func (r *AgentBackupPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_backup_policy"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// agentBackupPolicyIdentity keys agent backup policies by UUID and name.
+var agentBackupPolicyIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Agent backup policy name.",
+	lookup:         &backupJobImport,
+}
+
+var agentBackupPolicyResource = jobResource{
+	typeName: "veeam_agent_backup_policy",
+	kind:     "agent backup policy",
+	jobTypes: []models.EJobType{
+		models.JobTypeWindowsAgentBackupServerPolicy,
+		models.JobTypeLinuxAgentBackupServerPolicy,
+		models.JobTypeWindowsAgentBackupWorkstationPolicy,
+		models.JobTypeLinuxAgentBackupWorkstationPolicy,
+	},
+}
+
+func (r *AgentBackupPolicy) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = agentBackupPolicyIdentity.schema()
+}
+
+func (r *AgentBackupPolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam agent backup policy (server or workstation policy for " +
+			"Windows or Linux) that agents of the selected protection groups pull and run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the policy (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the policy. Must be unique across all jobs.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Policy type: " + strings.Join(agentBackupPolicyTypes, ", ") +
+					". Changing this forces a new policy.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the policy is disabled. Applied through the job " +
+					"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protection_group_ids": schema.ListAttribute{
+				MarkdownDescription: "UUIDs of the protection groups the policy is applied to " +
+					"(`veeam_protection_group.id`).",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"backup_mode": schema.StringAttribute{
+				MarkdownDescription: "Backup scope: `EntireComputer`, `Volumes` or `FileLevel`.",
+				Required:            true,
+			},
+			"volumes_scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "Volumes to back up. Required when `backup_mode = \"Volumes\"`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"all_volumes": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, all local volumes are backed up.",
+						Required:            true,
+					},
+					"volume_names": schema.ListAttribute{
+						MarkdownDescription: "Drive letters or mount points to back up when `all_volumes = false`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"files_scope": schema.SingleNestedAttribute{
+				MarkdownDescription: "Folders to back up. Required when `backup_mode = \"FileLevel\"`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"included_folders": schema.ListAttribute{
+						MarkdownDescription: "Folders to include in the backup.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+					"excluded_folders": schema.ListAttribute{
+						MarkdownDescription: "Folders to skip. Exclusions are applied after inclusions.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"destination": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where agents write their backups.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Destination type: " + strings.Join(agentBackupPolicyDestinationTypes, ", ") + ".",
+						Required:            true,
+					},
+					"repository_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the backup repository. Required for `BackupRepository`.",
+						Optional:            true,
+					},
+					"local_path": schema.StringAttribute{
+						MarkdownDescription: "Folder on the protected computer. Required for `LocalStorage`.",
+						Optional:            true,
+					},
+					"share_path": schema.StringAttribute{
+						MarkdownDescription: "UNC or NFS path of the share. Required for `SharedFolder`.",
+						Optional:            true,
+					},
+					"share_credential_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the credentials used to access the share (`veeam_credential.id`). " +
+							"Only valid for `SharedFolder`.",
+						Optional: true,
+					},
+				},
+			},
+			"retention_type": schema.StringAttribute{
+				MarkdownDescription: "Unit of `retention_quantity`: `RestorePoints` or `Days`. Defaults to `Days`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(models.RetentionPolicyTypeDays)),
+			},
+			"retention_quantity": schema.Int64Attribute{
+				MarkdownDescription: "Number of restore points or days to keep. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+			},
+			"schedule": scheduleAttribute(),
+			"workstation_triggers": schema.SingleNestedAttribute{
+				MarkdownDescription: "Event triggers of workstation policies. Only valid for the " +
+					"`*WorkstationPolicy` types.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"at_logoff": schema.BoolAttribute{
+						MarkdownDescription: "Start a backup when the user logs off. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"at_lock": schema.BoolAttribute{
+						MarkdownDescription: "Start a backup when the user locks the computer. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"at_target_connection": schema.BoolAttribute{
+						MarkdownDescription: "Start a backup when the backup target becomes available. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the policy type, scope, destination and workstation
+// trigger rules at plan time.
+func (r *AgentBackupPolicy) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AgentBackupPolicyResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// Scope or destination blocks derived from other resources are
+		// validated again on apply.
+		return
+	}
+	if err := validateAgentBackupPolicy(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid agent backup policy configuration", err.Error())
+	}
+}
+
+func (r *AgentBackupPolicy) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *AgentBackupPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateAgentBackupPolicy(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid agent backup policy configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model, err := r.buildAgentBackupPolicyModel(ctx, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create agent backup policy", err.Error())
+		return
+	}
+	spec := &models.AgentBackupPolicySpec{
+		JobSpec:     models.JobSpec{Name: model.Name, Type: model.Type},
+		Description: model.Description,
+		Computers:   model.Computers,
+		BackupMode:  model.BackupMode,
+		Volumes:     model.Volumes,
+		Files:       model.Files,
+		Destination: model.Destination,
+		Schedule:    model.Schedule,
+	}
+
+	var raw map[string]interface{}
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &raw); err != nil {
+		resp.Diagnostics.AddError("Failed to create agent backup policy",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	result, err := r.awaitAgentBackupPolicy(ctx, raw, "", data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create agent backup policy", err.Error())
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncAgentBackupPolicyFromAPI(&data, result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(agentBackupPolicyResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, agentBackupPolicyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *AgentBackupPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.AgentBackupPolicyModel
+	if !agentBackupPolicyResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncAgentBackupPolicyFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(agentBackupPolicyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *AgentBackupPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AgentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateAgentBackupPolicy(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid agent backup policy configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	payload, err := r.buildAgentBackupPolicyModel(ctx, &data, state.IsDisabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update agent backup policy", err.Error())
+		return
+	}
+	var raw map[string]interface{}
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &raw, agentBackupPolicyManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update agent backup policy",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	result, err := r.awaitAgentBackupPolicy(ctx, raw, data.ID.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update agent backup policy", err.Error())
+		return
+	}
+	syncAgentBackupPolicyFromAPI(&data, result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(agentBackupPolicyResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, agentBackupPolicyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *AgentBackupPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AgentBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentBackupPolicyResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *AgentBackupPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	agentBackupPolicyIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewAgentBackupPolicy returns a new veeam_agent_backup_policy resource instance.
+func NewAgentBackupPolicy() resource.Resource {
+	return &AgentBackupPolicy{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// agentBackupPolicyManagedPaths are cleared on the server when the plan
+// leaves them out, so switching backup_mode or destination.type drops the
+// previous scope or target, and removing a schedule block or workstation
+// trigger takes effect on update.
+var agentBackupPolicyManagedPaths = []string{
+	"volumes",
+	"files",
+	"destination.backupRepositoryId",
+	"destination.localPath",
+	"destination.sharedFolder",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+	"schedule.atLogOff",
+	"schedule.atLock",
+	"schedule.atTargetConnection",
+}
+
+// agentBackupPolicyTypes lists the accepted type values.
+var agentBackupPolicyTypes = []string{
+	string(models.JobTypeWindowsAgentBackupServerPolicy),
+	string(models.JobTypeLinuxAgentBackupServerPolicy),
+	string(models.JobTypeWindowsAgentBackupWorkstationPolicy),
+	string(models.JobTypeLinuxAgentBackupWorkstationPolicy),
+}
+
+// agentBackupPolicyDestinationTypes lists the accepted destination.type values.
+var agentBackupPolicyDestinationTypes = []string{
+	string(models.AgentBackupPolicyDestinationRepository),
+	string(models.AgentBackupPolicyDestinationLocal),
+	string(models.AgentBackupPolicyDestinationShare),
+}
+
+// validateAgentBackupPolicy checks the policy type, that protection groups are
+// listed once, that the scope block matches backup_mode, that the destination
+// fields fit destination.type, the retention settings, and that workstation
+// triggers are only set on workstation policies.
+func validateAgentBackupPolicy(data *AgentBackupPolicyResourceModel) error {
+	policyType := data.Type.ValueString()
+	if isConfigured(data.Type) && !slices.Contains(agentBackupPolicyTypes, policyType) {
+		return fmt.Errorf("type %q is not supported; expected one of %s",
+			policyType, strings.Join(agentBackupPolicyTypes, ", "))
+	}
+
+	if isConfigured(data.ProtectionGroupIDs) && len(data.ProtectionGroupIDs.Elements()) == 0 {
+		return errors.New("protection_group_ids must contain at least one protection group")
+	}
+	groups := map[string]bool{}
+	for i, e := range data.ProtectionGroupIDs.Elements() {
+		v, ok := e.(types.String)
+		if !ok || !isConfigured(v) {
+			continue
+		}
+		id := v.ValueString()
+		if id == "" {
+			return fmt.Errorf("protection_group_ids[%d] must not be empty", i)
+		}
+		if groups[id] {
+			return fmt.Errorf("protection_group_ids[%d]: %q is listed more than once", i, id)
+		}
+		groups[id] = true
+	}
+
+	mode := models.EAgentBackupJobMode(data.BackupMode.ValueString())
+	switch mode {
+	case models.AgentBackupModeEntireComputer, models.AgentBackupModeVolumes, models.AgentBackupModeFileLevel:
+	default:
+		if isConfigured(data.BackupMode) {
+			return fmt.Errorf("backup_mode %q is not supported; expected one of EntireComputer, Volumes, FileLevel", mode)
+		}
+	}
+	if isConfigured(data.BackupMode) {
+		if (mode == models.AgentBackupModeVolumes) != (data.VolumesScope != nil) {
+			return errors.New(`volumes_scope is required when backup_mode = "Volumes" and not allowed otherwise`)
+		}
+		if (mode == models.AgentBackupModeFileLevel) != (data.FilesScope != nil) {
+			return errors.New(`files_scope is required when backup_mode = "FileLevel" and not allowed otherwise`)
+		}
+	}
+	if vs := data.VolumesScope; vs != nil && isConfigured(vs.AllVolumes) {
+		names := vs.VolumeNames.Elements()
+		if vs.AllVolumes.ValueBool() && len(names) > 0 {
+			return errors.New("volumes_scope.volume_names must be omitted when all_volumes = true")
+		}
+		if !vs.AllVolumes.ValueBool() && isConfigured(vs.VolumeNames) && len(names) == 0 {
+			return errors.New("volumes_scope.volume_names must not be empty when all_volumes = false")
+		}
+	}
+	if fs := data.FilesScope; fs != nil {
+		if isConfigured(fs.IncludedFolders) && len(fs.IncludedFolders.Elements()) == 0 {
+			return errors.New("files_scope.included_folders must not be empty")
+		}
+		if err := validatePatternLists("files_scope", "included_folders", "excluded_folders",
+			fs.IncludedFolders, fs.ExcludedFolders); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAgentPolicyDestination(data.Destination); err != nil {
+		return err
+	}
+
+	switch models.ERetentionPolicyType(data.RetentionType.ValueString()) {
+	case models.RetentionPolicyTypeRestorePoints, models.RetentionPolicyTypeDays:
+	default:
+		if isConfigured(data.RetentionType) {
+			return fmt.Errorf("retention_type %q is not supported; expected RestorePoints or Days",
+				data.RetentionType.ValueString())
+		}
+	}
+	if isConfigured(data.RetentionQuantity) && data.RetentionQuantity.ValueInt64() < 1 {
+		return errors.New("retention_quantity must be at least 1")
+	}
+
+	if t := data.WorkstationTriggers; t != nil && isConfigured(data.Type) && !isWorkstationPolicy(policyType) &&
+		(t.AtLogoff.ValueBool() || t.AtLock.ValueBool() || t.AtTargetConnection.ValueBool()) {
+		return fmt.Errorf("workstation_triggers are only supported by workstation policies, not %s", policyType)
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// validateAgentPolicyDestination checks that exactly the fields of the
+// selected destination type are set.
+func validateAgentPolicyDestination(d *AgentPolicyDestination) error {
+	if d == nil || !isConfigured(d.Type) {
+		return nil
+	}
+	fields := map[string]types.String{
+		"repository_id":       d.RepositoryID,
+		"local_path":          d.LocalPath,
+		"share_path":          d.SharePath,
+		"share_credential_id": d.ShareCredentialID,
+	}
+	allowed := map[string]bool{}
+	var required string
+	destType := models.EAgentBackupPolicyDestinationType(d.Type.ValueString())
+	switch destType {
+	case models.AgentBackupPolicyDestinationRepository:
+		required = "repository_id"
+	case models.AgentBackupPolicyDestinationLocal:
+		required = "local_path"
+	case models.AgentBackupPolicyDestinationShare:
+		required = "share_path"
+		allowed["share_credential_id"] = true
+	default:
+		return fmt.Errorf("destination.type %q is not supported; expected one of %s",
+			destType, strings.Join(agentBackupPolicyDestinationTypes, ", "))
+	}
+	allowed[required] = true
+
+	if v := fields[required]; v.IsNull() || (!v.IsUnknown() && v.ValueString() == "") {
+		return fmt.Errorf("destination.%s is required when destination.type = %q", required, destType)
+	}
+	for _, name := range []string{"repository_id", "local_path", "share_path", "share_credential_id"} {
+		if !fields[name].IsNull() && !allowed[name] {
+			return fmt.Errorf("destination.%s is not allowed when destination.type = %q", name, destType)
+		}
+	}
+	return nil
+}
+
+// isWorkstationPolicy reports whether policyType is a workstation policy.
+func isWorkstationPolicy(policyType string) bool {
+	return policyType == string(models.JobTypeWindowsAgentBackupWorkstationPolicy) ||
+		policyType == string(models.JobTypeLinuxAgentBackupWorkstationPolicy)
+}
+
+// buildAgentBackupPolicyModel converts the plan into the full policy model
+// used for PUT; Create derives the POST spec from it. The API expects named
+// protection group objects, so each protection group is read to get its name.
+func (r *AgentBackupPolicy) buildAgentBackupPolicyModel(ctx context.Context, data *AgentBackupPolicyResourceModel, isDisabled bool) (*models.AgentBackupPolicyModel, error) {
+	m := &models.AgentBackupPolicyModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.EJobType(data.Type.ValueString()),
+			IsDisabled: isDisabled,
+		},
+		Description: data.Description.ValueString(),
+		BackupMode:  models.EAgentBackupJobMode(data.BackupMode.ValueString()),
+		Volumes:     buildAgentVolumesScopeModel(data.VolumesScope),
+		Files:       buildAgentFilesScopeModel(data.FilesScope),
+		Destination: buildAgentPolicyDestination(data),
+		Schedule:    buildAgentPolicySchedule(data.Schedule, data.WorkstationTriggers, isWorkstationPolicy(data.Type.ValueString())),
+	}
+
+	for i, id := range listStrings(data.ProtectionGroupIDs) {
+		endpoint := fmt.Sprintf(client.PathProtectionGroupByID, id)
+		var group models.ProtectionGroupModel
+		if err := r.client.GetJSON(ctx, endpoint, &group); err != nil {
+			return nil, fmt.Errorf("protection_group_ids[%d]: GET %s: %w", i, endpoint, err)
+		}
+		m.Computers = append(m.Computers, models.AgentObjectSpec{
+			Platform:          string(models.InventoryPlatformAgent),
+			ID:                id,
+			Name:              group.Name,
+			Type:              models.AgentTypeProtectionGroup,
+			ProtectionGroupID: id,
+		})
+	}
+	return m, nil
+}
+
+// buildAgentPolicyDestination builds the destination, including retention.
+func buildAgentPolicyDestination(data *AgentBackupPolicyResourceModel) *models.AgentBackupPolicyDestinationModel {
+	m := &models.AgentBackupPolicyDestinationModel{
+		RetentionPolicy: &models.BackupJobRetentionPolicySettings{
+			Type:     models.ERetentionPolicyType(data.RetentionType.ValueString()),
+			Quantity: int(data.RetentionQuantity.ValueInt64()),
+		},
+	}
+	d := data.Destination
+	if d == nil {
+		return m
+	}
+	m.Type = models.EAgentBackupPolicyDestinationType(d.Type.ValueString())
+	m.BackupRepositoryID = d.RepositoryID.ValueString()
+	m.LocalPath = d.LocalPath.ValueString()
+	if isConfigured(d.SharePath) {
+		m.SharedFolder = &models.AgentBackupPolicySharedFolderModel{
+			Path:          d.SharePath.ValueString(),
+			CredentialsID: d.ShareCredentialID.ValueString(),
+		}
+	}
+	return m
+}
+
+// buildAgentPolicySchedule combines the shared schedule block with the
+// workstation triggers, which the API keeps in the same section. Workstation
+// policies always send the triggers so that removing the block disables them.
+func buildAgentPolicySchedule(s *JobScheduleSettings, t *AgentPolicyWorkstationTriggers, workstation bool) *models.AgentBackupPolicyScheduleModel {
+	if s == nil && t == nil && !workstation {
+		return nil
+	}
+	m := &models.AgentBackupPolicyScheduleModel{}
+	if base := buildScheduleModel(s); base != nil {
+		m.BackupScheduleModel = *base
+	}
+	if workstation {
+		if t == nil {
+			t = &AgentPolicyWorkstationTriggers{}
+		}
+		m.AtLogOff = &models.AgentBackupPolicyTriggerModel{IsEnabled: t.AtLogoff.ValueBool()}
+		m.AtLock = &models.AgentBackupPolicyTriggerModel{IsEnabled: t.AtLock.ValueBool()}
+		m.AtTargetConnection = &models.AgentBackupPolicyTriggerModel{IsEnabled: t.AtTargetConnection.ValueBool()}
+	}
+	return m
+}
+
+// isAsyncAgentBackupPolicyResult reports whether a POST or PUT response is a
+// session rather than the saved policy.
+func isAsyncAgentBackupPolicyResult(result map[string]interface{}) bool {
+	_, hasState := result["state"]
+	_, hasSessionType := result["sessionType"]
+	return hasState || hasSessionType
+}
+
+// awaitAgentBackupPolicy turns a POST or PUT response into the saved policy.
+// A session response is waited for with WaitForTask, after which the policy
+// is read back by id, or resolved by name after a create.
+func (r *AgentBackupPolicy) awaitAgentBackupPolicy(ctx context.Context, raw map[string]interface{}, id, name string) (*models.AgentBackupPolicyModel, error) {
+	async := isAsyncAgentBackupPolicyResult(raw)
+	if async {
+		sessionID := getStringValue(raw, "id")
+		if sessionID == "" {
+			return nil, errors.New("API response did not include async session ID")
+		}
+		if err := r.client.WaitForTask(ctx, sessionID); err != nil {
+			return nil, fmt.Errorf("async agent backup policy task %s failed: %w", sessionID, err)
+		}
+	} else {
+		var result models.AgentBackupPolicyModel
+		if fromJSONMap(raw, &result) && result.ID != "" {
+			return &result, nil
+		}
+	}
+
+	if id == "" {
+		if !async {
+			return nil, errors.New("API response did not include policy ID or async session ID")
+		}
+		resolved, err := resolveImportID(ctx, r.client, backupJobImport, "name:"+name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve created agent backup policy: %w", err)
+		}
+		id = resolved
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, id)
+	var result models.AgentBackupPolicyModel
+	if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	return &result, nil
+}
+
+// syncAgentBackupPolicyFromAPI refreshes the state from a policy response.
+func syncAgentBackupPolicyFromAPI(data *AgentBackupPolicyResourceModel, api *models.AgentBackupPolicyModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.Type = types.StringValue(string(api.Type))
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+	data.BackupMode = types.StringValue(string(api.BackupMode))
+
+	groups := make([]string, 0, len(api.Computers))
+	for _, c := range api.Computers {
+		if c.Type != models.AgentTypeProtectionGroup {
+			continue
+		}
+		id := c.ProtectionGroupID
+		if id == "" {
+			id = c.ID
+		}
+		groups = append(groups, id)
+	}
+	data.ProtectionGroupIDs = stringListOrNull(groups)
+
+	data.VolumesScope = nil
+	if v := api.Volumes; v != nil && api.BackupMode == models.AgentBackupModeVolumes {
+		data.VolumesScope = &AgentVolumesScope{
+			AllVolumes:  types.BoolValue(v.AllVolumes),
+			VolumeNames: stringListOrNull(v.VolumeNames),
+		}
+	}
+	data.FilesScope = nil
+	if f := api.Files; f != nil && api.BackupMode == models.AgentBackupModeFileLevel {
+		data.FilesScope = &AgentFilesScope{
+			IncludedFolders: stringListOrNull(f.IncludedFolders),
+			ExcludedFolders: stringListOrNull(f.ExcludedFolders),
+		}
+	}
+
+	if d := api.Destination; d != nil {
+		dest := &AgentPolicyDestination{
+			Type:              types.StringValue(string(d.Type)),
+			RepositoryID:      stringOrNull(d.BackupRepositoryID),
+			LocalPath:         stringOrNull(d.LocalPath),
+			SharePath:         types.StringNull(),
+			ShareCredentialID: types.StringNull(),
+		}
+		if sf := d.SharedFolder; sf != nil {
+			dest.SharePath = stringOrNull(sf.Path)
+			dest.ShareCredentialID = stringOrNull(sf.CredentialsID)
+		}
+		data.Destination = dest
+		if rp := d.RetentionPolicy; rp != nil {
+			data.RetentionType = types.StringValue(string(rp.Type))
+			data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+		}
+	}
+
+	// The schedule section is also sent for workstation triggers alone, so
+	// the schedule block is only adopted when configured or running.
+	if s := api.Schedule; s != nil {
+		if data.Schedule != nil || s.RunAutomatically {
+			data.Schedule = syncScheduleFromAPI(data.Schedule, &s.BackupScheduleModel)
+		}
+		enabled := func(t *models.AgentBackupPolicyTriggerModel) bool { return t != nil && t.IsEnabled }
+		if data.WorkstationTriggers != nil || enabled(s.AtLogOff) || enabled(s.AtLock) || enabled(s.AtTargetConnection) {
+			data.WorkstationTriggers = &AgentPolicyWorkstationTriggers{
+				AtLogoff:           types.BoolValue(enabled(s.AtLogOff)),
+				AtLock:             types.BoolValue(enabled(s.AtLock)),
+				AtTargetConnection: types.BoolValue(enabled(s.AtTargetConnection)),
+			}
+		}
+	}
+}
@@ -0,0 +1,474 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// mockProtectionGroup answers the protection group lookup of the policy builder.
+func mockProtectionGroup(m *MockVeeamClient, id, name string) {
+	m.On("GetJSON", mock.Anything, "/api/v1/agents/protectionGroups/"+id, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*models.ProtectionGroupModel) = models.ProtectionGroupModel{ID: id, Name: name}
+		}).Return(nil)
+}
+
+// shareDestination targets the NAS share used by the laptop policies.
+func shareDestination() *AgentPolicyDestination {
+	return &AgentPolicyDestination{
+		Type:              types.StringValue("SharedFolder"),
+		SharePath:         types.StringValue(`\\nas01\laptops`),
+		ShareCredentialID: types.StringValue("cred-1"),
+	}
+}
+
+// filesScope backs up included with no exclusions.
+func filesScope(included types.List) *AgentFilesScope {
+	return &AgentFilesScope{IncludedFolders: included, ExcludedFolders: types.ListNull(types.StringType)}
+}
+
+// ---------------------------------------------------------------------------
+// AgentBackupPolicy — buildAgentBackupPolicyModel
+// ---------------------------------------------------------------------------
+
+func TestAgentBackupPolicy_BuildModel(t *testing.T) {
+	triggersOff := &models.AgentBackupPolicyScheduleModel{
+		AtLogOff:           &models.AgentBackupPolicyTriggerModel{IsEnabled: false},
+		AtLock:             &models.AgentBackupPolicyTriggerModel{IsEnabled: false},
+		AtTargetConnection: &models.AgentBackupPolicyTriggerModel{IsEnabled: false},
+	}
+	tests := []struct {
+		name         string
+		data         AgentBackupPolicyResourceModel
+		wantDest     *models.AgentBackupPolicyDestinationModel
+		wantSchedule *models.AgentBackupPolicyScheduleModel
+	}{
+		{
+			name: "server policy to a repository",
+			data: AgentBackupPolicyResourceModel{
+				Type:        types.StringValue("WindowsAgentBackupServerPolicy"),
+				BackupMode:  types.StringValue("EntireComputer"),
+				Destination: &AgentPolicyDestination{Type: types.StringValue("BackupRepository"), RepositoryID: types.StringValue("repo-1")},
+			},
+			wantDest: &models.AgentBackupPolicyDestinationModel{
+				Type:               models.AgentBackupPolicyDestinationRepository,
+				BackupRepositoryID: "repo-1",
+				RetentionPolicy:    &models.BackupJobRetentionPolicySettings{Type: models.RetentionPolicyTypeDays, Quantity: 14},
+			},
+			wantSchedule: nil,
+		},
+		{
+			name: "workstation policy to a share, backing up at logoff",
+			data: AgentBackupPolicyResourceModel{
+				Type:        types.StringValue("WindowsAgentBackupWorkstationPolicy"),
+				BackupMode:  types.StringValue("FileLevel"),
+				FilesScope:  filesScope(stringList(`C:\Users`)),
+				Destination: shareDestination(),
+				WorkstationTriggers: &AgentPolicyWorkstationTriggers{
+					AtLogoff:           types.BoolValue(true),
+					AtLock:             types.BoolValue(false),
+					AtTargetConnection: types.BoolValue(false),
+				},
+			},
+			wantDest: &models.AgentBackupPolicyDestinationModel{
+				Type:            models.AgentBackupPolicyDestinationShare,
+				SharedFolder:    &models.AgentBackupPolicySharedFolderModel{Path: `\\nas01\laptops`, CredentialsID: "cred-1"},
+				RetentionPolicy: &models.BackupJobRetentionPolicySettings{Type: models.RetentionPolicyTypeDays, Quantity: 14},
+			},
+			wantSchedule: &models.AgentBackupPolicyScheduleModel{
+				AtLogOff:           &models.AgentBackupPolicyTriggerModel{IsEnabled: true},
+				AtLock:             &models.AgentBackupPolicyTriggerModel{IsEnabled: false},
+				AtTargetConnection: &models.AgentBackupPolicyTriggerModel{IsEnabled: false},
+			},
+		},
+		{
+			name: "workstation policy without triggers disables them",
+			data: AgentBackupPolicyResourceModel{
+				Type:        types.StringValue("LinuxAgentBackupWorkstationPolicy"),
+				BackupMode:  types.StringValue("EntireComputer"),
+				Destination: &AgentPolicyDestination{Type: types.StringValue("LocalStorage"), LocalPath: types.StringValue("/var/backups")},
+			},
+			wantDest: &models.AgentBackupPolicyDestinationModel{
+				Type:            models.AgentBackupPolicyDestinationLocal,
+				LocalPath:       "/var/backups",
+				RetentionPolicy: &models.BackupJobRetentionPolicySettings{Type: models.RetentionPolicyTypeDays, Quantity: 14},
+			},
+			wantSchedule: triggersOff,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockProtectionGroup(mockClient, "pg-laptops", "Laptops OU")
+			tt.data.ProtectionGroupIDs = stringList("pg-laptops")
+			tt.data.RetentionType = types.StringValue("Days")
+			tt.data.RetentionQuantity = types.Int64Value(14)
+
+			m, err := (&AgentBackupPolicy{client: mockClient}).buildAgentBackupPolicyModel(context.Background(), &tt.data, false)
+			require.NoError(t, err)
+			assert.Equal(t, models.EJobType(tt.data.Type.ValueString()), m.Type)
+			assert.Equal(t, []models.AgentObjectSpec{{
+				Platform: "Agent", ID: "pg-laptops", Name: "Laptops OU",
+				Type: models.AgentTypeProtectionGroup, ProtectionGroupID: "pg-laptops",
+			}}, m.Computers)
+			assert.Equal(t, tt.wantDest, m.Destination)
+			assert.Equal(t, tt.wantSchedule, m.Schedule)
+		})
+	}
+}
+
+func TestAgentBackupPolicy_BuildModel_MissingProtectionGroup(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockProtectionGroup(mockClient, "pg-laptops", "Laptops OU")
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/agents/protectionGroups/pg-gone", mock.Anything).
+		Return(errors.New("HTTP 404: Not Found"))
+
+	_, err := (&AgentBackupPolicy{client: mockClient}).buildAgentBackupPolicyModel(context.Background(), &AgentBackupPolicyResourceModel{
+		Type:               types.StringValue("WindowsAgentBackupServerPolicy"),
+		ProtectionGroupIDs: stringList("pg-laptops", "pg-gone"),
+	}, false)
+	assert.ErrorContains(t, err, "protection_group_ids[1]: GET /api/v1/agents/protectionGroups/pg-gone")
+}
+
+// ---------------------------------------------------------------------------
+// AgentBackupPolicy — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestAgentBackupPolicy_ValidateConfig(t *testing.T) {
+	laptops := func(overrides map[string]interface{}) map[string]interface{} {
+		attrs := map[string]interface{}{
+			"name":                 "Laptops",
+			"description":          "Field laptops",
+			"type":                 "WindowsAgentBackupWorkstationPolicy",
+			"protection_group_ids": stringList("pg-laptops"),
+			"backup_mode":          "FileLevel",
+			"files_scope":          filesScope(stringList(`C:\Users`)),
+			"destination":          shareDestination(),
+		}
+		for k, v := range overrides {
+			attrs[k] = v
+		}
+		return attrs
+	}
+	listOf := func(values ...attr.Value) types.List {
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "file-level workstation policy",
+			attrs: laptops(nil),
+		},
+		{
+			name:    "policy type of a backup job",
+			attrs:   laptops(map[string]interface{}{"type": "WindowsAgentBackup"}),
+			wantErr: `type "WindowsAgentBackup" is not supported`,
+		},
+		{
+			name:    "no protection groups",
+			attrs:   laptops(map[string]interface{}{"protection_group_ids": stringList()}),
+			wantErr: "protection_group_ids must contain at least one protection group",
+		},
+		{
+			name:    "protection group listed twice",
+			attrs:   laptops(map[string]interface{}{"protection_group_ids": stringList("pg-laptops", "pg-laptops")}),
+			wantErr: `protection_group_ids[1]: "pg-laptops" is listed more than once`,
+		},
+		{
+			name:    "files scope on an entire computer policy",
+			attrs:   laptops(map[string]interface{}{"backup_mode": "EntireComputer"}),
+			wantErr: `files_scope is required when backup_mode = "FileLevel" and not allowed otherwise`,
+		},
+		{
+			name: "volume names with all volumes",
+			attrs: laptops(map[string]interface{}{
+				"backup_mode":   "Volumes",
+				"files_scope":   (*AgentFilesScope)(nil),
+				"volumes_scope": &AgentVolumesScope{AllVolumes: types.BoolValue(true), VolumeNames: stringList("C:")},
+			}),
+			wantErr: "volumes_scope.volume_names must be omitted when all_volumes = true",
+		},
+		{
+			name:    "folder included and excluded",
+			attrs:   laptops(map[string]interface{}{"files_scope": &AgentFilesScope{IncludedFolders: stringList(`C:\Users`), ExcludedFolders: stringList(`C:\Users`)}}),
+			wantErr: `files_scope: "C:\\Users" is both included and excluded`,
+		},
+		{
+			name:    "share without a path",
+			attrs:   laptops(map[string]interface{}{"destination": &AgentPolicyDestination{Type: types.StringValue("SharedFolder")}}),
+			wantErr: `destination.share_path is required when destination.type = "SharedFolder"`,
+		},
+		{
+			name: "repository with a share credential",
+			attrs: laptops(map[string]interface{}{"destination": &AgentPolicyDestination{
+				Type: types.StringValue("BackupRepository"), RepositoryID: types.StringValue("repo-1"), ShareCredentialID: types.StringValue("cred-1"),
+			}}),
+			wantErr: "destination.share_credential_id",
+		},
+		{
+			name:    "retention in weeks",
+			attrs:   laptops(map[string]interface{}{"retention_type": "Weeks"}),
+			wantErr: `retention_type "Weeks" is not supported`,
+		},
+		{
+			name: "logoff trigger on a server policy",
+			attrs: laptops(map[string]interface{}{
+				"type":                 "WindowsAgentBackupServerPolicy",
+				"workstation_triggers": &AgentPolicyWorkstationTriggers{AtLogoff: types.BoolValue(true)},
+			}),
+			wantErr: "workstation_triggers are only supported by workstation policies, not WindowsAgentBackupServerPolicy",
+		},
+		{
+			name:  "protection groups from a data source",
+			attrs: laptops(map[string]interface{}{"protection_group_ids": listOf(types.StringUnknown(), types.StringUnknown())}),
+		},
+		{
+			name: "volume names from a variable",
+			attrs: laptops(map[string]interface{}{
+				"backup_mode":   "Volumes",
+				"files_scope":   (*AgentFilesScope)(nil),
+				"volumes_scope": &AgentVolumesScope{AllVolumes: types.BoolValue(false), VolumeNames: types.ListUnknown(types.StringType)},
+			}),
+		},
+		{
+			name:  "included folders from a variable",
+			attrs: laptops(map[string]interface{}{"files_scope": filesScope(listOf(types.StringUnknown()))}),
+		},
+		{
+			name:  "share path from another resource",
+			attrs: laptops(map[string]interface{}{"destination": &AgentPolicyDestination{Type: types.StringValue("SharedFolder"), SharePath: types.StringUnknown()}}),
+		},
+		{
+			name: "policy type from a variable with a logoff trigger",
+			attrs: laptops(map[string]interface{}{
+				"type":                 types.StringUnknown(),
+				"workstation_triggers": &AgentPolicyWorkstationTriggers{AtLogoff: types.BoolValue(true)},
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &AgentBackupPolicy{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// AgentBackupPolicy — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestAgentBackupPolicy_ManagedPaths checks that switching a volume-level
+// policy on a repository to file-level on a share drops the old scope and
+// target, that removed workstation triggers are disabled, and that
+// notification settings made in the console survive the update.
+func TestAgentBackupPolicy_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"volumes": map[string]interface{}{"allVolumes": false, "volumeNames": []interface{}{"C:"}},
+		"destination": map[string]interface{}{
+			"type":               "BackupRepository",
+			"backupRepositoryId": "repo-1",
+		},
+		"schedule": map[string]interface{}{
+			"runAutomatically": false,
+			"atLogOff":         map[string]interface{}{"isEnabled": true},
+			"atLock":           map[string]interface{}{"isEnabled": true},
+		},
+		"notifications": map[string]interface{}{"sendSnmp": true},
+	}
+	desired := &models.AgentBackupPolicyModel{
+		BackupMode:  models.AgentBackupModeFileLevel,
+		Files:       buildAgentFilesScopeModel(filesScope(stringList(`C:\Users`))),
+		Destination: buildAgentPolicyDestination(&AgentBackupPolicyResourceModel{Destination: shareDestination()}),
+		Schedule:    buildAgentPolicySchedule(nil, nil, true),
+	}
+	merged, err := mergeManagedPayload(current, desired, agentBackupPolicyManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+	}{
+		{"volumes", nil},
+		{"files.includedFolders", []interface{}{`C:\Users`}},
+		{"destination.backupRepositoryId", nil},
+		{"destination.sharedFolder", map[string]interface{}{"path": `\\nas01\laptops`, "credentialsId": "cred-1"}},
+		{"schedule.atLogOff", map[string]interface{}{"isEnabled": false}},
+		{"schedule.atLock", map[string]interface{}{"isEnabled": false}},
+		{"notifications", map[string]interface{}{"sendSnmp": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// AgentBackupPolicy — awaitAgentBackupPolicy
+// ---------------------------------------------------------------------------
+
+func TestAgentBackupPolicy_AwaitResult(t *testing.T) {
+	saved := models.AgentBackupPolicyModel{
+		JobModel:   models.JobModel{ID: "pol-1", Name: "Laptops", Type: models.JobTypeWindowsAgentBackupWorkstationPolicy},
+		BackupMode: models.AgentBackupModeFileLevel,
+	}
+	session := map[string]interface{}{"id": "sess-1", "sessionType": "Infrastructure", "state": "Working"}
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		id      string
+		mocks   func(*MockVeeamClient)
+		wantErr string
+	}{
+		{
+			name: "saved policy in the response",
+			raw:  map[string]interface{}{"id": "pol-1", "name": "Laptops", "type": "WindowsAgentBackupWorkstationPolicy", "backupMode": "FileLevel"},
+		},
+		{
+			name: "session after an update",
+			raw:  session,
+			id:   "pol-1",
+			mocks: func(m *MockVeeamClient) {
+				m.On("WaitForTask", mock.Anything, "sess-1").Return(nil)
+			},
+		},
+		{
+			name: "session after a create",
+			raw:  session,
+			mocks: func(m *MockVeeamClient) {
+				m.On("WaitForTask", mock.Anything, "sess-1").Return(nil)
+				m.On("GetJSON", mock.Anything, "/api/v1/jobs", mock.Anything).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*map[string]interface{}) = map[string]interface{}{
+							"data": []interface{}{
+								map[string]interface{}{"id": "job-1", "name": "Nightly"},
+								map[string]interface{}{"id": "pol-1", "name": "Laptops"},
+							},
+						}
+					}).Return(nil)
+			},
+		},
+		{
+			name: "failed session",
+			raw:  session,
+			id:   "pol-1",
+			mocks: func(m *MockVeeamClient) {
+				m.On("WaitForTask", mock.Anything, "sess-1").Return(errors.New("protection group is offline"))
+			},
+			wantErr: "async agent backup policy task sess-1 failed: protection group is offline",
+		},
+		{
+			name:    "session without an ID",
+			raw:     map[string]interface{}{"state": "Working"},
+			wantErr: "API response did not include async session ID",
+		},
+		{
+			name:    "neither policy nor session",
+			raw:     map[string]interface{}{"name": "Laptops"},
+			wantErr: "API response did not include policy ID or async session ID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			if tt.mocks != nil {
+				tt.mocks(mockClient)
+			}
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/pol-1", mock.Anything).Maybe().
+				Run(func(args mock.Arguments) {
+					*args.Get(2).(*models.AgentBackupPolicyModel) = saved
+				}).Return(nil)
+
+			got, err := (&AgentBackupPolicy{client: mockClient}).awaitAgentBackupPolicy(context.Background(), tt.raw, tt.id, "Laptops")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "pol-1", got.ID)
+			assert.Equal(t, models.AgentBackupModeFileLevel, got.BackupMode)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// AgentBackupPolicy — syncAgentBackupPolicyFromAPI
+// ---------------------------------------------------------------------------
+
+// TestAgentBackupPolicy_SyncFromAPI checks that computers added to the policy
+// in the console stay out of protection_group_ids and that a trigger-only
+// schedule section does not create a schedule block.
+func TestAgentBackupPolicy_SyncFromAPI(t *testing.T) {
+	api := &models.AgentBackupPolicyModel{
+		JobModel:   models.JobModel{ID: "pol-1", Name: "Laptops", Type: models.JobTypeWindowsAgentBackupWorkstationPolicy},
+		BackupMode: models.AgentBackupModeFileLevel,
+		Computers: []models.AgentObjectSpec{
+			{Platform: "Agent", ID: "pg-laptops", Name: "Laptops", Type: models.AgentTypeProtectionGroup},
+			{Platform: "Agent", ID: "pc-42", Name: "LAPTOP-42", Type: models.AgentTypeWindowsComputer, ProtectionGroupID: "pg-laptops"},
+		},
+		Files:       &models.AgentBackupJobFilesModel{IncludedFolders: []string{`C:\Users`}},
+		Volumes:     &models.AgentBackupJobVolumesModel{AllVolumes: true},
+		Destination: buildAgentPolicyDestination(&AgentBackupPolicyResourceModel{Destination: shareDestination(), RetentionType: types.StringValue("RestorePoints"), RetentionQuantity: types.Int64Value(30)}),
+		Schedule: &models.AgentBackupPolicyScheduleModel{
+			AtLogOff: &models.AgentBackupPolicyTriggerModel{IsEnabled: true},
+		},
+	}
+	tests := []struct {
+		name         string
+		prior        *AgentPolicyWorkstationTriggers
+		wantTriggers *AgentPolicyWorkstationTriggers
+	}{
+		{
+			name:  "trigger enabled in the console",
+			prior: nil,
+			wantTriggers: &AgentPolicyWorkstationTriggers{
+				AtLogoff: types.BoolValue(true), AtLock: types.BoolValue(false), AtTargetConnection: types.BoolValue(false),
+			},
+		},
+		{
+			name:  "trigger disabled in the console",
+			prior: &AgentPolicyWorkstationTriggers{AtLogoff: types.BoolValue(false), AtLock: types.BoolValue(true), AtTargetConnection: types.BoolValue(false)},
+			wantTriggers: &AgentPolicyWorkstationTriggers{
+				AtLogoff: types.BoolValue(true), AtLock: types.BoolValue(false), AtTargetConnection: types.BoolValue(false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := AgentBackupPolicyResourceModel{WorkstationTriggers: tt.prior}
+			syncAgentBackupPolicyFromAPI(&data, api)
+			assert.Equal(t, stringList("pg-laptops"), data.ProtectionGroupIDs)
+			assert.Nil(t, data.VolumesScope, "a volumes section on a file-level policy is ignored")
+			require.NotNil(t, data.FilesScope)
+			assert.Equal(t, stringList(`C:\Users`), data.FilesScope.IncludedFolders)
+			assert.Equal(t, shareDestination().SharePath, data.Destination.SharePath)
+			assert.True(t, data.Destination.RepositoryID.IsNull())
+			assert.Equal(t, "RestorePoints", data.RetentionType.ValueString())
+			assert.Equal(t, int64(30), data.RetentionQuantity.ValueInt64())
+			assert.Nil(t, data.Schedule)
+			assert.Equal(t, tt.wantTriggers, data.WorkstationTriggers)
+		})
+	}
+}
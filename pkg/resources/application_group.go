@@ -0,0 +1,447 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &ApplicationGroup{}
+	_ resource.ResourceWithConfigure      = &ApplicationGroup{}
+	_ resource.ResourceWithImportState    = &ApplicationGroup{}
+	_ resource.ResourceWithIdentity       = &ApplicationGroup{}
+	_ resource.ResourceWithValidateConfig = &ApplicationGroup{}
+)
+
+// ApplicationGroup implements the veeam_application_group resource.
+type ApplicationGroup struct {
+	client client.APIClient
+}
+
+// ApplicationGroupResourceModel is the Terraform state model for veeam_application_group.
+type ApplicationGroupResourceModel struct {
+	ID              types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Description     types.String         `tfsdk:"description"`
+	VirtualMachines []ApplicationGroupVM `tfsdk:"virtual_machines"`
+}
+
+// ApplicationGroupVM maps to ApplicationGroupVMModel.
+type ApplicationGroupVM struct {
+	HostName              types.String                 `tfsdk:"host_name"`
+	Name                  types.String                 `tfsdk:"name"`
+	ObjectID              types.String                 `tfsdk:"object_id"`
+	Roles                 types.List                   `tfsdk:"roles"`
+	MemoryPercent         types.Int64                  `tfsdk:"memory_percent"`
+	StartupTimeoutSeconds types.Int64                  `tfsdk:"startup_timeout_seconds"`
+	TestScripts           []ApplicationGroupTestScript `tfsdk:"test_scripts"`
+}
+
+// ApplicationGroupTestScript maps to ApplicationGroupTestScriptModel.
+type ApplicationGroupTestScript struct {
+	Name      types.String `tfsdk:"name"`
+	Path      types.String `tfsdk:"path"`
+	Arguments types.String `tfsdk:"arguments"`
+}
+
+// applicationGroupRoles lists the predefined roles in the order they are
+// documented.
+var applicationGroupRoles = []string{
+	string(models.ApplicationGroupRoleDNSServer),
+	string(models.ApplicationGroupRoleDomainController),
+	string(models.ApplicationGroupRoleGlobalCatalog),
+	string(models.ApplicationGroupRoleMailServer),
+	string(models.ApplicationGroupRoleSQLServer),
+	string(models.ApplicationGroupRoleWebServer),
+}
+
+func (r *ApplicationGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_group"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// applicationGroupImport resolves "name:<group>" import IDs.
+var applicationGroupImport = naturalKeyImport{
+	kind:         "application group",
+	listEndpoint: client.PathApplicationGroups,
+	keys:         map[string]string{"name": "name"},
+}
+
+// applicationGroupIdentity pairs the group UUID with the group name.
+var applicationGroupIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Application group name.",
+	lookup:         &applicationGroupImport,
+}
+
+func (r *ApplicationGroup) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = applicationGroupIdentity.schema()
+}
+
+func (r *ApplicationGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	vmAttrs := labInventoryObjectAttributes("VM")
+	vmAttrs["roles"] = schema.ListAttribute{
+		MarkdownDescription: "Roles whose predefined tests are run against the VM: " +
+			"`" + strings.Join(applicationGroupRoles, "`, `") + "`.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	vmAttrs["memory_percent"] = schema.Int64Attribute{
+		MarkdownDescription: "Share of the production memory given to the VM in the lab, `10`–`100`. " +
+			"Defaults to `100`.",
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(100),
+	}
+	vmAttrs["startup_timeout_seconds"] = schema.Int64Attribute{
+		MarkdownDescription: "Seconds SureBackup waits for the VM to boot before the test fails. " +
+			"Defaults to `600`.",
+		Optional: true,
+		Computed: true,
+		Default:  int64default.StaticInt64(600),
+	}
+	vmAttrs["test_scripts"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Custom test scripts run on the backup server against the VM.",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Test name shown in the job session. Must be unique per VM.",
+					Required:            true,
+				},
+				"path": schema.StringAttribute{
+					MarkdownDescription: "Path of the script on the backup server.",
+					Required:            true,
+				},
+				"arguments": schema.StringAttribute{
+					MarkdownDescription: "Script arguments. `%vm_ip%` and `%vm_fqdn%` are replaced with " +
+						"the address and name of the VM in the lab.",
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam SureBackup application group: the ordered VMs that are started " +
+			"in a virtual lab before the VMs under test, with their roles and test scripts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the application group (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the application group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"virtual_machines": schema.ListNestedAttribute{
+				MarkdownDescription: "VMs of the group, started in list order. Each VM must be protected by " +
+					"a backup job.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: vmAttrs,
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks VM roles, memory shares, startup timeouts and test
+// script names at plan time.
+func (r *ApplicationGroup) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ApplicationGroupResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+	if err := validateApplicationGroup(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid application group configuration", err.Error())
+	}
+}
+
+func (r *ApplicationGroup) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *ApplicationGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateApplicationGroup(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid application group configuration", err.Error())
+		return
+	}
+
+	var result models.ApplicationGroupModel
+	if err := r.client.PostJSON(ctx, client.PathApplicationGroups, buildApplicationGroupSpec(&data), &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create application group",
+			fmt.Sprintf("POST %s: %s", client.PathApplicationGroups, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create application group",
+			fmt.Sprintf("POST %s returned no application group ID.", client.PathApplicationGroups))
+		return
+	}
+
+	syncApplicationGroupFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(applicationGroupIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *ApplicationGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathApplicationGroupByID, data.ID.ValueString())
+	var result models.ApplicationGroupModel
+	if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
+		if isSureBackupObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read application group",
+			fmt.Sprintf("GET %s: %s", endpoint, err))
+		return
+	}
+
+	syncApplicationGroupFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(applicationGroupIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *ApplicationGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateApplicationGroup(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid application group configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	endpoint := fmt.Sprintf(client.PathApplicationGroupByID, data.ID.ValueString())
+	var result models.ApplicationGroupModel
+	if err := putMergedPayload(ctx, r.client, endpoint, buildApplicationGroupSpec(&data), &result); err != nil {
+		resp.Diagnostics.AddError("Failed to update application group",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncApplicationGroupFromAPI(&data, &result)
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(applicationGroupIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *ApplicationGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApplicationGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathApplicationGroupByID, data.ID.ValueString())
+	if err := r.client.DeleteJSON(ctx, endpoint); err != nil {
+		resp.Diagnostics.AddError("Failed to delete application group",
+			fmt.Sprintf("DELETE %s (application group %s): %s", endpoint, data.ID.ValueString(), err))
+	}
+}
+
+func (r *ApplicationGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	applicationGroupIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewApplicationGroup returns a new veeam_application_group resource instance.
+func NewApplicationGroup() resource.Resource {
+	return &ApplicationGroup{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// validateApplicationGroup checks that each VM is listed once with valid
+// roles, a memory share between 10 and 100 percent, a positive startup
+// timeout and uniquely named test scripts.
+func validateApplicationGroup(data *ApplicationGroupResourceModel) error {
+	if len(data.VirtualMachines) == 0 {
+		return errors.New("virtual_machines must list at least one VM")
+	}
+	vms := map[string]bool{}
+	for i, vm := range data.VirtualMachines {
+		path := fmt.Sprintf("virtual_machines[%d]", i)
+		if isConfigured(vm.HostName) && isConfigured(vm.ObjectID) {
+			key := vm.HostName.ValueString() + "/" + vm.ObjectID.ValueString()
+			if vms[key] {
+				return fmt.Errorf("%s (%s): VM is listed more than once", path, vm.Name.ValueString())
+			}
+			vms[key] = true
+		}
+		if err := validateApplicationGroupRoles(path+".roles", vm.Roles); err != nil {
+			return err
+		}
+		if isConfigured(vm.MemoryPercent) && (vm.MemoryPercent.ValueInt64() < 10 || vm.MemoryPercent.ValueInt64() > 100) {
+			return fmt.Errorf("%s: memory_percent must be between 10 and 100", path)
+		}
+		if isConfigured(vm.StartupTimeoutSeconds) && vm.StartupTimeoutSeconds.ValueInt64() < 1 {
+			return fmt.Errorf("%s: startup_timeout_seconds must be at least 1", path)
+		}
+		scripts := map[string]bool{}
+		for j, s := range vm.TestScripts {
+			if !isConfigured(s.Name) {
+				continue
+			}
+			if scripts[s.Name.ValueString()] {
+				return fmt.Errorf("%s.test_scripts[%d]: test %q is listed more than once", path, j, s.Name.ValueString())
+			}
+			scripts[s.Name.ValueString()] = true
+		}
+	}
+	return nil
+}
+
+// validateApplicationGroupRoles checks a list of SureBackup VM roles. It is
+// shared with the linked job roles of veeam_surebackup_job.
+func validateApplicationGroupRoles(path string, roles types.List) error {
+	if !isConfigured(roles) {
+		return nil
+	}
+	seen := map[string]bool{}
+	for i, e := range roles.Elements() {
+		v, ok := e.(types.String)
+		if !ok || !isConfigured(v) {
+			continue
+		}
+		role := v.ValueString()
+		if !slices.Contains(applicationGroupRoles, role) {
+			return fmt.Errorf("%s[%d]: %q is not supported; expected one of %s",
+				path, i, role, strings.Join(applicationGroupRoles, ", "))
+		}
+		if seen[role] {
+			return fmt.Errorf("%s[%d]: %q is listed more than once", path, i, role)
+		}
+		seen[role] = true
+	}
+	return nil
+}
+
+func buildApplicationGroupRoles(roles types.List) []models.EApplicationGroupVMRole {
+	out := []models.EApplicationGroupVMRole{}
+	for _, role := range listStrings(roles) {
+		out = append(out, models.EApplicationGroupVMRole(role))
+	}
+	return out
+}
+
+func syncApplicationGroupRoles(roles []models.EApplicationGroupVMRole) types.List {
+	values := make([]string, 0, len(roles))
+	for _, role := range roles {
+		values = append(values, string(role))
+	}
+	return stringListOrNull(values)
+}
+
+// buildApplicationGroupSpec converts the plan into the request body. Roles and
+// test scripts are always sent so that removing them takes effect.
+func buildApplicationGroupSpec(data *ApplicationGroupResourceModel) *models.ApplicationGroupSpec {
+	spec := &models.ApplicationGroupSpec{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		Type:            models.VirtualLabTypeVSphere,
+		VirtualMachines: make([]models.ApplicationGroupVMModel, 0, len(data.VirtualMachines)),
+	}
+	for _, vm := range data.VirtualMachines {
+		m := models.ApplicationGroupVMModel{
+			VMObject: buildLabObject(&LabInventoryObject{HostName: vm.HostName, Name: vm.Name, ObjectID: vm.ObjectID},
+				models.VmwareTypeVirtualMachine),
+			Roles:                   buildApplicationGroupRoles(vm.Roles),
+			MemoryAllocationPercent: int(vm.MemoryPercent.ValueInt64()),
+			MaxBootTimeSec:          int(vm.StartupTimeoutSeconds.ValueInt64()),
+			TestScripts:             make([]models.ApplicationGroupTestScriptModel, 0, len(vm.TestScripts)),
+		}
+		for _, s := range vm.TestScripts {
+			m.TestScripts = append(m.TestScripts, models.ApplicationGroupTestScriptModel{
+				Name:      s.Name.ValueString(),
+				Path:      s.Path.ValueString(),
+				Arguments: s.Arguments.ValueString(),
+			})
+		}
+		spec.VirtualMachines = append(spec.VirtualMachines, m)
+	}
+	return spec
+}
+
+// syncApplicationGroupFromAPI refreshes the state from a group response. The
+// API returns the VMs in start order, which is also the configured order.
+func syncApplicationGroupFromAPI(data *ApplicationGroupResourceModel, api *models.ApplicationGroupModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+
+	vms := make([]ApplicationGroupVM, 0, len(api.VirtualMachines))
+	for _, vm := range api.VirtualMachines {
+		obj := syncLabObjectFromAPI(vm.VMObject)
+		entry := ApplicationGroupVM{
+			HostName:              obj.HostName,
+			Name:                  obj.Name,
+			ObjectID:              obj.ObjectID,
+			Roles:                 syncApplicationGroupRoles(vm.Roles),
+			MemoryPercent:         types.Int64Value(int64(vm.MemoryAllocationPercent)),
+			StartupTimeoutSeconds: types.Int64Value(int64(vm.MaxBootTimeSec)),
+		}
+		for _, s := range vm.TestScripts {
+			entry.TestScripts = append(entry.TestScripts, ApplicationGroupTestScript{
+				Name:      types.StringValue(s.Name),
+				Path:      types.StringValue(s.Path),
+				Arguments: stringOrNull(s.Arguments),
+			})
+		}
+		vms = append(vms, entry)
+	}
+	data.VirtualMachines = vms
+}
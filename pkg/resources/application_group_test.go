@@ -0,0 +1,260 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// groupVM is a vcsa01 VM with the given roles and default boot settings.
+func groupVM(name, objectID string, roles ...string) ApplicationGroupVM {
+	vm := ApplicationGroupVM{
+		HostName: types.StringValue("vcsa01.corp.local"),
+		Name:     types.StringValue(name),
+		ObjectID: types.StringValue(objectID),
+		Roles:    types.ListNull(types.StringType),
+	}
+	if len(roles) > 0 {
+		vm.Roles = stringList(roles...)
+	}
+	return vm
+}
+
+// salesDBTest is the custom test script run against sql01.
+var salesDBTest = ApplicationGroupTestScript{
+	Name:      types.StringValue("Sales DB"),
+	Path:      types.StringValue(`C:\Scripts\Test-SalesDb.ps1`),
+	Arguments: types.StringValue("-Server %vm_ip%"),
+}
+
+// ---------------------------------------------------------------------------
+// ApplicationGroup — buildApplicationGroupSpec
+// ---------------------------------------------------------------------------
+
+func TestApplicationGroup_BuildSpec(t *testing.T) {
+	sql := groupVM("sql01", "vm-205", "SQLServer")
+	sql.MemoryPercent = types.Int64Value(50)
+	sql.StartupTimeoutSeconds = types.Int64Value(600)
+	sql.TestScripts = []ApplicationGroupTestScript{salesDBTest}
+
+	tests := []struct {
+		name string
+		vm   ApplicationGroupVM
+		want models.ApplicationGroupVMModel
+	}{
+		{
+			name: "SQL Server with a custom test",
+			vm:   sql,
+			want: models.ApplicationGroupVMModel{
+				VMObject: models.VmwareObjectSpec{
+					Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "sql01",
+					Type: models.VmwareTypeVirtualMachine, ObjectID: "vm-205",
+				},
+				Roles:                   []models.EApplicationGroupVMRole{models.ApplicationGroupRoleSQLServer},
+				MemoryAllocationPercent: 50,
+				MaxBootTimeSec:          600,
+				TestScripts: []models.ApplicationGroupTestScriptModel{
+					{Name: "Sales DB", Path: `C:\Scripts\Test-SalesDb.ps1`, Arguments: "-Server %vm_ip%"},
+				},
+			},
+		},
+		{
+			name: "VM without roles or tests",
+			vm:   groupVM("app01", "vm-310"),
+			want: models.ApplicationGroupVMModel{
+				VMObject: models.VmwareObjectSpec{
+					Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "app01",
+					Type: models.VmwareTypeVirtualMachine, ObjectID: "vm-310",
+				},
+				Roles:       []models.EApplicationGroupVMRole{},
+				TestScripts: []models.ApplicationGroupTestScriptModel{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := buildApplicationGroupSpec(&ApplicationGroupResourceModel{
+				Name:            types.StringValue("Core-Services"),
+				VirtualMachines: []ApplicationGroupVM{groupVM("dc01", "vm-101", "DNSServer", "DomainController"), tt.vm},
+			})
+			assert.Equal(t, models.VirtualLabTypeVSphere, spec.Type)
+			require.Len(t, spec.VirtualMachines, 2)
+			assert.Equal(t, "dc01", spec.VirtualMachines[0].VMObject.Name, "VMs are sent in start order")
+			assert.Equal(t, tt.want, spec.VirtualMachines[1])
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ApplicationGroup — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestApplicationGroup_ValidateConfig(t *testing.T) {
+	dc := groupVM("dc01", "vm-101", "DNSServer", "DomainController", "GlobalCatalog")
+	with := func(vm ApplicationGroupVM, change func(*ApplicationGroupVM)) ApplicationGroupVM {
+		change(&vm)
+		return vm
+	}
+
+	tests := []struct {
+		name    string
+		vms     []ApplicationGroupVM
+		wantErr string
+	}{
+		{
+			name: "domain controller and SQL Server",
+			vms:  []ApplicationGroupVM{dc, groupVM("sql01", "vm-205", "SQLServer")},
+		},
+		{
+			name: "VM without roles",
+			vms:  []ApplicationGroupVM{groupVM("app01", "vm-310")},
+		},
+		{
+			name:    "no VMs",
+			vms:     []ApplicationGroupVM{},
+			wantErr: "virtual_machines must list at least one VM",
+		},
+		{
+			name:    "VM listed twice",
+			vms:     []ApplicationGroupVM{dc, groupVM("dc01-copy", "vm-101")},
+			wantErr: "virtual_machines[1] (dc01-copy): VM is listed more than once",
+		},
+		{
+			name:    "Exchange role",
+			vms:     []ApplicationGroupVM{dc, groupVM("mail01", "vm-410", "Exchange")},
+			wantErr: `virtual_machines[1].roles[0]: "Exchange" is not supported`,
+		},
+		{
+			name:    "role listed twice",
+			vms:     []ApplicationGroupVM{groupVM("dc01", "vm-101", "DNSServer", "DNSServer")},
+			wantErr: `virtual_machines[0].roles[1]: "DNSServer" is listed more than once`,
+		},
+		{
+			name:    "memory share below 10 percent",
+			vms:     []ApplicationGroupVM{with(dc, func(vm *ApplicationGroupVM) { vm.MemoryPercent = types.Int64Value(5) })},
+			wantErr: "virtual_machines[0]: memory_percent must be between 10 and 100",
+		},
+		{
+			name:    "no time to start",
+			vms:     []ApplicationGroupVM{with(dc, func(vm *ApplicationGroupVM) { vm.StartupTimeoutSeconds = types.Int64Value(0) })},
+			wantErr: "virtual_machines[0]: startup_timeout_seconds must be at least 1",
+		},
+		{
+			name: "test script listed twice",
+			vms: []ApplicationGroupVM{with(groupVM("sql01", "vm-205", "SQLServer"), func(vm *ApplicationGroupVM) {
+				vm.TestScripts = []ApplicationGroupTestScript{salesDBTest, salesDBTest}
+			})},
+			wantErr: `virtual_machines[0].test_scripts[1]: test "Sales DB" is listed more than once`,
+		},
+		{
+			name: "VM IDs from the vSphere data source",
+			vms: []ApplicationGroupVM{
+				with(dc, func(vm *ApplicationGroupVM) { vm.ObjectID = types.StringUnknown() }),
+				with(groupVM("sql01", "", "SQLServer"), func(vm *ApplicationGroupVM) { vm.ObjectID = types.StringUnknown() }),
+			},
+		},
+		{
+			name: "vCenter Server from a variable",
+			vms: []ApplicationGroupVM{
+				with(dc, func(vm *ApplicationGroupVM) { vm.HostName = types.StringUnknown() }),
+				with(groupVM("sql01", "vm-101", "SQLServer"), func(vm *ApplicationGroupVM) { vm.HostName = types.StringUnknown() }),
+			},
+		},
+		{
+			name: "roles from a variable",
+			vms: []ApplicationGroupVM{with(dc, func(vm *ApplicationGroupVM) {
+				vm.Roles = types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown(), types.StringUnknown()})
+			})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &ApplicationGroup{}, map[string]interface{}{"name": "Core-Services", "virtual_machines": tt.vms})
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ApplicationGroup — syncApplicationGroupFromAPI
+// ---------------------------------------------------------------------------
+
+func TestApplicationGroup_SyncFromAPI(t *testing.T) {
+	var data ApplicationGroupResourceModel
+	syncApplicationGroupFromAPI(&data, &models.ApplicationGroupModel{
+		ID:   "grp-1",
+		Name: "Core-Services",
+		VirtualMachines: []models.ApplicationGroupVMModel{
+			{
+				VMObject:                models.VmwareObjectSpec{HostName: "vcsa01.corp.local", Name: "app01", ObjectID: "vm-310"},
+				MemoryAllocationPercent: 100,
+				MaxBootTimeSec:          1800,
+			},
+			{
+				VMObject:                models.VmwareObjectSpec{HostName: "vcsa01.corp.local", Name: "sql01", ObjectID: "vm-205"},
+				Roles:                   []models.EApplicationGroupVMRole{models.ApplicationGroupRoleSQLServer},
+				MemoryAllocationPercent: 50,
+				MaxBootTimeSec:          600,
+				TestScripts:             []models.ApplicationGroupTestScriptModel{{Name: "Sales DB", Path: `C:\Scripts\Test-SalesDb.ps1`}},
+			},
+		},
+	})
+
+	app := groupVM("app01", "vm-310")
+	app.MemoryPercent = types.Int64Value(100)
+	app.StartupTimeoutSeconds = types.Int64Value(1800)
+	sql := groupVM("sql01", "vm-205", "SQLServer")
+	sql.MemoryPercent = types.Int64Value(50)
+	sql.StartupTimeoutSeconds = types.Int64Value(600)
+	sql.TestScripts = []ApplicationGroupTestScript{{
+		Name:      types.StringValue("Sales DB"),
+		Path:      types.StringValue(`C:\Scripts\Test-SalesDb.ps1`),
+		Arguments: types.StringNull(),
+	}}
+	assert.Equal(t, []ApplicationGroupVM{app, sql}, data.VirtualMachines)
+}
+
+// ---------------------------------------------------------------------------
+// ApplicationGroup — Read
+// ---------------------------------------------------------------------------
+
+func TestApplicationGroup_Read_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantRemoved bool
+	}{
+		{name: "API error code", err: &models.APIError{ErrorCode: "NotFound", Message: "Application group not found"}, wantRemoved: true},
+		{name: "plain 404", err: errors.New("HTTP 404: NotFound"), wantRemoved: true},
+		{name: "server error", err: errors.New("HTTP 500: Internal Server Error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/applicationGroups/grp-1", mock.Anything).Return(tt.err)
+			r := &ApplicationGroup{client: mockClient}
+
+			state := buildNullResourceState(r)
+			require.False(t, state.SetAttribute(context.Background(), path.Root("id"), "grp-1").HasError())
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+			assert.Equal(t, !tt.wantRemoved, resp.Diagnostics.HasError())
+		})
+	}
+}
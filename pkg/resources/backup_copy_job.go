@@ -0,0 +1,557 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &BackupCopyJob{}
+	_ resource.ResourceWithConfigure      = &BackupCopyJob{}
+	_ resource.ResourceWithImportState    = &BackupCopyJob{}
+	_ resource.ResourceWithIdentity       = &BackupCopyJob{}
+	_ resource.ResourceWithValidateConfig = &BackupCopyJob{}
+)
+
+// BackupCopyJob implements the veeam_backup_copy_job resource.
+type BackupCopyJob struct {
+	client client.APIClient
+}
+
+// BackupCopyJobResourceModel is the Terraform state model for veeam_backup_copy_job.
+type BackupCopyJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	// Mode is Immediate or Periodic.
+	Mode       types.String `tfsdk:"mode"`
+	IsDisabled types.Bool   `tfsdk:"is_disabled"`
+
+	// Source — exactly one of the two lists is set.
+	SourceJobIDs        types.List `tfsdk:"source_job_ids"`
+	SourceRepositoryIDs types.List `tfsdk:"source_repository_ids"`
+
+	// Target and retention.
+	RepositoryID      types.String          `tfsdk:"repository_id"`
+	RetentionType     types.String          `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64           `tfsdk:"retention_quantity"`
+	GFSPolicy         *JobGFSPolicy         `tfsdk:"gfs_policy"`
+	Encryption        *JobStorageEncryption `tfsdk:"encryption"`
+
+	// WANAccelerator routes the copy through a WAN accelerator pair.
+	WANAccelerator *BackupCopyWANAccelerator `tfsdk:"wan_accelerator"`
+	// Schedule is the periodic schedule (Periodic mode only).
+	Schedule *BackupCopySchedule `tfsdk:"schedule"`
+	// CopyWindow restricts the hours in which data is transferred.
+	CopyWindow *JobBackupWindow `tfsdk:"copy_window"`
+}
+
+// BackupCopyWANAccelerator maps to the WAN accelerator IDs of
+// BackupCopyJobDataTransferModel.
+type BackupCopyWANAccelerator struct {
+	SourceID types.String `tfsdk:"source_id"`
+	TargetID types.String `tfsdk:"target_id"`
+}
+
+// BackupCopySchedule maps to the daily / periodically parts of
+// BackupCopyJobScheduleModel. Exactly one of daily_local_time or
+// periodically_frequency is set.
+type BackupCopySchedule struct {
+	DailyLocalTime types.String `tfsdk:"daily_local_time"`
+	// DailyKind is Everyday or WeekDays.
+	DailyKind types.String `tfsdk:"daily_kind"`
+	// PeriodicallyKind is Hours or Minutes.
+	PeriodicallyKind      types.String `tfsdk:"periodically_kind"`
+	PeriodicallyFrequency types.Int64  `tfsdk:"periodically_frequency"`
+}
+
+func (r *BackupCopyJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_copy_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// backupCopyJobIdentity keys backup copy jobs by UUID and name.
+var backupCopyJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Backup copy job name.",
+	lookup:         &backupJobImport,
+}
+
+var backupCopyJobResource = jobResource{
+	typeName: "veeam_backup_copy_job",
+	kind:     "backup copy job",
+	jobTypes: []models.EJobType{models.JobTypeBackupCopy},
+	hint:     "Use veeam_backup_job for backup jobs.",
+}
+
+func (r *BackupCopyJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = backupCopyJobIdentity.schema()
+}
+
+func (r *BackupCopyJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam backup copy job that copies restore points of backup jobs " +
+			"or repositories to a second repository, typically offsite.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the backup copy job (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the backup copy job. Must be unique across all jobs.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+				Required:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Copy mode: `Immediate` copies every new restore point as soon as it " +
+					"appears, `Periodic` copies the latest restore point on `schedule`. " +
+					"Changing this forces a new job.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+					"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_job_ids": schema.ListAttribute{
+				MarkdownDescription: "UUIDs of the backup jobs whose restore points are copied. " +
+					"Set exactly one of `source_job_ids` or `source_repository_ids`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_repository_ids": schema.ListAttribute{
+				MarkdownDescription: "UUIDs of repositories whose backups are all copied. " +
+					"Set exactly one of `source_job_ids` or `source_repository_ids`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"repository_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the target repository or scale-out backup repository.",
+				Required:            true,
+			},
+			"retention_type": schema.StringAttribute{
+				MarkdownDescription: "Retention unit: `RestorePoints` (default) or `Days`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(models.RetentionPolicyTypeRestorePoints)),
+			},
+			"retention_quantity": schema.Int64Attribute{
+				MarkdownDescription: "Number of restore points or days kept in the target. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+			},
+			"gfs_policy": gfsPolicyAttribute(),
+			"encryption": encryptionAttribute(),
+			"wan_accelerator": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sends data through a WAN accelerator pair. " +
+					"When omitted, data is transferred directly.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"source_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the WAN accelerator at the source site.",
+						Required:            true,
+					},
+					"target_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the WAN accelerator at the target site.",
+						Required:            true,
+					},
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				MarkdownDescription: "When a `Periodic` job runs. Set exactly one of " +
+					"`daily_local_time` or `periodically_frequency`. Not used in `Immediate` mode.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"daily_local_time": schema.StringAttribute{
+						MarkdownDescription: "Daily start time in `HH:MM` (server local time).",
+						Optional:            true,
+					},
+					"daily_kind": schema.StringAttribute{
+						MarkdownDescription: "Days of a daily run: `Everyday` (default) or `WeekDays`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(models.DailyKindsEveryday)),
+					},
+					"periodically_kind": schema.StringAttribute{
+						MarkdownDescription: "Unit of `periodically_frequency`: `Hours` (default) or `Minutes`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(models.PeriodicallyHours)),
+					},
+					"periodically_frequency": schema.Int64Attribute{
+						MarkdownDescription: "Runs the job every N hours or minutes.",
+						Optional:            true,
+					},
+				},
+			},
+			"copy_window": backupWindowAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks the source and mode-dependent schedule rules at plan
+// time instead of after the POST is sent.
+func (r *BackupCopyJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BackupCopyJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// A block built from another resource's output is unknown until apply.
+		return
+	}
+	if err := validateBackupCopyJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid backup copy job configuration", err.Error())
+	}
+}
+
+func (r *BackupCopyJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *BackupCopyJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupCopyJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateBackupCopyJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid backup copy job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildBackupCopyJobModel(&data, false)
+	spec := &models.BackupCopyJobSpec{
+		JobSpec:       models.JobSpec{Name: model.Name, Type: model.Type},
+		Description:   model.Description,
+		Mode:          model.Mode,
+		SourceObjects: model.SourceObjects,
+		Storage:       model.Storage,
+		DataTransfer:  model.DataTransfer,
+		Schedule:      model.Schedule,
+	}
+
+	var result models.BackupCopyJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create backup copy job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create backup copy job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncBackupCopyJobFromAPI(&data, &result)
+
+	resp.Diagnostics.Append(backupCopyJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, backupCopyJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *BackupCopyJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupCopyJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.BackupCopyJobModel
+	if !backupCopyJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncBackupCopyJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(backupCopyJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *BackupCopyJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BackupCopyJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateBackupCopyJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid backup copy job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.BackupCopyJobModel
+	payload := buildBackupCopyJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, backupCopyJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update backup copy job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncBackupCopyJobFromAPI(&data, &result)
+	}
+
+	resp.Diagnostics.Append(backupCopyJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, backupCopyJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *BackupCopyJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BackupCopyJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupCopyJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *BackupCopyJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	backupCopyJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewBackupCopyJob returns a new veeam_backup_copy_job resource instance.
+func NewBackupCopyJob() resource.Resource {
+	return &BackupCopyJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// backupCopyJobManagedPaths are the fields an update clears on the server when
+// the plan leaves them out, so dropping gfs_policy, an encryption key,
+// wan_accelerator or a schedule block takes effect instead of being merged
+// back from the current job.
+var backupCopyJobManagedPaths = []string{
+	"sourceObjects.jobIds",
+	"sourceObjects.repositoryIds",
+	"storage.gfsPolicy",
+	"storage.encryption.encryptionPasswordId",
+	"storage.encryption.kmsServerId",
+	"dataTransfer.sourceWANAcceleratorId",
+	"dataTransfer.targetWANAcceleratorId",
+	"schedule.daily",
+	"schedule.periodically",
+	"schedule.backupWindow",
+}
+
+// validateBackupCopyJob checks the mode-dependent rules: exactly one source
+// list, a schedule only (and always) in Periodic mode with exactly one of its
+// daily or periodic forms, and a well-formed copy_window. Values that are
+// still unknown are skipped; Create and Update see them resolved.
+func validateBackupCopyJob(data *BackupCopyJobResourceModel) error {
+	mode := models.EBackupCopyJobMode(data.Mode.ValueString())
+	if !data.Mode.IsUnknown() {
+		switch mode {
+		case models.BackupCopyJobModeImmediate, models.BackupCopyJobModePeriodic:
+		default:
+			return fmt.Errorf("unsupported mode %q; expected Immediate or Periodic", mode)
+		}
+	}
+
+	if !data.SourceJobIDs.IsUnknown() && !data.SourceRepositoryIDs.IsUnknown() {
+		hasJobs := !data.SourceJobIDs.IsNull() && len(data.SourceJobIDs.Elements()) > 0
+		hasRepos := !data.SourceRepositoryIDs.IsNull() && len(data.SourceRepositoryIDs.Elements()) > 0
+		if hasJobs == hasRepos {
+			return errors.New("set exactly one of source_job_ids or source_repository_ids")
+		}
+	}
+
+	s := data.Schedule
+	switch {
+	case data.Mode.IsUnknown():
+	case mode == models.BackupCopyJobModeImmediate && s != nil:
+		return errors.New("schedule is only supported in Periodic mode; use copy_window to restrict Immediate copies")
+	case mode == models.BackupCopyJobModePeriodic && s == nil:
+		return errors.New("Periodic mode requires a schedule block")
+	}
+	if s != nil && !s.DailyLocalTime.IsUnknown() && !s.PeriodicallyFrequency.IsUnknown() {
+		daily := !s.DailyLocalTime.IsNull()
+		periodic := !s.PeriodicallyFrequency.IsNull()
+		if daily == periodic {
+			return errors.New("schedule requires exactly one of daily_local_time or periodically_frequency")
+		}
+		if periodic && s.PeriodicallyFrequency.ValueInt64() <= 0 {
+			return errors.New("schedule.periodically_frequency must be greater than zero")
+		}
+	}
+
+	if err := validateBackupWindowDays(data.CopyWindow); err != nil {
+		return fmt.Errorf("copy_window: %w", err)
+	}
+	return nil
+}
+
+// buildBackupCopyJobModel converts the plan into the full job model used for
+// PUT; Create derives the POST spec from it.
+func buildBackupCopyJobModel(data *BackupCopyJobResourceModel, isDisabled bool) *models.BackupCopyJobModel {
+	m := &models.BackupCopyJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeBackupCopy,
+			IsDisabled: isDisabled,
+		},
+		Description:   data.Description.ValueString(),
+		Mode:          models.EBackupCopyJobMode(data.Mode.ValueString()),
+		SourceObjects: &models.BackupCopyJobSourceObjectsModel{},
+		Storage: &models.BackupCopyJobStorageModel{
+			TargetRepositoryID: data.RepositoryID.ValueString(),
+			RetentionPolicy: &models.BackupJobRetentionPolicySettings{
+				Type:     models.ERetentionPolicyType(data.RetentionType.ValueString()),
+				Quantity: int(data.RetentionQuantity.ValueInt64()),
+			},
+			GFSPolicy:  buildGFSPolicyModel(data.GFSPolicy),
+			Encryption: buildEncryptionModel(data.Encryption),
+		},
+		DataTransfer: &models.BackupCopyJobDataTransferModel{},
+	}
+
+	if !data.SourceJobIDs.IsNull() && !data.SourceJobIDs.IsUnknown() {
+		data.SourceJobIDs.ElementsAs(context.Background(), &m.SourceObjects.JobIDs, false)
+	}
+	if !data.SourceRepositoryIDs.IsNull() && !data.SourceRepositoryIDs.IsUnknown() {
+		data.SourceRepositoryIDs.ElementsAs(context.Background(), &m.SourceObjects.RepositoryIDs, false)
+	}
+
+	if w := data.WANAccelerator; w != nil {
+		m.DataTransfer = &models.BackupCopyJobDataTransferModel{
+			UseWanAccelerators:     true,
+			SourceWANAcceleratorID: w.SourceID.ValueString(),
+			TargetWANAcceleratorID: w.TargetID.ValueString(),
+		}
+	}
+
+	schedule := &models.BackupCopyJobScheduleModel{BackupWindow: buildBackupWindowModel(data.CopyWindow)}
+	if s := data.Schedule; s != nil {
+		if isConfigured(s.DailyLocalTime) {
+			schedule.Daily = &models.ScheduleDailyModel{
+				IsEnabled: true,
+				LocalTime: s.DailyLocalTime.ValueString(),
+				DailyKind: models.EDailyKinds(s.DailyKind.ValueString()),
+			}
+		} else {
+			schedule.Periodically = &models.SchedulePeriodicallyModel{
+				IsEnabled:        true,
+				PeriodicallyKind: models.EPeriodicallyKinds(s.PeriodicallyKind.ValueString()),
+				Frequency:        int(s.PeriodicallyFrequency.ValueInt64()),
+			}
+		}
+	}
+	if schedule.Daily != nil || schedule.Periodically != nil || schedule.BackupWindow != nil {
+		m.Schedule = schedule
+	}
+	return m
+}
+
+// syncBackupCopyJobFromAPI refreshes the state from a job response.
+func syncBackupCopyJobFromAPI(data *BackupCopyJobResourceModel, api *models.BackupCopyJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+	if api.Mode != "" {
+		data.Mode = types.StringValue(string(api.Mode))
+	}
+
+	data.SourceJobIDs = types.ListNull(types.StringType)
+	data.SourceRepositoryIDs = types.ListNull(types.StringType)
+	if src := api.SourceObjects; src != nil {
+		if len(src.JobIDs) > 0 {
+			data.SourceJobIDs, _ = types.ListValueFrom(context.Background(), types.StringType, src.JobIDs)
+		}
+		if len(src.RepositoryIDs) > 0 {
+			data.SourceRepositoryIDs, _ = types.ListValueFrom(context.Background(), types.StringType, src.RepositoryIDs)
+		}
+	}
+
+	if st := api.Storage; st != nil {
+		data.RepositoryID = types.StringValue(st.TargetRepositoryID)
+		if rp := st.RetentionPolicy; rp != nil {
+			data.RetentionType = types.StringValue(string(rp.Type))
+			data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+		}
+		data.GFSPolicy = syncGFSPolicyFromAPI(st.GFSPolicy)
+		data.Encryption = syncEncryptionFromAPI(st.Encryption)
+	}
+
+	data.WANAccelerator = nil
+	if dt := api.DataTransfer; dt != nil && dt.UseWanAccelerators {
+		data.WANAccelerator = &BackupCopyWANAccelerator{
+			SourceID: types.StringValue(dt.SourceWANAcceleratorID),
+			TargetID: types.StringValue(dt.TargetWANAcceleratorID),
+		}
+	}
+
+	existingWindow := data.CopyWindow
+	data.Schedule = nil
+	data.CopyWindow = nil
+	if s := api.Schedule; s != nil {
+		switch {
+		case s.Daily != nil && s.Daily.IsEnabled:
+			data.Schedule = &BackupCopySchedule{
+				DailyLocalTime:        types.StringValue(s.Daily.LocalTime),
+				DailyKind:             types.StringValue(string(s.Daily.DailyKind)),
+				PeriodicallyKind:      types.StringValue(string(models.PeriodicallyHours)),
+				PeriodicallyFrequency: types.Int64Null(),
+			}
+		case s.Periodically != nil && s.Periodically.IsEnabled:
+			data.Schedule = &BackupCopySchedule{
+				DailyLocalTime:        types.StringNull(),
+				DailyKind:             types.StringValue(string(models.DailyKindsEveryday)),
+				PeriodicallyKind:      types.StringValue(string(s.Periodically.PeriodicallyKind)),
+				PeriodicallyFrequency: types.Int64Value(int64(s.Periodically.Frequency)),
+			}
+		}
+		data.CopyWindow = syncBackupWindowFromAPI(existingWindow, s.BackupWindow)
+	}
+}
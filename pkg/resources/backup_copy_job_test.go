@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func stringList(values ...string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// copyWindow permits the given hours on Monday.
+func copyWindow(hours ...int64) *JobBackupWindow {
+	elems := make([]attr.Value, 0, len(hours))
+	for _, h := range hours {
+		elems = append(elems, types.Int64Value(h))
+	}
+	return &JobBackupWindow{Days: []JobBackupWindowDay{{
+		Day:   types.StringValue("Monday"),
+		Hours: types.SetValueMust(types.Int64Type, elems),
+	}}}
+}
+
+// ---------------------------------------------------------------------------
+// BackupCopyJob — buildBackupCopyJobModel
+// ---------------------------------------------------------------------------
+
+func TestBackupCopyJob_BuildModel(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     BackupCopyJobResourceModel
+		sources  *models.BackupCopyJobSourceObjectsModel
+		schedule *models.BackupCopyJobScheduleModel
+		transfer *models.BackupCopyJobDataTransferModel
+	}{
+		{
+			name: "periodic daily copy of jobs",
+			data: BackupCopyJobResourceModel{
+				Mode:         types.StringValue("Periodic"),
+				SourceJobIDs: stringList("job-1", "job-2"),
+				Schedule: &BackupCopySchedule{
+					DailyLocalTime: types.StringValue("22:00"),
+					DailyKind:      types.StringValue("WeekDays"),
+				},
+			},
+			sources: &models.BackupCopyJobSourceObjectsModel{JobIDs: []string{"job-1", "job-2"}},
+			schedule: &models.BackupCopyJobScheduleModel{
+				Daily: &models.ScheduleDailyModel{IsEnabled: true, LocalTime: "22:00", DailyKind: models.DailyKindsWeekdays},
+			},
+			transfer: &models.BackupCopyJobDataTransferModel{},
+		},
+		{
+			name: "periodic copy every six hours",
+			data: BackupCopyJobResourceModel{
+				Mode:         types.StringValue("Periodic"),
+				SourceJobIDs: stringList("job-1"),
+				Schedule: &BackupCopySchedule{
+					DailyLocalTime:        types.StringNull(),
+					PeriodicallyKind:      types.StringValue("Hours"),
+					PeriodicallyFrequency: types.Int64Value(6),
+				},
+			},
+			sources: &models.BackupCopyJobSourceObjectsModel{JobIDs: []string{"job-1"}},
+			schedule: &models.BackupCopyJobScheduleModel{
+				Periodically: &models.SchedulePeriodicallyModel{IsEnabled: true, PeriodicallyKind: models.PeriodicallyHours, Frequency: 6},
+			},
+			transfer: &models.BackupCopyJobDataTransferModel{},
+		},
+		{
+			name: "immediate copy of a repository without a window",
+			data: BackupCopyJobResourceModel{
+				Mode:                types.StringValue("Immediate"),
+				SourceRepositoryIDs: stringList("repo-primary"),
+			},
+			sources:  &models.BackupCopyJobSourceObjectsModel{RepositoryIDs: []string{"repo-primary"}},
+			transfer: &models.BackupCopyJobDataTransferModel{},
+		},
+		{
+			name: "immediate copy through WAN accelerators",
+			data: BackupCopyJobResourceModel{
+				Mode:                types.StringValue("Immediate"),
+				SourceRepositoryIDs: stringList("repo-primary"),
+				WANAccelerator: &BackupCopyWANAccelerator{
+					SourceID: types.StringValue("wan-src"),
+					TargetID: types.StringValue("wan-dst"),
+				},
+			},
+			sources: &models.BackupCopyJobSourceObjectsModel{RepositoryIDs: []string{"repo-primary"}},
+			transfer: &models.BackupCopyJobDataTransferModel{
+				UseWanAccelerators: true, SourceWANAcceleratorID: "wan-src", TargetWANAcceleratorID: "wan-dst",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := buildBackupCopyJobModel(&tt.data, false)
+			assert.Equal(t, models.JobTypeBackupCopy, m.Type)
+			assert.Equal(t, tt.sources, m.SourceObjects)
+			assert.Equal(t, tt.schedule, m.Schedule)
+			assert.Equal(t, tt.transfer, m.DataTransfer)
+		})
+	}
+}
+
+// TestBackupCopyJob_BuildModel_CopyWindow checks that an Immediate job sends
+// its copy_window as the schedule's backup window and nothing else.
+func TestBackupCopyJob_BuildModel_CopyWindow(t *testing.T) {
+	data := BackupCopyJobResourceModel{
+		Mode:                types.StringValue("Immediate"),
+		SourceRepositoryIDs: stringList("repo-primary"),
+		CopyWindow:          copyWindow(22, 23),
+	}
+	m := buildBackupCopyJobModel(&data, false)
+	require.NotNil(t, m.Schedule)
+	assert.Nil(t, m.Schedule.Daily)
+	assert.Nil(t, m.Schedule.Periodically)
+	require.NotNil(t, m.Schedule.BackupWindow)
+	monday := m.Schedule.BackupWindow.BackupWindow.Days[1]
+	assert.Equal(t, models.DayMonday, monday.Day)
+	assert.Equal(t, "0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1", monday.Hours)
+}
+
+// ---------------------------------------------------------------------------
+// BackupCopyJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestBackupCopyJob_ValidateConfig(t *testing.T) {
+	daily := &BackupCopySchedule{DailyLocalTime: types.StringValue("22:00")}
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "periodic copy of jobs",
+			attrs: map[string]interface{}{"mode": "Periodic", "source_job_ids": stringList("job-1"), "schedule": daily},
+		},
+		{
+			name:  "immediate copy of a repository",
+			attrs: map[string]interface{}{"mode": "Immediate", "source_repository_ids": stringList("repo-1"), "copy_window": copyWindow(1)},
+		},
+		{
+			name:    "unsupported mode",
+			attrs:   map[string]interface{}{"mode": "Mirror", "source_job_ids": stringList("job-1")},
+			wantErr: `unsupported mode "Mirror"`,
+		},
+		{
+			name:    "no source",
+			attrs:   map[string]interface{}{"mode": "Immediate"},
+			wantErr: "set exactly one of source_job_ids or source_repository_ids",
+		},
+		{
+			name: "both sources",
+			attrs: map[string]interface{}{"mode": "Immediate",
+				"source_job_ids": stringList("job-1"), "source_repository_ids": stringList("repo-1")},
+			wantErr: "set exactly one of source_job_ids or source_repository_ids",
+		},
+		{
+			name:    "immediate with schedule",
+			attrs:   map[string]interface{}{"mode": "Immediate", "source_job_ids": stringList("job-1"), "schedule": daily},
+			wantErr: "only supported in Periodic mode",
+		},
+		{
+			name:    "periodic without schedule",
+			attrs:   map[string]interface{}{"mode": "Periodic", "source_job_ids": stringList("job-1")},
+			wantErr: "Periodic mode requires a schedule block",
+		},
+		{
+			name: "daily and periodic schedule",
+			attrs: map[string]interface{}{"mode": "Periodic", "source_job_ids": stringList("job-1"),
+				"schedule": &BackupCopySchedule{DailyLocalTime: types.StringValue("22:00"), PeriodicallyFrequency: types.Int64Value(4)}},
+			wantErr: "exactly one of daily_local_time or periodically_frequency",
+		},
+		{
+			name: "zero frequency",
+			attrs: map[string]interface{}{"mode": "Periodic", "source_job_ids": stringList("job-1"),
+				"schedule": &BackupCopySchedule{PeriodicallyFrequency: types.Int64Value(0)}},
+			wantErr: "greater than zero",
+		},
+		{
+			name:    "hour outside the day",
+			attrs:   map[string]interface{}{"mode": "Immediate", "source_job_ids": stringList("job-1"), "copy_window": copyWindow(24)},
+			wantErr: "copy_window: day \"Monday\": hour 24 is outside 0–23",
+		},
+		{
+			name:  "mode from another resource",
+			attrs: map[string]interface{}{"mode": types.StringUnknown(), "source_job_ids": stringList("job-1"), "schedule": daily},
+		},
+		{
+			name:  "sources from another resource",
+			attrs: map[string]interface{}{"mode": "Immediate", "source_job_ids": types.ListUnknown(types.StringType)},
+		},
+		{
+			name: "schedule time from a variable",
+			attrs: map[string]interface{}{"mode": "Periodic", "source_job_ids": stringList("job-1"),
+				"schedule": &BackupCopySchedule{DailyLocalTime: types.StringUnknown()}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &BackupCopyJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// BackupCopyJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestBackupCopyJob_ManagedPaths checks that blocks dropped from the plan are
+// cleared on the server instead of being merged back, and that sections the
+// resource does not manage survive the update.
+func TestBackupCopyJob_ManagedPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		current map[string]interface{}
+		data    BackupCopyJobResourceModel
+		section string
+		want    interface{}
+	}{
+		{
+			name: "WAN accelerators removed",
+			current: map[string]interface{}{"dataTransfer": map[string]interface{}{
+				"useWanAccelerators": true, "sourceWANAcceleratorId": "wan-src", "targetWANAcceleratorId": "wan-dst",
+			}},
+			data:    BackupCopyJobResourceModel{SourceJobIDs: stringList("job-1")},
+			section: "dataTransfer",
+			want:    map[string]interface{}{"useWanAccelerators": false},
+		},
+		{
+			name: "password replaced by a KMS server",
+			current: map[string]interface{}{"storage": map[string]interface{}{
+				"encryption": map[string]interface{}{"isEnabled": true, "encryptionPasswordId": "pwd-1"},
+			}},
+			data: BackupCopyJobResourceModel{
+				SourceJobIDs: stringList("job-1"),
+				Encryption:   &JobStorageEncryption{KMSServerID: types.StringValue("kms-1")},
+			},
+			section: "storage.encryption",
+			want:    map[string]interface{}{"isEnabled": true, "kmsServerId": "kms-1"},
+		},
+		{
+			name:    "jobs replaced by a repository",
+			current: map[string]interface{}{"sourceObjects": map[string]interface{}{"jobIds": []interface{}{"job-1"}}},
+			data:    BackupCopyJobResourceModel{SourceRepositoryIDs: stringList("repo-1")},
+			section: "sourceObjects",
+			want:    map[string]interface{}{"repositoryIds": []interface{}{"repo-1"}},
+		},
+		{
+			name: "interval replaced by a daily run",
+			current: map[string]interface{}{"schedule": map[string]interface{}{
+				"periodically": map[string]interface{}{"isEnabled": true, "periodicallyKind": "Hours", "frequency": float64(6)},
+			}},
+			data: BackupCopyJobResourceModel{
+				SourceJobIDs: stringList("job-1"),
+				Schedule:     &BackupCopySchedule{DailyLocalTime: types.StringValue("22:00"), DailyKind: types.StringValue("Everyday")},
+			},
+			section: "schedule",
+			want: map[string]interface{}{
+				"daily": map[string]interface{}{"isEnabled": true, "localTime": "22:00", "dailyKind": "Everyday"},
+			},
+		},
+		{
+			name:    "notifications kept",
+			current: map[string]interface{}{"notifications": map[string]interface{}{"sendSNMPNotifications": true}},
+			data:    BackupCopyJobResourceModel{SourceJobIDs: stringList("job-1")},
+			section: "notifications",
+			want:    map[string]interface{}{"sendSNMPNotifications": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := mergeManagedPayload(tt.current, buildBackupCopyJobModel(&tt.data, false), backupCopyJobManagedPaths...)
+			require.NoError(t, err)
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// BackupCopyJob — syncBackupCopyJobFromAPI
+// ---------------------------------------------------------------------------
+
+func TestBackupCopyJob_SyncFromAPI(t *testing.T) {
+	t.Run("console switched to an interval without WAN acceleration", func(t *testing.T) {
+		data := BackupCopyJobResourceModel{
+			WANAccelerator: &BackupCopyWANAccelerator{SourceID: types.StringValue("wan-src"), TargetID: types.StringValue("wan-dst")},
+			Schedule:       &BackupCopySchedule{DailyLocalTime: types.StringValue("22:00")},
+		}
+		syncBackupCopyJobFromAPI(&data, &models.BackupCopyJobModel{
+			JobModel:      models.JobModel{ID: "copy-1", Type: models.JobTypeBackupCopy},
+			Mode:          models.BackupCopyJobModePeriodic,
+			SourceObjects: &models.BackupCopyJobSourceObjectsModel{JobIDs: []string{"job-1"}},
+			DataTransfer:  &models.BackupCopyJobDataTransferModel{SourceWANAcceleratorID: "wan-src"},
+			Schedule: &models.BackupCopyJobScheduleModel{
+				Daily:        &models.ScheduleDailyModel{IsEnabled: false, LocalTime: "22:00"},
+				Periodically: &models.SchedulePeriodicallyModel{IsEnabled: true, PeriodicallyKind: models.PeriodicallyHours, Frequency: 6},
+			},
+		})
+		assert.Nil(t, data.WANAccelerator)
+		require.NotNil(t, data.Schedule)
+		assert.True(t, data.Schedule.DailyLocalTime.IsNull())
+		assert.Equal(t, int64(6), data.Schedule.PeriodicallyFrequency.ValueInt64())
+		assert.Nil(t, data.CopyWindow)
+	})
+
+	t.Run("immediate copy of a repository", func(t *testing.T) {
+		var data BackupCopyJobResourceModel
+		m := buildBackupCopyJobModel(&BackupCopyJobResourceModel{
+			Mode:                types.StringValue("Immediate"),
+			SourceRepositoryIDs: stringList("repo-primary"),
+			CopyWindow:          copyWindow(22, 23),
+		}, false)
+		syncBackupCopyJobFromAPI(&data, m)
+		assert.True(t, data.SourceJobIDs.IsNull())
+		assert.Equal(t, stringList("repo-primary"), data.SourceRepositoryIDs)
+		assert.Nil(t, data.Schedule)
+		assert.Nil(t, data.Encryption)
+		require.NotNil(t, data.CopyWindow)
+		assert.Equal(t, "Monday", data.CopyWindow.Days[0].Day.ValueString())
+	})
+}
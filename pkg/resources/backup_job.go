@@ -12,6 +12,9 @@ package resources
 //   HyperVBackup       Backup Microsoft Hyper-V VMs and containers.
 //                      Requires: virtual_machines block with at least one include.
 //
+//   CloudDirectorBackup Backup VMware Cloud Director organisations, org VDCs and vApps.
+//                      Requires: virtual_machines block with at least one include.
+//
 //   WindowsAgentBackup Backup Windows machines managed via Veeam Agent.
 //                      Requires: agent_computers block with at least one computer.
 //
@@ -34,14 +37,19 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/patrikcze/terraform-provider-veeam/internal/client"
@@ -50,9 +58,11 @@ import (
 
 // Compile-time interface checks.
 var (
-	_ resource.Resource                = &BackupJob{}
-	_ resource.ResourceWithConfigure   = &BackupJob{}
-	_ resource.ResourceWithImportState = &BackupJob{}
+	_ resource.Resource                   = &BackupJob{}
+	_ resource.ResourceWithConfigure      = &BackupJob{}
+	_ resource.ResourceWithImportState    = &BackupJob{}
+	_ resource.ResourceWithIdentity       = &BackupJob{}
+	_ resource.ResourceWithValidateConfig = &BackupJob{}
 )
 
 // BackupJob implements the veeam_backup_job Terraform resource.
@@ -77,8 +87,10 @@ type BackupJobModel struct {
 	Type           types.String `tfsdk:"type"`
 	IsHighPriority types.Bool   `tfsdk:"is_high_priority"`
 	IsDisabled     types.Bool   `tfsdk:"is_disabled"`
+	// CloneFromJobID creates the job as a clone of an existing job.
+	CloneFromJobID types.String `tfsdk:"clone_from_job_id"`
 
-	// VM scope — required for VSphereBackup and HyperVBackup job types.
+	// VM scope — required for VSphereBackup, HyperVBackup and CloudDirectorBackup job types.
 	VirtualMachines *VMBackupScope `tfsdk:"virtual_machines"`
 
 	// Agent scope — required for WindowsAgentBackup and LinuxAgentBackup job types.
@@ -104,7 +116,10 @@ type BackupJobModel struct {
 	// Storage settings (optional; recommended for all job types).
 	Storage *JobStorageSettings `tfsdk:"storage"`
 
-	// Guest processing (optional; VSphereBackup / HyperVBackup only).
+	// Advanced settings (optional; sent as storage.advancedSettings).
+	AdvancedSettings *JobAdvancedSettings `tfsdk:"advanced_settings"`
+
+	// Guest processing (optional; VSphereBackup / HyperVBackup / CloudDirectorBackup only).
 	GuestProcessing *JobGuestProcessing `tfsdk:"guest_processing"`
 
 	// Schedule settings (optional).
@@ -115,21 +130,50 @@ type BackupJobModel struct {
 type VMBackupScope struct {
 	// Includes is the list of VMware / Hyper-V objects to back up. At least one required.
 	Includes []VMIncludeEntry `tfsdk:"includes"`
+	// Excludes removes individual VMs or disks from the included containers.
+	Excludes *VMExclusions `tfsdk:"excludes"`
 	// ExcludeTemplates excludes all VM templates from the backup when true.
 	ExcludeTemplates types.Bool `tfsdk:"exclude_templates"`
+	// ExcludeTemplatesFromIncremental skips templates in incremental runs only.
+	ExcludeTemplatesFromIncremental types.Bool `tfsdk:"exclude_templates_from_incremental"`
+}
+
+// VMExclusions maps to BackupJobExclusionsSpec (vms / disks).
+type VMExclusions struct {
+	// VMs lists inventory objects excluded from the backup scope.
+	VMs []VMIncludeEntry `tfsdk:"vms"`
+	// Disks lists per-VM disk selections.
+	Disks []VMDiskExclusion `tfsdk:"disks"`
+}
+
+// VMDiskExclusion maps to VmwareObjectDiskModel: a VM and the disks to process.
+type VMDiskExclusion struct {
+	Platform types.String `tfsdk:"platform"`
+	Type     types.String `tfsdk:"type"`
+	HostName types.String `tfsdk:"host_name"`
+	Name     types.String `tfsdk:"name"`
+	ObjectID types.String `tfsdk:"object_id"`
+	// DisksToProcess is AllDisks, SystemOnly or SelectedDisks.
+	DisksToProcess types.String `tfsdk:"disks_to_process"`
+	// Disks lists disk IDs (e.g. "scsi0:1") when disks_to_process = "SelectedDisks".
+	Disks types.List `tfsdk:"disks"`
+	// RemoveFromVMConfiguration removes excluded disks from the VM configuration on restore.
+	RemoveFromVMConfiguration types.Bool `tfsdk:"remove_from_vm_configuration"`
 }
 
 // VMIncludeEntry is a single VMware or Hyper-V inventory object to include.
 type VMIncludeEntry struct {
-	// Platform identifies the hypervisor platform: "VSphere" or "HyperV".
+	// Platform identifies the hypervisor platform: "VSphere", "HyperV" or "CloudDirector".
 	Platform types.String `tfsdk:"platform"`
-	// Type is the vSphere/Hyper-V object type (VirtualMachine, Folder, Datacenter, Cluster…).
+	// Type is the vSphere/Hyper-V object type (VirtualMachine, Folder, Cluster, SCVMM…).
 	Type types.String `tfsdk:"type"`
-	// HostName is the vCenter Server or ESXi hostname that owns this object.
+	// HostName is the vCenter Server, ESXi host, SCVMM server or Hyper-V
+	// cluster/host that owns this object.
 	HostName types.String `tfsdk:"host_name"`
 	// Name is the display name of the inventory object.
 	Name types.String `tfsdk:"name"`
-	// ObjectID is the vSphere MoRef ID (required for all objects except vCenter/ESXi hosts).
+	// ObjectID is the vSphere MoRef ID or Hyper-V object ID (required for all
+	// objects except vCenter Servers and standalone hosts).
 	ObjectID types.String `tfsdk:"object_id"`
 }
 
@@ -168,6 +212,8 @@ type JobStorageSettings struct {
 	RepositoryID types.String `tfsdk:"repository_id"`
 	// ProxyAutoSelect enables automatic proxy selection (default: true).
 	ProxyAutoSelect types.Bool `tfsdk:"proxy_auto_select"`
+	// ProxyIDs lists the backup proxies to use when ProxyAutoSelect is false.
+	ProxyIDs types.List `tfsdk:"proxy_ids"`
 	// RetentionType selects whether retention is measured in RestorePoints or Days.
 	RetentionType types.String `tfsdk:"retention_type"`
 	// RetentionQuantity is the number of restore points or days to retain.
@@ -200,6 +246,114 @@ type JobGFSPolicy struct {
 	YearlyDesiredTime types.String `tfsdk:"yearly_desired_time"`
 }
 
+// JobAdvancedSettings maps to BackupJobAdvancedSettingsModel.
+type JobAdvancedSettings struct {
+	// Backup configures the backup mode and full / maintenance schedules.
+	Backup *JobAdvancedBackup `tfsdk:"backup"`
+	// Storage configures compression, data reduction and encryption.
+	Storage *JobAdvancedStorage `tfsdk:"storage"`
+	// Notifications configures per-job email and SNMP notifications.
+	Notifications *JobNotifications `tfsdk:"notifications"`
+	// HyperV configures Hyper-V-specific processing (HyperVBackup only).
+	HyperV *JobHyperVSettings `tfsdk:"hyper_v"`
+}
+
+// JobHyperVSettings maps to BackupJobAdvancedSettingsHyperVModel.
+type JobHyperVSettings struct {
+	GuestQuiescence      types.Bool `tfsdk:"guest_quiescence"`
+	CrashConsistent      types.Bool `tfsdk:"crash_consistent"`
+	ChangedBlockTracking types.Bool `tfsdk:"changed_block_tracking"`
+	VolumeSnapshots      types.Bool `tfsdk:"volume_snapshots"`
+}
+
+// JobNotifications maps to NotificationSettingsModel.
+type JobNotifications struct {
+	// SNMPEnabled sends SNMP traps for job results.
+	SNMPEnabled types.Bool `tfsdk:"snmp_enabled"`
+	// Email enables per-job email notifications when set.
+	Email *JobEmailNotifications `tfsdk:"email"`
+}
+
+// JobEmailNotifications maps to EmailNotificationSettingsModel and its
+// customNotificationSettings.
+type JobEmailNotifications struct {
+	// Recipients lists the destination email addresses.
+	Recipients types.List `tfsdk:"recipients"`
+	// UseGlobalSettings applies the triggers of veeam_notification_settings.
+	UseGlobalSettings types.Bool `tfsdk:"use_global_settings"`
+	// Subject is the custom subject template.
+	Subject                types.String `tfsdk:"subject"`
+	NotifyOnSuccess        types.Bool   `tfsdk:"notify_on_success"`
+	NotifyOnWarning        types.Bool   `tfsdk:"notify_on_warning"`
+	NotifyOnError          types.Bool   `tfsdk:"notify_on_error"`
+	SuppressUntilLastRetry types.Bool   `tfsdk:"suppress_until_last_retry"`
+}
+
+// JobAdvancedStorage maps to BackupStorageSettingModel.
+type JobAdvancedStorage struct {
+	// CompressionLevel is None, DedupFriendly, Optimal, High, Extreme or Auto.
+	CompressionLevel types.String `tfsdk:"compression_level"`
+	// StorageOptimization is the block size: 256KB, 512KB, 1MB or 4MB.
+	StorageOptimization types.String `tfsdk:"storage_optimization"`
+	// InlineDedupeEnabled deduplicates data before it is written to the repository.
+	InlineDedupeEnabled types.Bool `tfsdk:"inline_dedupe_enabled"`
+	// ExcludeSwapFileBlocks skips guest swap and page file blocks.
+	ExcludeSwapFileBlocks types.Bool `tfsdk:"exclude_swap_file_blocks"`
+	// ExcludeDeletedFileBlocks skips blocks of deleted guest files.
+	ExcludeDeletedFileBlocks types.Bool `tfsdk:"exclude_deleted_file_blocks"`
+	// Encryption enables backup file encryption when set.
+	Encryption *JobStorageEncryption `tfsdk:"encryption"`
+}
+
+// JobStorageEncryption maps to BackupStorageEncryptionModel. Exactly one key
+// source must be set.
+type JobStorageEncryption struct {
+	// EncryptionPasswordID is the UUID of a veeam_encryption_password.
+	EncryptionPasswordID types.String `tfsdk:"encryption_password_id"`
+	// KMSServerID is the UUID of a veeam_kms_server.
+	KMSServerID types.String `tfsdk:"kms_server_id"`
+}
+
+// JobAdvancedBackup holds the backup mode and the scheduled full backup and
+// maintenance operations of BackupJobAdvancedSettingsModel.
+type JobAdvancedBackup struct {
+	// Mode is the EBackupModeType (Incremental, ReverseIncremental…).
+	Mode types.String `tfsdk:"mode"`
+	// SyntheticFull maps to synthenticFulls; nil disables synthetic fulls.
+	SyntheticFull *JobAdvancedSchedule `tfsdk:"synthetic_full"`
+	// ActiveFull maps to activeFulls; nil disables active fulls.
+	ActiveFull *JobAdvancedSchedule `tfsdk:"active_full"`
+	// HealthCheck maps to backupHealth; nil disables health checks.
+	HealthCheck *JobAdvancedSchedule `tfsdk:"health_check"`
+	// CompactFull maps to fullBackupMaintenance.defragmentAndCompact.
+	CompactFull *JobAdvancedSchedule `tfsdk:"compact_full"`
+}
+
+// JobAdvancedSchedule maps to AdvancedStorageScheduleModel. Exactly one of
+// Weekly or Monthly is set.
+type JobAdvancedSchedule struct {
+	Weekly  *JobAdvancedScheduleWeekly  `tfsdk:"weekly"`
+	Monthly *JobAdvancedScheduleMonthly `tfsdk:"monthly"`
+}
+
+// JobAdvancedScheduleWeekly maps to AdvancedStorageScheduleWeeklyModel.
+type JobAdvancedScheduleWeekly struct {
+	// Days lists the days of the week (Monday…Sunday).
+	Days types.List `tfsdk:"days"`
+}
+
+// JobAdvancedScheduleMonthly maps to AdvancedStorageScheduleMonthlyModel.
+type JobAdvancedScheduleMonthly struct {
+	// DayNumberInMonth is First, Second, Third, Fourth, Last or OnDay.
+	DayNumberInMonth types.String `tfsdk:"day_number_in_month"`
+	// DayOfWeek is used with First…Last.
+	DayOfWeek types.String `tfsdk:"day_of_week"`
+	// DayOfMonth is used with OnDay.
+	DayOfMonth types.Int64 `tfsdk:"day_of_month"`
+	// Months limits the schedule to the listed months.
+	Months types.List `tfsdk:"months"`
+}
+
 // JobGuestProcessing maps to BackupJobGuestProcessingModel.
 type JobGuestProcessing struct {
 	// AppAwareEnabled activates application-aware processing.
@@ -209,8 +363,84 @@ type JobGuestProcessing struct {
 	// InteractionProxyAutoSelect auto-selects the guest interaction proxy.
 	InteractionProxyAutoSelect types.Bool `tfsdk:"interaction_proxy_auto_select"`
 	// GuestCredentials specifies the credentials used for guest OS interaction.
-	// Applies to VSphereBackup and HyperVBackup job types only.
+	// Applies to VSphereBackup, HyperVBackup and CloudDirectorBackup job types only.
 	GuestCredentials *JobGuestCredentials `tfsdk:"guest_credentials"`
+	// ObjectOverrides holds per-VM guest processing settings keyed by object_id.
+	ObjectOverrides []JobGuestObjectOverride `tfsdk:"object_overrides"`
+}
+
+// JobGuestObjectOverride overrides guest processing for a single VM. It is
+// split across appSettings, indexingSettings and credentialsPerMachine.
+type JobGuestObjectOverride struct {
+	Platform types.String `tfsdk:"platform"`
+	Type     types.String `tfsdk:"type"`
+	HostName types.String `tfsdk:"host_name"`
+	Name     types.String `tfsdk:"name"`
+	ObjectID types.String `tfsdk:"object_id"`
+	// CredentialsID overrides the guest credentials for Windows guests.
+	CredentialsID types.String `tfsdk:"credentials_id"`
+	// LinuxCredentialsID overrides the guest credentials for Linux guests.
+	LinuxCredentialsID types.String `tfsdk:"linux_credentials_id"`
+	// AppAware maps to the VSS / transaction log part of BackupApplicationSettingsModel.
+	AppAware *JobGuestAppAware `tfsdk:"app_aware"`
+	// Indexing maps to BackupIndexingSettingsModel.
+	Indexing *JobGuestIndexing `tfsdk:"indexing"`
+	// Scripts maps to BackupScriptSettingsModel.
+	Scripts *JobGuestScripts `tfsdk:"scripts"`
+}
+
+// JobGuestAppAware holds the per-VM application-aware processing options.
+type JobGuestAppAware struct {
+	// VSS is RequireSuccess, IgnoreFailures or Disabled.
+	VSS types.String `tfsdk:"vss"`
+	// TransactionLogs is Process or CopyOnly.
+	TransactionLogs types.String    `tfsdk:"transaction_logs"`
+	SQL             *JobGuestSQL    `tfsdk:"sql"`
+	Oracle          *JobGuestOracle `tfsdk:"oracle"`
+}
+
+// JobGuestSQL maps to BackupSQLSettingsModel.
+type JobGuestSQL struct {
+	// LogsProcessing is TruncateLogs, PreventTruncation or BackupLogsPeriodically.
+	LogsProcessing types.String `tfsdk:"logs_processing"`
+	// BackupIntervalMinutes is the log backup interval for BackupLogsPeriodically.
+	BackupIntervalMinutes types.Int64 `tfsdk:"backup_interval_minutes"`
+	// RetainLogBackups is UntilBackupIsDeleted or KeepOnlyLastDays.
+	RetainLogBackups types.String `tfsdk:"retain_log_backups"`
+	// RetainDays is the log retention for KeepOnlyLastDays.
+	RetainDays types.Int64 `tfsdk:"retain_days"`
+}
+
+// JobGuestOracle maps to BackupOracleSettingsModel.
+type JobGuestOracle struct {
+	// CredentialsID is the Oracle credential record; null uses the guest credentials.
+	CredentialsID types.String `tfsdk:"credentials_id"`
+	// ArchiveLogs is DoNotDelete, DeleteLogsOlderThanHours or DeleteLogsOverGb.
+	ArchiveLogs          types.String `tfsdk:"archive_logs"`
+	DeleteOlderThanHours types.Int64  `tfsdk:"delete_older_than_hours"`
+	DeleteOverGB         types.Int64  `tfsdk:"delete_over_gb"`
+	// BackupLogs enables periodic archived log backups.
+	BackupLogs            types.Bool  `tfsdk:"backup_logs"`
+	BackupIntervalMinutes types.Int64 `tfsdk:"backup_interval_minutes"`
+}
+
+// JobGuestIndexing is the per-VM indexing scope, applied to both the Windows
+// and Linux indexing settings of the VM.
+type JobGuestIndexing struct {
+	// Mode is Disabled, IndexAll, IndexAllExcept or IndexOnly.
+	Mode types.String `tfsdk:"mode"`
+	// Paths lists the folders for IndexAllExcept / IndexOnly.
+	Paths types.List `tfsdk:"paths"`
+}
+
+// JobGuestScripts holds the per-VM pre-freeze and post-thaw scripts.
+type JobGuestScripts struct {
+	// Mode is RequireSuccess, IgnoreExecFailures or DisableExec.
+	Mode                   types.String `tfsdk:"mode"`
+	WindowsPreFreezeScript types.String `tfsdk:"windows_pre_freeze_script"`
+	WindowsPostThawScript  types.String `tfsdk:"windows_post_thaw_script"`
+	LinuxPreFreezeScript   types.String `tfsdk:"linux_pre_freeze_script"`
+	LinuxPostThawScript    types.String `tfsdk:"linux_post_thaw_script"`
 }
 
 // JobGuestCredentials maps to GuestOsCredentialsModel.
@@ -251,6 +481,23 @@ type JobScheduleSettings struct {
 	RetryEnabled      types.Bool  `tfsdk:"retry_enabled"`
 	RetryCount        types.Int64 `tfsdk:"retry_count"`
 	RetryAwaitMinutes types.Int64 `tfsdk:"retry_await_minutes"`
+
+	// BackupWindow stops the job outside the permitted hours when set.
+	BackupWindow *JobBackupWindow `tfsdk:"backup_window"`
+}
+
+// JobBackupWindow maps to ScheduleBackupWindowModel.
+type JobBackupWindow struct {
+	// Days lists the weekdays with permitted hours; unlisted days are denied.
+	Days []JobBackupWindowDay `tfsdk:"days"`
+}
+
+// JobBackupWindowDay maps to BackupWindowDayHoursModel.
+type JobBackupWindowDay struct {
+	// Day is the day of the week (Monday…Sunday).
+	Day types.String `tfsdk:"day"`
+	// Hours is the set of permitted hours (0–23); hour 22 covers 22:00–23:00.
+	Hours types.Set `tfsdk:"hours"`
 }
 
 // ---------------------------------------------------------------------------
@@ -259,6 +506,19 @@ type JobScheduleSettings struct {
 
 func (r *BackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_backup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// backupJobIdentity pairs the job UUID with the job name; the name alone is
+// enough to import through an identity block.
+var backupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Backup job name.",
+	lookup:         &backupJobImport,
+}
+
+func (r *BackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = backupJobIdentity.schema()
 }
 
 func (r *BackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -268,6 +528,7 @@ func (r *BackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 Supported job types:
 - **VSphereBackup** — VMware vSphere backup (requires ` + "`virtual_machines`" + ` block)
 - **HyperVBackup** — Microsoft Hyper-V backup (requires ` + "`virtual_machines`" + ` block)
+- **CloudDirectorBackup** — VMware Cloud Director backup (requires ` + "`virtual_machines`" + ` block)
 - **WindowsAgentBackup** — Veeam Agent for Windows (requires ` + "`agent_computers`" + ` block)
 - **LinuxAgentBackup** — Veeam Agent for Linux (requires ` + "`agent_computers`" + ` block)`,
 
@@ -292,7 +553,7 @@ Supported job types:
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Job type discriminator. Allowed values: " +
-					"`VSphereBackup`, `HyperVBackup`, `WindowsAgentBackup`, `LinuxAgentBackup`.",
+					"`VSphereBackup`, `HyperVBackup`, `CloudDirectorBackup`, `WindowsAgentBackup`, `LinuxAgentBackup`.",
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					// Changing job type requires destroy + recreate.
@@ -306,10 +567,28 @@ Supported job types:
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
 			},
+			"clone_from_job_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of an existing job to clone on create (`POST /api/v1/jobs/{id}/clone`). " +
+					"The settings in this configuration are then applied on top of the clone; " +
+					"settings the provider does not model are kept from the source job. " +
+					"The source must be of the same `type`. Changing this forces a new job; " +
+					"removing it does not.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(cloneSourceChanged,
+						"Changing the clone source creates a new job.",
+						"Changing the clone source creates a new job."),
+				},
+			},
 			"is_disabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether the job is disabled (read-only; use the Veeam " +
-					"console to disable/enable jobs, or use the enable/disable API endpoints).",
+				MarkdownDescription: "If `true`, the job is disabled and does not run on its schedule. " +
+					"Applied through the job enable/disable endpoints, independent of the other " +
+					"settings. When omitted, the current state is tracked but not changed.",
+				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			// -----------------------------------------------------------------
@@ -346,54 +625,22 @@ Supported job types:
 			},
 
 			// -----------------------------------------------------------------
-			// VM scope (required for VSphereBackup / HyperVBackup)
+			// VM scope (required for VSphereBackup / HyperVBackup / CloudDirectorBackup)
 			// -----------------------------------------------------------------
 			"virtual_machines": schema.SingleNestedAttribute{
 				MarkdownDescription: "Defines which VMs or containers the job protects. " +
-					"Required for `VSphereBackup` and `HyperVBackup` job types.",
+					"Required for `VSphereBackup`, `HyperVBackup` and `CloudDirectorBackup` job types.",
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
 					"includes": schema.ListNestedAttribute{
-						MarkdownDescription: "List of VMware vSphere or Hyper-V objects to " +
-							"include in the backup. At least one entry is required.",
+						MarkdownDescription: "List of VMware vSphere, Hyper-V or Cloud Director " +
+							"objects to include in the backup. At least one entry is required.",
 						Required: true,
 						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"platform": schema.StringAttribute{
-									MarkdownDescription: "Hypervisor platform. " +
-										"Allowed values: `VSphere`, `HyperV`.",
-									Required: true,
-								},
-								"type": schema.StringAttribute{
-									MarkdownDescription: "Object type within the platform. " +
-										"vSphere examples: `VirtualMachine`, `Folder`, " +
-										"`Datacenter`, `Cluster`, `Host`, `ResourcePool`, " +
-										"`VirtualApp`, `Tag`. " +
-										"Leave empty to let the API infer the type.",
-									Optional: true,
-									Computed: true,
-								},
-								"host_name": schema.StringAttribute{
-									MarkdownDescription: "FQDN or IP address of the vCenter " +
-										"Server or ESXi host that owns this object.",
-									Optional: true,
-									Computed: true,
-								},
-								"name": schema.StringAttribute{
-									MarkdownDescription: "Display name of the inventory object " +
-										"(VM name, folder name, datacenter name, etc.).",
-									Required: true,
-								},
-								"object_id": schema.StringAttribute{
-									MarkdownDescription: "vSphere MoRef ID (e.g. `vm-101`, " +
-										"`domain-c12`). Required for all objects except " +
-										"vCenter Servers and standalone ESXi hosts.",
-									Optional: true,
-									Computed: true,
-								},
-							},
+							Attributes: vmObjectAttributes(),
 						},
 					},
+					"excludes": vmExclusionsAttribute(),
 					"exclude_templates": schema.BoolAttribute{
 						MarkdownDescription: "If `true`, all VM templates are excluded from " +
 							"the backup scope.",
@@ -401,6 +648,13 @@ Supported job types:
 						Computed: true,
 						Default:  booldefault.StaticBool(false),
 					},
+					"exclude_templates_from_incremental": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, VM templates are backed up in full " +
+							"runs only and skipped by incremental runs.",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
 				},
 			},
 
@@ -511,6 +765,15 @@ Supported job types:
 						Optional: true,
 						Computed: true,
 						Default:  booldefault.StaticBool(true),
+						Validators: []validator.Bool{
+							proxySelectionValidator{},
+						},
+					},
+					"proxy_ids": schema.ListAttribute{
+						MarkdownDescription: "UUIDs of the backup proxies the job uses when " +
+							"`proxy_auto_select = false`. Reference `veeam_proxy.id`.",
+						ElementType: types.StringType,
+						Optional:    true,
 					},
 					"retention_type": schema.StringAttribute{
 						MarkdownDescription: "Retention policy type. " +
@@ -524,74 +787,173 @@ Supported job types:
 						Optional: true,
 						Computed: true,
 					},
-					"gfs_policy": schema.SingleNestedAttribute{
-						MarkdownDescription: "Grandfather-Father-Son (GFS) long-term archival retention policy. " +
-							"When configured, Veeam preserves selected weekly, monthly, and yearly " +
-							"full backups beyond the standard retention window.",
+					"gfs_policy": gfsPolicyAttribute(),
+				},
+			},
+
+			// -----------------------------------------------------------------
+			// Advanced settings (storage.advancedSettings in the API)
+			// -----------------------------------------------------------------
+			"advanced_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Advanced job settings. Requires the `storage` block. " +
+					"Settings left out are managed outside Terraform.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"backup": schema.SingleNestedAttribute{
+						MarkdownDescription: "Backup mode and scheduled full backups and maintenance. " +
+							"Schedules left out of this block are disabled.",
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
-							"is_enabled": schema.BoolAttribute{
-								MarkdownDescription: "Master switch that activates the GFS retention policy.",
-								Required:            true,
+							"mode": schema.StringAttribute{
+								MarkdownDescription: "Backup method. Allowed values: `Incremental`, " +
+									"`ReverseIncremental`, `Full`, `Transform`, `TransformForeverIncremental`. " +
+									"Use `Incremental` without `synthetic_full` and `active_full` " +
+									"for forever-forward incremental.",
+								Optional: true,
+								Computed: true,
 							},
-							"weekly_enabled": schema.BoolAttribute{
-								MarkdownDescription: "Activate weekly GFS archival. " +
-									"Preserves one full backup per week for the configured duration.",
+							"synthetic_full": advancedScheduleAttribute("Creates synthetic full backups on a schedule."),
+							"active_full":    advancedScheduleAttribute("Creates active full backups on a schedule."),
+							"health_check":   advancedScheduleAttribute("Runs the storage-level backup health check on a schedule."),
+							"compact_full":   advancedScheduleAttribute("Defragments and compacts the full backup file on a schedule."),
+						},
+					},
+					"storage": schema.SingleNestedAttribute{
+						MarkdownDescription: "Compression, data reduction and encryption of backup files.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"compression_level": schema.StringAttribute{
+								MarkdownDescription: "Compression level. Allowed values: `None`, " +
+									"`DedupFriendly`, `Optimal`, `High`, `Extreme`, `Auto`.",
 								Optional: true,
 								Computed: true,
-								Default:  booldefault.StaticBool(false),
 							},
-							"weekly_keep_for": schema.Int64Attribute{
-								MarkdownDescription: "Number of weeks to retain weekly full backups (1\u20139999). " +
-									"Only evaluated when `weekly_enabled = true`.",
+							"storage_optimization": schema.StringAttribute{
+								MarkdownDescription: "Block size used for backup files. " +
+									"Allowed values: `256KB`, `512KB`, `1MB`, `4MB`.",
 								Optional: true,
 								Computed: true,
 							},
-							"weekly_desired_time": schema.StringAttribute{
-								MarkdownDescription: "Day of the week on which the weekly full backup is created. " +
-									"Allowed values: `Monday`, `Tuesday`, `Wednesday`, `Thursday`, " +
-									"`Friday`, `Saturday`, `Sunday`.",
+							"inline_dedupe_enabled": schema.BoolAttribute{
+								MarkdownDescription: "If `true` (default), data is deduplicated " +
+									"before it is written to the repository.",
 								Optional: true,
 								Computed: true,
+								Default:  booldefault.StaticBool(true),
 							},
-							"monthly_enabled": schema.BoolAttribute{
-								MarkdownDescription: "Activate monthly GFS archival. " +
-									"Preserves one full backup per month for the configured duration.",
+							"exclude_swap_file_blocks": schema.BoolAttribute{
+								MarkdownDescription: "If `true` (default), guest swap and page " +
+									"file blocks are skipped.",
 								Optional: true,
 								Computed: true,
-								Default:  booldefault.StaticBool(false),
+								Default:  booldefault.StaticBool(true),
 							},
-							"monthly_keep_for": schema.Int64Attribute{
-								MarkdownDescription: "Number of months to retain monthly full backups (1\u2013999). " +
-									"Only evaluated when `monthly_enabled = true`.",
+							"exclude_deleted_file_blocks": schema.BoolAttribute{
+								MarkdownDescription: "If `true` (default), blocks of deleted " +
+									"guest files are skipped.",
 								Optional: true,
 								Computed: true,
+								Default:  booldefault.StaticBool(true),
 							},
-							"monthly_desired_time": schema.StringAttribute{
-								MarkdownDescription: "Week of the month on which the monthly full backup is created. " +
-									"Allowed values: `First`, `Second`, `Third`, `Fourth`, `Last`.",
+							"encryption": encryptionAttribute(),
+						},
+					},
+					"hyper_v": schema.SingleNestedAttribute{
+						MarkdownDescription: "Hyper-V processing settings. `HyperVBackup` jobs only.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"guest_quiescence": schema.BoolAttribute{
+								MarkdownDescription: "If `true`, Hyper-V integration services quiesce " +
+									"the guest when application-aware processing is disabled. " +
+									"Defaults to `false`.",
 								Optional: true,
 								Computed: true,
+								Default:  booldefault.StaticBool(false),
 							},
-							"yearly_enabled": schema.BoolAttribute{
-								MarkdownDescription: "Activate yearly GFS archival. " +
-									"Preserves one full backup per year for the configured duration.",
+							"crash_consistent": schema.BoolAttribute{
+								MarkdownDescription: "If `true`, VMs that cannot be quiesced are backed " +
+									"up crash-consistently instead of being suspended. " +
+									"Requires `guest_quiescence`. Defaults to `false`.",
 								Optional: true,
 								Computed: true,
 								Default:  booldefault.StaticBool(false),
 							},
-							"yearly_keep_for": schema.Int64Attribute{
-								MarkdownDescription: "Number of years to retain yearly full backups (1\u2013999). " +
-									"Only evaluated when `yearly_enabled = true`.",
+							"changed_block_tracking": schema.BoolAttribute{
+								MarkdownDescription: "If `true` (default), incremental runs read only " +
+									"the blocks reported by Hyper-V resilient change tracking.",
 								Optional: true,
 								Computed: true,
+								Default:  booldefault.StaticBool(true),
 							},
-							"yearly_desired_time": schema.StringAttribute{
-								MarkdownDescription: "Month of the year in which the yearly full backup is created. " +
-									"Allowed values: `January`, `February`, `March`, `April`, `May`, " +
-									"`June`, `July`, `August`, `September`, `October`, `November`, `December`.",
+							"volume_snapshots": schema.BoolAttribute{
+								MarkdownDescription: "If `true` (default), multiple VMs on the same " +
+									"volume are processed with a single volume snapshot.",
 								Optional: true,
 								Computed: true,
+								Default:  booldefault.StaticBool(true),
+							},
+						},
+					},
+					"notifications": schema.SingleNestedAttribute{
+						MarkdownDescription: "Per-job email and SNMP notifications.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"snmp_enabled": schema.BoolAttribute{
+								MarkdownDescription: "If `true`, SNMP traps are sent for job results.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"email": schema.SingleNestedAttribute{
+								MarkdownDescription: "Per-job email notifications, sent in addition to " +
+									"the global `veeam_email_settings` recipients. When omitted, " +
+									"per-job email notifications are disabled.",
+								Optional: true,
+								Attributes: map[string]schema.Attribute{
+									"recipients": schema.ListAttribute{
+										MarkdownDescription: "Destination email addresses.",
+										ElementType:         types.StringType,
+										Required:            true,
+									},
+									"use_global_settings": schema.BoolAttribute{
+										MarkdownDescription: "If `true`, the triggers and subject of the global " +
+											"notification settings apply and the attributes below are ignored.",
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									"subject": schema.StringAttribute{
+										MarkdownDescription: "Subject template. Supports the VBR variables " +
+											"`%Time%`, `%JobName%`, `%JobResult%`, `%ObjectCount%` and `%Issues%`.",
+										Optional: true,
+										Computed: true,
+									},
+									"notify_on_success": schema.BoolAttribute{
+										MarkdownDescription: "Send an email when the job succeeds.",
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(true),
+									},
+									"notify_on_warning": schema.BoolAttribute{
+										MarkdownDescription: "Send an email when the job finishes with warnings.",
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(true),
+									},
+									"notify_on_error": schema.BoolAttribute{
+										MarkdownDescription: "Send an email when the job fails.",
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(true),
+									},
+									"suppress_until_last_retry": schema.BoolAttribute{
+										MarkdownDescription: "If `true`, notifications are held back until " +
+											"the last retry of the job.",
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(true),
+									},
+								},
 							},
 						},
 					},
@@ -603,7 +965,7 @@ Supported job types:
 			// -----------------------------------------------------------------
 			"guest_processing": schema.SingleNestedAttribute{
 				MarkdownDescription: "Application-aware processing and guest OS file indexing. " +
-					"Applies to `VSphereBackup` and `HyperVBackup` job types only.",
+					"Applies to `VSphereBackup`, `HyperVBackup` and `CloudDirectorBackup` job types only.",
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
 					"app_aware_enabled": schema.BoolAttribute{
@@ -631,7 +993,7 @@ Supported job types:
 					"guest_credentials": schema.SingleNestedAttribute{
 						MarkdownDescription: "Guest OS credentials used for application-aware processing. " +
 							"When omitted, Veeam uses the credentials configured on the managed server. " +
-							"Applies to `VSphereBackup` and `HyperVBackup` job types only.",
+							"Applies to `VSphereBackup`, `HyperVBackup` and `CloudDirectorBackup` job types only.",
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
 							"credentials_id": schema.StringAttribute{
@@ -641,138 +1003,673 @@ Supported job types:
 							},
 						},
 					},
+					"object_overrides": schema.ListNestedAttribute{
+						MarkdownDescription: "Per-VM guest processing settings that override the job-wide " +
+							"values: credentials, application-aware options, indexing scope and " +
+							"pre-freeze / post-thaw scripts. Each entry is keyed by `object_id`.",
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: guestObjectOverrideAttributes(),
+						},
+					},
 				},
 			},
 
 			// -----------------------------------------------------------------
 			// Schedule
 			// -----------------------------------------------------------------
-			"schedule": schema.SingleNestedAttribute{
-				MarkdownDescription: "Job scheduling configuration. When omitted, the job " +
-					"must be started manually.",
+			"schedule": scheduleAttribute(),
+		},
+	}
+}
+
+// scheduleAttribute returns the schedule block (BackupScheduleModel) shared
+// by backup and replication jobs.
+func scheduleAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Job scheduling configuration. When omitted, the job " +
+			"must be started manually.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"run_automatically": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the job runs on the configured " +
+					"schedule automatically.",
 				Optional: true,
-				Attributes: map[string]schema.Attribute{
-					"run_automatically": schema.BoolAttribute{
-						MarkdownDescription: "If `true`, the job runs on the configured " +
-							"schedule automatically.",
-						Optional: true,
-						Computed: true,
-						Default:  booldefault.StaticBool(false),
-					},
-					// Daily
-					"daily_enabled": schema.BoolAttribute{
-						MarkdownDescription: "Enable daily schedule.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"daily_local_time": schema.StringAttribute{
-						MarkdownDescription: "Daily start time in `HH:MM` format (server local time).",
-						Optional:            true,
-						Computed:            true,
-					},
-					"daily_kind": schema.StringAttribute{
-						MarkdownDescription: "Which days to run. " +
-							"Allowed values: `Everyday`, `WeekDays`, `SelectedDays`.",
-						Optional: true,
-						Computed: true,
-					},
-					// Monthly
-					"monthly_enabled": schema.BoolAttribute{
-						MarkdownDescription: "Enable monthly schedule.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"monthly_local_time": schema.StringAttribute{
-						MarkdownDescription: "Monthly start time in `HH:MM` format.",
-						Optional:            true,
-						Computed:            true,
-					},
-					"monthly_day_of_month": schema.Int64Attribute{
-						MarkdownDescription: "Day of the month (1–28) on which the job runs.",
-						Optional:            true,
-						Computed:            true,
-					},
-					// Periodic (interval)
-					"periodically_enabled": schema.BoolAttribute{
-						MarkdownDescription: "Enable periodic (interval-based) schedule.",
-						Optional:            true,
-						Computed:            true,
-						Default:             booldefault.StaticBool(false),
-					},
-					"periodically_kind": schema.StringAttribute{
-						MarkdownDescription: "Time unit for the interval. " +
-							"Allowed values: `Hours`, `Minutes`, `Seconds`, `Days`.",
-						Optional: true,
-						Computed: true,
-					},
-					"periodically_frequency": schema.Int64Attribute{
-						MarkdownDescription: "Number of time units between runs.",
-						Optional:            true,
-						Computed:            true,
-					},
-					// After-job chaining
-					"after_job_enabled": schema.BoolAttribute{
-						MarkdownDescription: "If `true`, this job starts automatically after " +
-							"another job completes.",
-						Optional: true,
-						Computed: true,
-						Default:  booldefault.StaticBool(false),
-					},
-					"after_job_name": schema.StringAttribute{
-						MarkdownDescription: "Display **name** of the preceding job. " +
-							"The Veeam API v1.3 identifies chained jobs by name, not UUID.",
-						Optional: true,
-						Computed: true,
-					},
-					// Retry
-					"retry_enabled": schema.BoolAttribute{
-						MarkdownDescription: "If `true`, the job is retried automatically " +
-							"when it fails.",
-						Optional: true,
-						Computed: true,
-						Default:  booldefault.StaticBool(false),
-					},
-					"retry_count": schema.Int64Attribute{
-						MarkdownDescription: "Number of retry attempts (must be > 0).",
-						Optional:            true,
-						Computed:            true,
-					},
-					"retry_await_minutes": schema.Int64Attribute{
-						MarkdownDescription: "Wait time between retries in minutes (must be > 0).",
-						Optional:            true,
-						Computed:            true,
-					},
-				},
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			// Daily
+			"daily_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable daily schedule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"daily_local_time": schema.StringAttribute{
+				MarkdownDescription: "Daily start time in `HH:MM` format (server local time).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"daily_kind": schema.StringAttribute{
+				MarkdownDescription: "Which days to run. " +
+					"Allowed values: `Everyday`, `WeekDays`, `SelectedDays`.",
+				Optional: true,
+				Computed: true,
+			},
+			// Monthly
+			"monthly_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable monthly schedule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"monthly_local_time": schema.StringAttribute{
+				MarkdownDescription: "Monthly start time in `HH:MM` format.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"monthly_day_of_month": schema.Int64Attribute{
+				MarkdownDescription: "Day of the month (1–28) on which the job runs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			// Periodic (interval)
+			"periodically_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable periodic (interval-based) schedule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"periodically_kind": schema.StringAttribute{
+				MarkdownDescription: "Time unit for the interval. " +
+					"Allowed values: `Hours`, `Minutes`, `Seconds`, `Days`.",
+				Optional: true,
+				Computed: true,
+			},
+			"periodically_frequency": schema.Int64Attribute{
+				MarkdownDescription: "Number of time units between runs.",
+				Optional:            true,
+				Computed:            true,
+			},
+			// After-job chaining
+			"after_job_enabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, this job starts automatically after " +
+					"another job completes.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"after_job_name": schema.StringAttribute{
+				MarkdownDescription: "Display **name** of the preceding job. " +
+					"The Veeam API v1.3 identifies chained jobs by name, not UUID.",
+				Optional: true,
+				Computed: true,
+			},
+			// Retry
+			"retry_enabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the job is retried automatically " +
+					"when it fails.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"retry_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of retry attempts (must be > 0).",
+				Optional:            true,
+				Computed:            true,
 			},
+			"retry_await_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Wait time between retries in minutes (must be > 0).",
+				Optional:            true,
+				Computed:            true,
+			},
+			// Backup window
+			"backup_window": backupWindowAttribute(),
 		},
 	}
 }
 
-// ---------------------------------------------------------------------------
-// Configure
-// ---------------------------------------------------------------------------
-
-func (r *BackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	c, ok := req.ProviderData.(client.APIClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Provider Data",
-			"Expected client.APIClient from provider configuration.",
-		)
-		return
+// gfsPolicyAttribute returns the gfs_policy block (GFSPolicySettingsModel)
+// shared by backup and backup copy jobs.
+func gfsPolicyAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Grandfather-Father-Son (GFS) long-term archival retention policy. " +
+			"When configured, Veeam preserves selected weekly, monthly, and yearly " +
+			"full backups beyond the standard retention window.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Master switch that activates the GFS retention policy.",
+				Required:            true,
+			},
+			"weekly_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Activate weekly GFS archival. " +
+					"Preserves one full backup per week for the configured duration.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"weekly_keep_for": schema.Int64Attribute{
+				MarkdownDescription: "Number of weeks to retain weekly full backups (1\u20139999). " +
+					"Only evaluated when `weekly_enabled = true`.",
+				Optional: true,
+				Computed: true,
+			},
+			"weekly_desired_time": schema.StringAttribute{
+				MarkdownDescription: "Day of the week on which the weekly full backup is created. " +
+					"Allowed values: `Monday`, `Tuesday`, `Wednesday`, `Thursday`, " +
+					"`Friday`, `Saturday`, `Sunday`.",
+				Optional: true,
+				Computed: true,
+			},
+			"monthly_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Activate monthly GFS archival. " +
+					"Preserves one full backup per month for the configured duration.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"monthly_keep_for": schema.Int64Attribute{
+				MarkdownDescription: "Number of months to retain monthly full backups (1\u2013999). " +
+					"Only evaluated when `monthly_enabled = true`.",
+				Optional: true,
+				Computed: true,
+			},
+			"monthly_desired_time": schema.StringAttribute{
+				MarkdownDescription: "Week of the month on which the monthly full backup is created. " +
+					"Allowed values: `First`, `Second`, `Third`, `Fourth`, `Last`.",
+				Optional: true,
+				Computed: true,
+			},
+			"yearly_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Activate yearly GFS archival. " +
+					"Preserves one full backup per year for the configured duration.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"yearly_keep_for": schema.Int64Attribute{
+				MarkdownDescription: "Number of years to retain yearly full backups (1\u2013999). " +
+					"Only evaluated when `yearly_enabled = true`.",
+				Optional: true,
+				Computed: true,
+			},
+			"yearly_desired_time": schema.StringAttribute{
+				MarkdownDescription: "Month of the year in which the yearly full backup is created. " +
+					"Allowed values: `January`, `February`, `March`, `April`, `May`, " +
+					"`June`, `July`, `August`, `September`, `October`, `November`, `December`.",
+				Optional: true,
+				Computed: true,
+			},
+		},
 	}
-	r.client = c
 }
 
-// ---------------------------------------------------------------------------
-// CRUD — Create
-// ---------------------------------------------------------------------------
-
+// encryptionAttribute returns the encryption block (BackupStorageEncryptionModel).
+func encryptionAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Encrypts backup files. Set exactly one of " +
+			"`encryption_password_id` or `kms_server_id`. " +
+			"When omitted, encryption is disabled.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"encryption_password_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the encryption password. " +
+					"Obtain from the `veeam_encryption_password` resource.",
+				Optional: true,
+			},
+			"kms_server_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the KMS server that manages " +
+					"the encryption keys. Obtain from the `veeam_kms_server` resource.",
+				Optional: true,
+			},
+		},
+		Validators: []validator.Object{encryptionKeySourceValidator{}},
+	}
+}
+
+// backupWindowAttribute returns the backup_window hour grid
+// (ScheduleBackupWindowModel).
+func backupWindowAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Permitted hours per weekday. A job still running " +
+			"outside the window is stopped. When omitted, the job may run at any time.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"days": schema.ListNestedAttribute{
+				MarkdownDescription: "Weekdays with permitted hours. Days not listed " +
+					"are denied entirely.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							MarkdownDescription: "Day of the week: `Monday` … `Sunday`.",
+							Required:            true,
+						},
+						"hours": schema.SetAttribute{
+							MarkdownDescription: "Set of permitted hours (0–23). Hour `22` covers " +
+								"22:00–23:00, so a 22:00–06:00 window is " +
+								"`[22, 23, 0, 1, 2, 3, 4, 5]`. The order is not significant.",
+							ElementType: types.Int64Type,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// vmObjectAttributes returns the attributes identifying a VMware vSphere,
+// Hyper-V or Cloud Director inventory object (VmwareObjectModel).
+func vmObjectAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"platform": schema.StringAttribute{
+			MarkdownDescription: "Hypervisor platform. " +
+				"Allowed values: `VSphere`, `HyperV`, `CloudDirector`.",
+			Required: true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Object type within the platform. " +
+				"vSphere examples: `VirtualMachine`, `Folder`, " +
+				"`Datacenter`, `Cluster`, `Host`, `ResourcePool`, " +
+				"`VirtualApp`, `Tag`. " +
+				"Hyper-V: `VirtualMachine`, `Host`, `Cluster`, `SCVMM`, " +
+				"`HostGroup`, `Tag`. " +
+				"Cloud Director: `CloudDirectorServer`, `Organization`, " +
+				"`OrganizationVDC`, `vApp`, `VirtualMachine`. " +
+				"Leave empty to let the API infer the type.",
+			Optional: true,
+			Computed: true,
+		},
+		"host_name": schema.StringAttribute{
+			MarkdownDescription: "FQDN or IP address of the vCenter " +
+				"Server or ESXi host that owns this object. For Hyper-V, " +
+				"the SCVMM server, failover cluster or standalone host. " +
+				"For Cloud Director, the Cloud Director server.",
+			Optional: true,
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the inventory object " +
+				"(VM name, folder name, datacenter name, etc.).",
+			Required: true,
+		},
+		"object_id": schema.StringAttribute{
+			MarkdownDescription: "vSphere MoRef ID (e.g. `vm-101`, " +
+				"`domain-c12`) or Hyper-V object ID. Required for all " +
+				"objects except vCenter Servers, SCVMM servers and " +
+				"standalone hosts.",
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+// vmExclusionsAttribute returns the excludes block (vms / disks) of a VM
+// job scope.
+func vmExclusionsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Objects removed from the included containers.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"vms": schema.ListNestedAttribute{
+				MarkdownDescription: "VMs (or containers) excluded from the backup scope.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: vmObjectAttributes(),
+				},
+			},
+			"disks": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-VM disk selection. Each entry identifies a VM " +
+					"and the disks to process for it.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: vmDiskExclusionAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// vmDiskExclusionAttributes extends vmObjectAttributes with the disk
+// selection of VmwareObjectDiskModel.
+func vmDiskExclusionAttributes() map[string]schema.Attribute {
+	attrs := vmObjectAttributes()
+	attrs["disks_to_process"] = schema.StringAttribute{
+		MarkdownDescription: "Disks to back up for this VM: `AllDisks`, `SystemOnly` " +
+			"(system disk only, e.g. to skip SQL tempdb disks) or `SelectedDisks`.",
+		Required: true,
+	}
+	attrs["disks"] = schema.ListAttribute{
+		MarkdownDescription: "Disk IDs to process (e.g. `scsi0:1`). " +
+			"Required when `disks_to_process = \"SelectedDisks\"`.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+	}
+	attrs["remove_from_vm_configuration"] = schema.BoolAttribute{
+		MarkdownDescription: "Remove the skipped disks from the VM configuration " +
+			"stored in the backup.",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+	return attrs
+}
+
+// guestObjectOverrideAttributes extends vmObjectAttributes with the per-VM
+// guest processing settings. object_id is required since it keys the entry.
+func guestObjectOverrideAttributes() map[string]schema.Attribute {
+	attrs := vmObjectAttributes()
+	attrs["object_id"] = schema.StringAttribute{
+		MarkdownDescription: "vSphere MoRef ID or Hyper-V VM ID of the VM (e.g. `vm-101`). " +
+			"Must be unique across `object_overrides`.",
+		Required: true,
+	}
+	attrs["credentials_id"] = schema.StringAttribute{
+		MarkdownDescription: "UUID of the credentials record used for this VM when it runs Windows.",
+		Optional:            true,
+	}
+	attrs["linux_credentials_id"] = schema.StringAttribute{
+		MarkdownDescription: "UUID of the credentials record used for this VM when it runs Linux.",
+		Optional:            true,
+	}
+	attrs["app_aware"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "Application-aware processing options for this VM.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"vss": schema.StringAttribute{
+				MarkdownDescription: "VSS behaviour: `RequireSuccess` (default), `IgnoreFailures` or `Disabled`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"transaction_logs": schema.StringAttribute{
+				MarkdownDescription: "Transaction log handling: `Process` (default) or `CopyOnly` " +
+					"(leave logs untouched for another backup tool).",
+				Optional: true,
+				Computed: true,
+			},
+			"sql": schema.SingleNestedAttribute{
+				MarkdownDescription: "Microsoft SQL Server transaction log settings. " +
+					"Applies when `transaction_logs = \"Process\"`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"logs_processing": schema.StringAttribute{
+						MarkdownDescription: "`TruncateLogs`, `PreventTruncation` or `BackupLogsPeriodically`.",
+						Required:            true,
+					},
+					"backup_interval_minutes": schema.Int64Attribute{
+						MarkdownDescription: "Log backup interval in minutes. " +
+							"Only with `logs_processing = \"BackupLogsPeriodically\"`.",
+						Optional: true,
+						Computed: true,
+					},
+					"retain_log_backups": schema.StringAttribute{
+						MarkdownDescription: "Log backup retention: `UntilBackupIsDeleted` or `KeepOnlyLastDays`. " +
+							"Only with `logs_processing = \"BackupLogsPeriodically\"`.",
+						Optional: true,
+						Computed: true,
+					},
+					"retain_days": schema.Int64Attribute{
+						MarkdownDescription: "Days to keep log backups. " +
+							"Only with `retain_log_backups = \"KeepOnlyLastDays\"`.",
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			"oracle": schema.SingleNestedAttribute{
+				MarkdownDescription: "Oracle archived log settings. " +
+					"Applies when `transaction_logs = \"Process\"`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"credentials_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the credentials record used to connect to Oracle. " +
+							"When omitted, the guest OS credentials are used.",
+						Optional: true,
+					},
+					"archive_logs": schema.StringAttribute{
+						MarkdownDescription: "`DoNotDelete`, `DeleteLogsOlderThanHours` or `DeleteLogsOverGb`.",
+						Required:            true,
+					},
+					"delete_older_than_hours": schema.Int64Attribute{
+						MarkdownDescription: "Required with `archive_logs = \"DeleteLogsOlderThanHours\"`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"delete_over_gb": schema.Int64Attribute{
+						MarkdownDescription: "Required with `archive_logs = \"DeleteLogsOverGb\"`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"backup_logs": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, archived logs are backed up periodically.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"backup_interval_minutes": schema.Int64Attribute{
+						MarkdownDescription: "Archived log backup interval in minutes. " +
+							"Only with `backup_logs = true`.",
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	attrs["indexing"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "Guest file indexing scope for this VM. Applied to both the " +
+			"Windows and Linux indexing settings.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "`Disabled`, `IndexAll`, `IndexAllExcept` or `IndexOnly`.",
+				Required:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Folders to exclude (`IndexAllExcept`) or include (`IndexOnly`). " +
+					"Environment variables such as `%windir%` are allowed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+	attrs["scripts"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "Pre-freeze and post-thaw scripts run inside this VM around the snapshot.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "`RequireSuccess`, `IgnoreExecFailures` or `DisableExec`.",
+				Required:            true,
+			},
+			"windows_pre_freeze_script": schema.StringAttribute{
+				MarkdownDescription: "Path to the script run before the snapshot on Windows guests.",
+				Optional:            true,
+			},
+			"windows_post_thaw_script": schema.StringAttribute{
+				MarkdownDescription: "Path to the script run after the snapshot on Windows guests.",
+				Optional:            true,
+			},
+			"linux_pre_freeze_script": schema.StringAttribute{
+				MarkdownDescription: "Path to the script run before the snapshot on Linux guests.",
+				Optional:            true,
+			},
+			"linux_post_thaw_script": schema.StringAttribute{
+				MarkdownDescription: "Path to the script run after the snapshot on Linux guests.",
+				Optional:            true,
+			},
+		},
+	}
+	return attrs
+}
+
+// advancedScheduleAttribute returns the weekly / monthly schedule block used by
+// the advanced_settings.backup operations (AdvancedStorageScheduleModel).
+func advancedScheduleAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + " Set exactly one of `weekly` or `monthly`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"weekly": schema.SingleNestedAttribute{
+				MarkdownDescription: "Run on selected days of the week.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.ListAttribute{
+						MarkdownDescription: "Days of the week, e.g. `[\"Saturday\"]`.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"monthly": schema.SingleNestedAttribute{
+				MarkdownDescription: "Run once a month.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"day_number_in_month": schema.StringAttribute{
+						MarkdownDescription: "Week of the month: `First`, `Second`, `Third`, " +
+							"`Fourth`, `Last`, or `OnDay` to use `day_of_month`.",
+						Required: true,
+					},
+					"day_of_week": schema.StringAttribute{
+						MarkdownDescription: "Day of the week. Required unless " +
+							"`day_number_in_month = \"OnDay\"`.",
+						Optional: true,
+					},
+					"day_of_month": schema.Int64Attribute{
+						MarkdownDescription: "Day of the month (1–31). Required when " +
+							"`day_number_in_month = \"OnDay\"`.",
+						Optional: true,
+					},
+					"months": schema.ListAttribute{
+						MarkdownDescription: "Months in which the operation runs. " +
+							"When omitted, the server default (every month) applies.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// proxySelectionValidator checks storage.proxy_auto_select against its sibling
+// proxy_ids at plan time: manual selection needs at least one proxy, and
+// proxies are only accepted when auto-selection is off.
+type proxySelectionValidator struct{}
+
+func (v proxySelectionValidator) Description(_ context.Context) string {
+	return "proxy_ids must be non-empty when proxy_auto_select is false, and unset otherwise"
+}
+
+func (v proxySelectionValidator) MarkdownDescription(_ context.Context) string {
+	return "`proxy_ids` must be non-empty when `proxy_auto_select` is `false`, and unset otherwise"
+}
+
+func (v proxySelectionValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+	var proxyIDs types.List
+	idsPath := req.Path.ParentPath().AtName("proxy_ids")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, idsPath, &proxyIDs)...)
+	if resp.Diagnostics.HasError() || proxyIDs.IsUnknown() {
+		return
+	}
+
+	// A null proxy_auto_select takes the default of true.
+	autoSelect := req.ConfigValue.IsNull() || req.ConfigValue.ValueBool()
+	hasProxies := !proxyIDs.IsNull() && len(proxyIDs.Elements()) > 0
+	switch {
+	case !autoSelect && !hasProxies:
+		resp.Diagnostics.AddAttributeError(idsPath, "Missing backup proxies",
+			"proxy_ids must list at least one veeam_proxy when proxy_auto_select is false.")
+	case autoSelect && !proxyIDs.IsNull():
+		resp.Diagnostics.AddAttributeError(idsPath, "proxy_ids requires manual proxy selection",
+			"Set proxy_auto_select = false to pin the job to proxy_ids.")
+	}
+}
+
+// ValidateConfig runs the checks that span several attributes, so an invalid
+// configuration fails at plan time instead of after the job is created.
+func (r *BackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BackupJobModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// A whole block is unknown until apply (e.g. built from another
+		// resource's output); there is nothing to check yet.
+		return
+	}
+
+	if err := validateVMExclusions(data.VirtualMachines); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("virtual_machines").AtName("excludes"),
+			"Invalid virtual_machines.excludes", err.Error())
+	}
+	if err := validateAdvancedSettings(&data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("advanced_settings"), "Invalid advanced_settings", err.Error())
+	}
+	if err := validateGuestObjectOverrides(data.GuestProcessing); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("guest_processing").AtName("object_overrides"),
+			"Invalid guest_processing.object_overrides", err.Error())
+	}
+}
+
+// encryptionKeySourceValidator checks that an encryption block names exactly
+// one key source: an encryption password or a KMS server.
+type encryptionKeySourceValidator struct{}
+
+func (v encryptionKeySourceValidator) Description(_ context.Context) string {
+	return "exactly one of encryption_password_id and kms_server_id must be set"
+}
+
+func (v encryptionKeySourceValidator) MarkdownDescription(_ context.Context) string {
+	return "exactly one of `encryption_password_id` and `kms_server_id` must be set"
+}
+
+func (v encryptionKeySourceValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	set := 0
+	for _, name := range []string{"encryption_password_id", "kms_server_id"} {
+		id, _ := req.ConfigValue.Attributes()[name].(types.String)
+		if id.IsUnknown() {
+			return
+		}
+		if id.ValueString() != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid encryption key source",
+			"Set exactly one of encryption_password_id or kms_server_id.")
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Configure
+// ---------------------------------------------------------------------------
+
+func (r *BackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected client.APIClient from provider configuration.",
+		)
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD — Create
+// ---------------------------------------------------------------------------
+
 func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data BackupJobModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -781,9 +1678,14 @@ func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	jobType := models.EJobType(data.Type.ValueString())
+	plannedDisabled := data.IsDisabled
+	cloning := data.CloneFromJobID.ValueString() != ""
+	// createdDisabled is the enabled state of the new job: new jobs start
+	// enabled, clones inherit it from the source job.
+	createdDisabled := false
 
 	switch jobType {
-	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup:
+	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup, models.JobTypeCloudDirectorBackup:
 		if data.VirtualMachines == nil || len(data.VirtualMachines.Includes) == 0 {
 			resp.Diagnostics.AddError(
 				"Missing required virtual_machines block",
@@ -791,15 +1693,42 @@ func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp
 			)
 			return
 		}
+		if err := validateVMObjects(&data); err != nil {
+			resp.Diagnostics.AddError("Invalid virtual_machines", err.Error())
+			return
+		}
+		if err := validateBackupWindow(data.Schedule); err != nil {
+			resp.Diagnostics.AddError("Invalid schedule.backup_window", err.Error())
+			return
+		}
 
-		spec := r.buildVMJobSpec(&data)
 		var result models.BackupJobModel
-		if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
-			resp.Diagnostics.AddError("Failed to create backup job",
-				fmt.Sprintf("POST %s: %s", client.PathJobs, err))
-			return
+		if cloning {
+			id, disabled, err := r.cloneJob(ctx, &data)
+			if err != nil {
+				keepClonedJob(ctx, resp, id)
+				resp.Diagnostics.AddError("Failed to clone backup job", err.Error())
+				return
+			}
+			data.ID = types.StringValue(id)
+			createdDisabled = disabled
+			endpoint := fmt.Sprintf(client.PathJobByID, id)
+			if err := putMergedPayload(ctx, r.client, endpoint, r.buildVMJobModel(&data, disabled), &result, jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...); err != nil {
+				keepClonedJob(ctx, resp, id)
+				resp.Diagnostics.AddError("Failed to apply settings to cloned backup job",
+					fmt.Sprintf("Backup job %s was cloned but PUT %s failed: %s. The clone was saved to state "+
+						"and will be replaced on the next apply.", id, endpoint, err))
+				return
+			}
+		} else {
+			spec := r.buildVMJobSpec(&data)
+			if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+				resp.Diagnostics.AddError("Failed to create backup job",
+					fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+				return
+			}
+			data.ID = types.StringValue(result.ID)
 		}
-		data.ID = types.StringValue(result.ID)
 		r.syncVMJobFromAPI(&data, &result)
 
 	case models.JobTypeWindowsAgentBackup, models.JobTypeLinuxAgentBackup:
@@ -813,7 +1742,7 @@ func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp
 		if data.GuestProcessing != nil {
 			resp.Diagnostics.AddError(
 				"guest_processing is not supported for agent backup jobs",
-				fmt.Sprintf("Job type '%s' does not support guest_processing in this provider. Use VSphereBackup/HyperVBackup for guest processing settings.", jobType),
+				fmt.Sprintf("Job type '%s' does not support guest_processing in this provider. Use VSphereBackup/HyperVBackup/CloudDirectorBackup for guest processing settings.", jobType),
 			)
 			return
 		}
@@ -824,13 +1753,37 @@ func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp
 			)
 			return
 		}
+		if err := validateBackupWindow(data.Schedule); err != nil {
+			resp.Diagnostics.AddError("Invalid schedule.backup_window", err.Error())
+			return
+		}
 
-		spec := r.buildAgentJobSpec(&data)
 		var result map[string]interface{}
-		if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
-			resp.Diagnostics.AddError("Failed to create agent backup job",
-				fmt.Sprintf("POST %s: %s", client.PathJobs, err))
-			return
+		if cloning {
+			id, disabled, err := r.cloneJob(ctx, &data)
+			if err != nil {
+				keepClonedJob(ctx, resp, id)
+				resp.Diagnostics.AddError("Failed to clone agent backup job", err.Error())
+				return
+			}
+			data.ID = types.StringValue(id)
+			createdDisabled = disabled
+			endpoint := fmt.Sprintf(client.PathJobByID, id)
+			result, err = r.putAgentJob(ctx, endpoint, &data, disabled)
+			if err != nil {
+				keepClonedJob(ctx, resp, id)
+				resp.Diagnostics.AddError("Failed to apply settings to cloned agent backup job",
+					fmt.Sprintf("Agent backup job %s was cloned but the update failed: %s. The clone was saved "+
+						"to state and will be replaced on the next apply.", id, err))
+				return
+			}
+		} else {
+			spec := r.buildAgentJobSpec(&data)
+			if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+				resp.Diagnostics.AddError("Failed to create agent backup job",
+					fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+				return
+			}
 		}
 		if id, ok := result["id"].(string); ok && id != "" {
 			data.ID = types.StringValue(id)
@@ -846,9 +1799,60 @@ func (r *BackupJob) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	// Jobs end up enabled unless is_disabled = true, including clones of a
+	// disabled source job.
+	wantDisabled := !plannedDisabled.IsNull() && !plannedDisabled.IsUnknown() && plannedDisabled.ValueBool()
 	r.normalizeUnknownStateFields(&data)
+	resp.Diagnostics.Append(saveJobState(ctx, r.client, "backup job", data.ID.ValueString(), createdDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, backupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+// cloneSourceChanged requires replacement when clone_from_job_id is set to a
+// new source, but not when it is removed after the job was created.
+func cloneSourceChanged(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.PlanValue.IsNull()
+}
+
+// cloneJob clones clone_from_job_id and returns the new job ID and its
+// enabled state. The clone must be of the configured job type; when it is
+// not, the ID of the clone is returned together with the error.
+func (r *BackupJob) cloneJob(ctx context.Context, data *BackupJobModel) (string, bool, error) {
+	endpoint := fmt.Sprintf(client.PathJobClone, data.CloneFromJobID.ValueString())
+	spec := &models.JobCloneSpec{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	var result map[string]interface{}
+	if err := r.client.PostJSON(ctx, endpoint, spec, &result); err != nil {
+		return "", false, fmt.Errorf("POST %s: %w", endpoint, err)
+	}
+	id, _ := result["id"].(string)
+	if id == "" {
+		return "", false, fmt.Errorf("POST %s: response did not include the new job ID", endpoint)
+	}
+	if t, _ := result["type"].(string); t != "" && t != data.Type.ValueString() {
+		return id, false, fmt.Errorf("job %s is of type %s but type is set to %s; the cloned job %s "+
+			"was saved to state and will be replaced on the next apply",
+			data.CloneFromJobID.ValueString(), t, data.Type.ValueString(), id)
+	}
+	disabled, _ := result["isDisabled"].(bool)
+	return id, disabled, nil
+}
+
+// keepClonedJob records the ID of a clone whose setup failed. Terraform keeps
+// a resource that fails during Create as tainted, so the clone is deleted and
+// created again on the next apply instead of being orphaned. Nothing is saved
+// when the clone request itself failed (id is empty).
+func keepClonedJob(ctx context.Context, resp *resource.CreateResponse, id string) {
+	if id == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(id))...)
+	resp.Diagnostics.Append(backupJobIdentity.set(ctx, resp.Identity, types.StringValue(id), types.StringNull())...)
 }
 
 // ---------------------------------------------------------------------------
@@ -867,7 +1871,7 @@ func (r *BackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	jobType := models.EJobType(data.Type.ValueString())
 
 	switch jobType {
-	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup:
+	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup, models.JobTypeCloudDirectorBackup:
 		var result models.BackupJobModel
 		if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
 			resp.Diagnostics.AddError("Failed to read backup job",
@@ -905,6 +1909,7 @@ func (r *BackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *re
 	r.normalizeUnknownStateFields(&data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(backupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 // ---------------------------------------------------------------------------
@@ -926,6 +1931,13 @@ func (r *BackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 	data.ID = state.ID
 
+	// The enabled state is applied through its own endpoints after the PUT,
+	// so the PUT carries the current value.
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
 	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
 	jobType := models.EJobType(data.Type.ValueString())
 
@@ -933,7 +1945,7 @@ func (r *BackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp
 	// isDisabled) — not just the creation spec.  We therefore build the full model
 	// object for the update payload.
 	switch jobType {
-	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup:
+	case models.JobTypeVSphereBackup, models.JobTypeHyperVBackup, models.JobTypeCloudDirectorBackup:
 		if data.VirtualMachines == nil || len(data.VirtualMachines.Includes) == 0 {
 			resp.Diagnostics.AddError(
 				"Missing required virtual_machines block",
@@ -941,9 +1953,17 @@ func (r *BackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp
 			)
 			return
 		}
+		if err := validateVMObjects(&data); err != nil {
+			resp.Diagnostics.AddError("Invalid virtual_machines", err.Error())
+			return
+		}
+		if err := validateBackupWindow(data.Schedule); err != nil {
+			resp.Diagnostics.AddError("Invalid schedule.backup_window", err.Error())
+			return
+		}
 		payload := r.buildVMJobModel(&data, state.IsDisabled.ValueBool())
 		var result models.BackupJobModel
-		if err := r.client.PutJSON(ctx, endpoint, payload, &result); err != nil {
+		if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...); err != nil {
 			resp.Diagnostics.AddError("Failed to update backup job",
 				fmt.Sprintf("PUT %s: %s", endpoint, err))
 			return
@@ -963,25 +1983,17 @@ func (r *BackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp
 		if data.GuestProcessing != nil {
 			resp.Diagnostics.AddError(
 				"guest_processing is not supported for agent backup jobs",
-				fmt.Sprintf("Job type '%s' does not support guest_processing in this provider. Use VSphereBackup/HyperVBackup for guest processing settings.", jobType),
+				fmt.Sprintf("Job type '%s' does not support guest_processing in this provider. Use VSphereBackup/HyperVBackup/CloudDirectorBackup for guest processing settings.", jobType),
 			)
 			return
 		}
-		payload := r.buildAgentJobModel(&data, state.IsDisabled.ValueBool())
-
-		// Agent job PUT expects immutable discriminator fields to be preserved.
-		// Fetch the current model and carry forward agentType when present.
-		var current map[string]any
-		if err := r.client.GetJSON(ctx, endpoint, &current); err == nil {
-			if agentType, ok := current["agentType"].(string); ok && agentType != "" {
-				payload["agentType"] = agentType
-			}
+		if err := validateBackupWindow(data.Schedule); err != nil {
+			resp.Diagnostics.AddError("Invalid schedule.backup_window", err.Error())
+			return
 		}
-
-		var result map[string]interface{}
-		if err := r.client.PutJSON(ctx, endpoint, payload, &result); err != nil {
-			resp.Diagnostics.AddError("Failed to update agent backup job",
-				fmt.Sprintf("PUT %s: %s", endpoint, err))
+		result, err := r.putAgentJob(ctx, endpoint, &data, state.IsDisabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update agent backup job", err.Error())
 			return
 		}
 		if id, ok := result["id"].(string); ok && id != "" {
@@ -997,8 +2009,36 @@ func (r *BackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	r.normalizeUnknownStateFields(&data)
+	resp.Diagnostics.Append(saveJobState(ctx, r.client, "backup job", data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, backupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+// putAgentJob merges the planned agent job settings into the current job and
+// PUTs the result. Agent job PUT expects immutable discriminator fields to be
+// preserved, so agentType is carried forward from the current model.
+func (r *BackupJob) putAgentJob(ctx context.Context, endpoint string, data *BackupJobModel, isDisabled bool) (map[string]interface{}, error) {
+	payload := r.buildAgentJobModel(data, isDisabled)
+
+	var current map[string]any
+	if err := r.client.GetJSON(ctx, endpoint, &current); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	if agentType, ok := current["agentType"].(string); ok && agentType != "" {
+		payload["agentType"] = agentType
+	}
+	merged, err := mergeManagedPayload(current, payload, jobManagedPaths(agentJobManagedPaths, data.AdvancedSettings)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := r.client.PutJSON(ctx, endpoint, merged, &result); err != nil {
+		return nil, fmt.Errorf("PUT %s: %w", endpoint, err)
+	}
+	return result, nil
 }
 
 // ---------------------------------------------------------------------------
@@ -1025,8 +2065,15 @@ func (r *BackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp
 // ImportState
 // ---------------------------------------------------------------------------
 
+// backupJobImport resolves "name:<job name>" import IDs in addition to UUIDs.
+var backupJobImport = naturalKeyImport{
+	kind:         "backup job",
+	listEndpoint: client.PathJobs,
+	keys:         map[string]string{"name": "name"},
+}
+
 func (r *BackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	backupJobIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -1055,6 +2102,7 @@ func (r *BackupJob) buildVMJobSpec(data *BackupJobModel) *models.BackupJobSpec {
 
 	if data.Storage != nil {
 		spec.Storage = r.buildStorageModel(data.Storage)
+		spec.Storage.AdvancedSettings = buildAdvancedSettingsModel(data.AdvancedSettings)
 	}
 
 	if data.GuestProcessing != nil {
@@ -1062,12 +2110,74 @@ func (r *BackupJob) buildVMJobSpec(data *BackupJobModel) *models.BackupJobSpec {
 	}
 
 	if data.Schedule != nil {
-		spec.Schedule = r.buildScheduleModel(data.Schedule)
+		spec.Schedule = buildScheduleModel(data.Schedule)
 	}
 
 	return spec
 }
 
+// vmJobManagedPaths lists the nested VM job fields owned by this resource.
+// They are removed from the merged PUT body when the plan no longer sets them
+// (see mergeManagedPayload); every other server-side field is preserved.
+var vmJobManagedPaths = []string{
+	"virtualMachines.excludes.vms",
+	"virtualMachines.excludes.disks",
+	"virtualMachines.excludes.templates",
+	"storage.retentionPolicy",
+	"storage.gfsPolicy",
+	"guestProcessing.guestCredentials",
+	"guestProcessing.appAwareProcessing.appSettings",
+	"guestProcessing.guestFSIndexing.indexingSettings",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// encryptionManagedPaths are the key source fields of
+// advanced_settings.storage.encryption. Switching from a password to a KMS
+// server must drop the old ID, but only while the storage section is
+// configured; an omitted section is left as set on the server.
+var encryptionManagedPaths = []string{
+	"storage.advancedSettings.storageData.encryption.encryptionPasswordId",
+	"storage.advancedSettings.storageData.encryption.kmsServerId",
+}
+
+// hyperVManagedPath is advanced_settings.hyper_v. It is owned by the plan
+// whenever advanced_settings is configured, so removing hyper_v resets the
+// Hyper-V settings instead of keeping the server values.
+const hyperVManagedPath = "storage.advancedSettings.hyperV"
+
+// jobManagedPaths returns base plus the advanced settings paths the plan
+// manages.
+func jobManagedPaths(base []string, as *JobAdvancedSettings) []string {
+	paths := slices.Clone(base)
+	if as == nil {
+		return paths
+	}
+	paths = append(paths, hyperVManagedPath)
+	if as.Storage != nil {
+		paths = append(paths, encryptionManagedPaths...)
+	}
+	return paths
+}
+
+// agentJobManagedPaths is the agent job counterpart of vmJobManagedPaths.
+var agentJobManagedPaths = []string{
+	"storage.retentionPolicy",
+	"storage.gfsPolicy",
+	"volumes",
+	"files",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
 // buildVMJobModel converts Terraform plan state into a full BackupJobModel for PUT (update).
 // The Veeam API PUT endpoint expects the complete JobModel (including id / isDisabled).
 func (r *BackupJob) buildVMJobModel(data *BackupJobModel, isDisabled bool) *models.BackupJobModel {
@@ -1085,6 +2195,7 @@ func (r *BackupJob) buildVMJobModel(data *BackupJobModel, isDisabled bool) *mode
 
 	if data.Storage != nil {
 		m.Storage = r.buildStorageModel(data.Storage)
+		m.Storage.AdvancedSettings = buildAdvancedSettingsModel(data.AdvancedSettings)
 	}
 
 	if data.GuestProcessing != nil {
@@ -1092,7 +2203,7 @@ func (r *BackupJob) buildVMJobModel(data *BackupJobModel, isDisabled bool) *mode
 	}
 
 	if data.Schedule != nil {
-		m.Schedule = r.buildScheduleModel(data.Schedule)
+		m.Schedule = buildScheduleModel(data.Schedule)
 	}
 
 	return m
@@ -1131,7 +2242,9 @@ func (r *BackupJob) buildAgentJobSpec(data *BackupJobModel) map[string]any {
 	}
 
 	if data.Storage != nil {
-		spec["storage"] = r.buildAgentStorageModel(data.Storage)
+		storage := r.buildAgentStorageModel(data.Storage)
+		storage.AdvancedSettings = buildAdvancedSettingsModel(data.AdvancedSettings)
+		spec["storage"] = storage
 	}
 
 	if data.VolumesScope != nil {
@@ -1143,7 +2256,7 @@ func (r *BackupJob) buildAgentJobSpec(data *BackupJobModel) map[string]any {
 	}
 
 	if data.Schedule != nil {
-		spec["schedule"] = r.buildScheduleModel(data.Schedule)
+		spec["schedule"] = buildScheduleModel(data.Schedule)
 	}
 
 	return spec
@@ -1183,7 +2296,9 @@ func (r *BackupJob) buildAgentJobModel(data *BackupJobModel, isDisabled bool) ma
 	}
 
 	if data.Storage != nil {
-		m["storage"] = r.buildAgentStorageModel(data.Storage)
+		storage := r.buildAgentStorageModel(data.Storage)
+		storage.AdvancedSettings = buildAdvancedSettingsModel(data.AdvancedSettings)
+		m["storage"] = storage
 	}
 
 	if data.VolumesScope != nil {
@@ -1195,7 +2310,7 @@ func (r *BackupJob) buildAgentJobModel(data *BackupJobModel, isDisabled bool) ma
 	}
 
 	if data.Schedule != nil {
-		m["schedule"] = r.buildScheduleModel(data.Schedule)
+		m["schedule"] = buildScheduleModel(data.Schedule)
 	}
 
 	return m
@@ -1210,27 +2325,17 @@ func (r *BackupJob) buildVirtualMachinesSpec(scope *VMBackupScope) *models.Backu
 		return nil
 	}
 
-	includes := make([]models.VmwareObjectSpec, 0, len(scope.Includes))
+	includes := make([]models.InventoryObjectSpec, 0, len(scope.Includes))
 	for _, entry := range scope.Includes {
-		platform := entry.Platform.ValueString()
-		if platform == "" {
-			platform = string(models.InventoryPlatformVSphere)
-		}
-		obj := models.VmwareObjectSpec{
-			Platform: platform,
-			Name:     entry.Name.ValueString(),
-			HostName: entry.HostName.ValueString(),
-			Type:     models.EVmwareInventoryType(entry.Type.ValueString()),
-			ObjectID: entry.ObjectID.ValueString(),
-		}
-		includes = append(includes, obj)
+		includes = append(includes, buildVMObject(entry))
 	}
 
 	spec := &models.BackupJobVirtualMachinesSpec{Includes: includes}
-
-	if !scope.ExcludeTemplates.IsNull() && scope.ExcludeTemplates.ValueBool() {
+	if ex := buildVMExclusions(scope); ex != nil {
 		spec.Excludes = &models.BackupJobExclusionsSpec{
-			Templates: &models.BackupJobExclusionsTemplates{IsEnabled: true},
+			VMs:       ex.VMs,
+			Disks:     ex.Disks,
+			Templates: ex.Templates,
 		}
 	}
 
@@ -1242,31 +2347,182 @@ func (r *BackupJob) buildVirtualMachinesModel(scope *VMBackupScope) *models.Back
 		return nil
 	}
 
-	includes := make([]models.VmwareObjectSpec, 0, len(scope.Includes))
+	includes := make([]models.InventoryObjectSpec, 0, len(scope.Includes))
 	for _, entry := range scope.Includes {
-		platform := entry.Platform.ValueString()
-		if platform == "" {
-			platform = string(models.InventoryPlatformVSphere)
+		includes = append(includes, buildVMObject(entry))
+	}
+
+	return &models.BackupJobVirtualMachinesModel{
+		Includes: includes,
+		Excludes: buildVMExclusions(scope),
+	}
+}
+
+// buildVMObject converts an include/exclude entry into an InventoryObjectSpec,
+// defaulting the platform to VSphere.
+func buildVMObject(entry VMIncludeEntry) models.InventoryObjectSpec {
+	platform := entry.Platform.ValueString()
+	if platform == "" {
+		platform = string(models.InventoryPlatformVSphere)
+	}
+	return models.InventoryObjectSpec{
+		Platform: platform,
+		Name:     entry.Name.ValueString(),
+		HostName: entry.HostName.ValueString(),
+		Type:     entry.Type.ValueString(),
+		ObjectID: entry.ObjectID.ValueString(),
+	}
+}
+
+// buildVMExclusions returns the excludes section, or nil when nothing is
+// excluded.
+func buildVMExclusions(scope *VMBackupScope) *models.BackupJobExclusions {
+	ex := &models.BackupJobExclusions{}
+
+	if scope.Excludes != nil {
+		for _, vm := range scope.Excludes.VMs {
+			ex.VMs = append(ex.VMs, buildVMObject(vm))
+		}
+		for _, d := range scope.Excludes.Disks {
+			disk := models.VmwareObjectDiskExclusion{
+				VMObject: buildVMObject(VMIncludeEntry{
+					Platform: d.Platform,
+					Type:     d.Type,
+					HostName: d.HostName,
+					Name:     d.Name,
+					ObjectID: d.ObjectID,
+				}),
+				DisksToProcess:            models.EVmwareDisksTypeToProcess(d.DisksToProcess.ValueString()),
+				Disks:                     []string{},
+				RemoveFromVMConfiguration: d.RemoveFromVMConfiguration.ValueBool(),
+			}
+			if !d.Disks.IsNull() && !d.Disks.IsUnknown() {
+				// Ignore conversion error — an empty list is valid.
+				_ = d.Disks.ElementsAs(context.Background(), &disk.Disks, false)
+			}
+			ex.Disks = append(ex.Disks, disk)
 		}
-		obj := models.VmwareObjectSpec{
-			Platform: platform,
-			Name:     entry.Name.ValueString(),
-			HostName: entry.HostName.ValueString(),
-			Type:     models.EVmwareInventoryType(entry.Type.ValueString()),
-			ObjectID: entry.ObjectID.ValueString(),
+	}
+
+	if scope.ExcludeTemplates.ValueBool() || scope.ExcludeTemplatesFromIncremental.ValueBool() {
+		ex.Templates = &models.BackupJobExclusionsTemplates{
+			IsEnabled:              scope.ExcludeTemplates.ValueBool(),
+			ExcludeFromIncremental: scope.ExcludeTemplatesFromIncremental.ValueBool(),
 		}
-		includes = append(includes, obj)
 	}
 
-	m := &models.BackupJobVirtualMachinesModel{Includes: includes}
+	if len(ex.VMs) == 0 && len(ex.Disks) == 0 && ex.Templates == nil {
+		return nil
+	}
+	return ex
+}
+
+// vmObjectTypes lists the inventory object types accepted per platform.
+var vmObjectTypes = map[models.EInventoryPlatformType][]string{
+	models.InventoryPlatformVSphere: {
+		string(models.VmwareTypeVirtualMachine), string(models.VmwareTypeVCenterServer),
+		string(models.VmwareTypeDatacenter), string(models.VmwareTypeCluster),
+		string(models.VmwareTypeHost), string(models.VmwareTypeResourcePool),
+		string(models.VmwareTypeFolder), string(models.VmwareTypeDatastore),
+		string(models.VmwareTypeDatastoreCluster), string(models.VmwareTypeStoragePolicy),
+		string(models.VmwareTypeTemplate), string(models.VmwareTypeTag),
+		string(models.VmwareTypeCategory), string(models.VmwareTypeVirtualApp),
+	},
+	models.InventoryPlatformHyperV: {
+		string(models.HyperVTypeVirtualMachine), string(models.HyperVTypeHost),
+		string(models.HyperVTypeCluster), string(models.HyperVTypeSCVMM),
+		string(models.HyperVTypeHostGroup), string(models.HyperVTypeTag),
+	},
+	models.InventoryPlatformCloudDirector: {
+		string(models.CloudDirectorTypeServer), string(models.CloudDirectorTypeOrganization),
+		string(models.CloudDirectorTypeOrganizationVDC), string(models.CloudDirectorTypeVApp),
+		string(models.CloudDirectorTypeVirtualMachine),
+	},
+}
+
+// validateVMObjects checks that every inventory object of a VM job belongs to
+// the job's platform and uses an object type of that platform, so a
+// HyperVBackup job is never sent vSphere objects or vice versa.
+func validateVMObjects(data *BackupJobModel) error {
+	platform := models.InventoryPlatformVSphere
+	switch models.EJobType(data.Type.ValueString()) {
+	case models.JobTypeHyperVBackup:
+		platform = models.InventoryPlatformHyperV
+	case models.JobTypeCloudDirectorBackup:
+		platform = models.InventoryPlatformCloudDirector
+	}
 
-	if !scope.ExcludeTemplates.IsNull() && scope.ExcludeTemplates.ValueBool() {
-		m.Excludes = &models.BackupJobExclusions{
-			Templates: &models.BackupJobExclusionsTemplates{IsEnabled: true},
+	check := func(path string, p, t types.String, name string) error {
+		return checkVMObject(path, data.Type.ValueString(), platform, p, t, name)
+	}
+
+	if scope := data.VirtualMachines; scope != nil {
+		for i, vm := range scope.Includes {
+			if err := check(fmt.Sprintf("virtual_machines.includes[%d]", i), vm.Platform, vm.Type, vm.Name.ValueString()); err != nil {
+				return err
+			}
+		}
+		if ex := scope.Excludes; ex != nil {
+			for i, vm := range ex.VMs {
+				if err := check(fmt.Sprintf("virtual_machines.excludes.vms[%d]", i), vm.Platform, vm.Type, vm.Name.ValueString()); err != nil {
+					return err
+				}
+			}
+			for i, d := range ex.Disks {
+				if err := check(fmt.Sprintf("virtual_machines.excludes.disks[%d]", i), d.Platform, d.Type, d.Name.ValueString()); err != nil {
+					return err
+				}
+			}
 		}
 	}
+	if gp := data.GuestProcessing; gp != nil {
+		for i, o := range gp.ObjectOverrides {
+			if err := check(fmt.Sprintf("guest_processing.object_overrides[%d]", i), o.Platform, o.Type, o.Name.ValueString()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
 
-	return m
+// checkVMObject verifies that one inventory object belongs to platform and
+// uses an object type of that platform. jobType is only used in the error.
+func checkVMObject(path, jobType string, platform models.EInventoryPlatformType, p, t types.String, name string) error {
+	if isConfigured(p) && models.EInventoryPlatformType(p.ValueString()) != platform {
+		return fmt.Errorf("%s (%s): platform %q does not match job type %s; expected %q",
+			path, name, p.ValueString(), jobType, platform)
+	}
+	if isConfigured(t) && t.ValueString() != "" && !slices.Contains(vmObjectTypes[platform], t.ValueString()) {
+		return fmt.Errorf("%s (%s): unsupported %s object type %q; expected one of %s",
+			path, name, platform, t.ValueString(), strings.Join(vmObjectTypes[platform], ", "))
+	}
+	return nil
+}
+
+// validateVMExclusions checks disk exclusion entries. Values that are unknown
+// at plan time are skipped.
+func validateVMExclusions(scope *VMBackupScope) error {
+	if scope == nil || scope.Excludes == nil {
+		return nil
+	}
+	for i, d := range scope.Excludes.Disks {
+		if d.DisksToProcess.IsUnknown() || d.Disks.IsUnknown() {
+			continue
+		}
+		mode := models.EVmwareDisksTypeToProcess(d.DisksToProcess.ValueString())
+		switch mode {
+		case models.DisksTypeAllDisks, models.DisksTypeSystemOnly:
+		case models.DisksTypeSelectedDisks:
+			if d.Disks.IsNull() || len(d.Disks.Elements()) == 0 {
+				return fmt.Errorf("virtual_machines.excludes.disks[%d] (%s): disks must list at least one disk "+
+					"when disks_to_process is %q", i, d.Name.ValueString(), mode)
+			}
+		default:
+			return fmt.Errorf("virtual_machines.excludes.disks[%d] (%s): unsupported disks_to_process %q; "+
+				"expected one of AllDisks, SystemOnly, SelectedDisks", i, d.Name.ValueString(), mode)
+		}
+	}
+	return nil
 }
 
 func (r *BackupJob) buildAgentComputerList(computers []AgentComputerEntry) []models.AgentObjectSpec {
@@ -1294,6 +2550,9 @@ func (r *BackupJob) buildStorageModel(s *JobStorageSettings) *models.BackupJobSt
 			AutoSelectEnabled: !s.ProxyAutoSelect.IsNull() && s.ProxyAutoSelect.ValueBool(),
 		},
 	}
+	if !m.BackupProxies.AutoSelectEnabled && !s.ProxyIDs.IsNull() && !s.ProxyIDs.IsUnknown() {
+		s.ProxyIDs.ElementsAs(context.Background(), &m.BackupProxies.ProxyIDs, false)
+	}
 
 	if !s.RetentionType.IsNull() && !s.RetentionType.IsUnknown() {
 		qty := 14 // safe default — matches Veeam console default
@@ -1336,6 +2595,205 @@ func (r *BackupJob) buildAgentStorageModel(s *JobStorageSettings) *models.AgentB
 	return m
 }
 
+// buildAdvancedSettingsModel converts advanced_settings into the API
+// BackupJobAdvancedSettingsModel. Returns nil when nothing is configured so the
+// server keeps its current values.
+func buildAdvancedSettingsModel(as *JobAdvancedSettings) *models.BackupJobAdvancedSettingsModel {
+	if as == nil || (as.Backup == nil && as.Storage == nil && as.Notifications == nil && as.HyperV == nil) {
+		return nil
+	}
+
+	m := &models.BackupJobAdvancedSettingsModel{}
+	if b := as.Backup; b != nil {
+		if !b.Mode.IsNull() && !b.Mode.IsUnknown() {
+			m.BackupModeType = models.EBackupModeType(b.Mode.ValueString())
+		}
+		m.SyntheticFulls = buildAdvancedScheduleModel(b.SyntheticFull)
+		m.ActiveFulls = buildAdvancedScheduleModel(b.ActiveFull)
+		m.BackupHealth = buildAdvancedScheduleModel(b.HealthCheck)
+		m.FullBackupMaintenance = &models.FullBackupMaintenanceModel{
+			DefragmentAndCompact: buildAdvancedScheduleModel(b.CompactFull),
+		}
+	}
+	if as.Storage != nil {
+		m.StorageData = buildStorageDataModel(as.Storage)
+	}
+	if as.Notifications != nil {
+		m.Notifications = buildNotificationsModel(as.Notifications)
+	}
+	if h := as.HyperV; h != nil {
+		m.HyperV = &models.BackupJobAdvancedSettingsHyperVModel{
+			GuestQuiescence:      h.GuestQuiescence.ValueBool(),
+			CrashConsistent:      h.CrashConsistent.ValueBool(),
+			ChangedBlockTracking: h.ChangedBlockTracking.ValueBool(),
+			VolumeSnapshot:       h.VolumeSnapshots.ValueBool(),
+		}
+	}
+	return m
+}
+
+// buildNotificationsModel converts advanced_settings.notifications. An
+// omitted email block disables per-job email notifications.
+func buildNotificationsModel(n *JobNotifications) *models.NotificationSettingsModel {
+	m := &models.NotificationSettingsModel{
+		SendSNMPNotifications: n.SNMPEnabled.ValueBool(),
+		EmailNotifications:    &models.EmailNotificationSettingsModel{IsEnabled: false},
+	}
+	e := n.Email
+	if e == nil {
+		return m
+	}
+
+	var recipients []string
+	e.Recipients.ElementsAs(context.Background(), &recipients, false)
+	m.EmailNotifications = &models.EmailNotificationSettingsModel{
+		IsEnabled:        true,
+		Recipients:       recipients,
+		NotificationType: models.EmailNotificationUseCustom,
+		CustomNotificationSettings: &models.EmailCustomNotificationSettingsModel{
+			NotifyOnSuccess:                    e.NotifyOnSuccess.ValueBool(),
+			NotifyOnWarning:                    e.NotifyOnWarning.ValueBool(),
+			NotifyOnError:                      e.NotifyOnError.ValueBool(),
+			SuppressNotificationUntilLastRetry: e.SuppressUntilLastRetry.ValueBool(),
+		},
+	}
+	if e.UseGlobalSettings.ValueBool() {
+		m.EmailNotifications.NotificationType = models.EmailNotificationUseGlobal
+		m.EmailNotifications.CustomNotificationSettings = nil
+	} else if !e.Subject.IsNull() && !e.Subject.IsUnknown() {
+		m.EmailNotifications.CustomNotificationSettings.Subject = e.Subject.ValueString()
+	}
+	return m
+}
+
+// buildAdvancedScheduleModel converts a schedule block into the API model. A
+// nil block disables the operation; the unused weekly / monthly half is sent
+// disabled so switching between them takes effect on update.
+func buildAdvancedScheduleModel(s *JobAdvancedSchedule) *models.AdvancedStorageScheduleModel {
+	if s == nil {
+		return &models.AdvancedStorageScheduleModel{IsEnabled: false}
+	}
+
+	m := &models.AdvancedStorageScheduleModel{
+		IsEnabled: true,
+		Weekly:    &models.AdvancedStorageScheduleWeeklyModel{IsEnabled: false},
+		Monthly:   &models.AdvancedStorageScheduleMonthlyModel{IsEnabled: false},
+	}
+	if s.Weekly != nil {
+		var days []string
+		s.Weekly.Days.ElementsAs(context.Background(), &days, false)
+		m.Weekly.IsEnabled = true
+		for _, d := range days {
+			m.Weekly.Days = append(m.Weekly.Days, models.EDayOfWeek(d))
+		}
+	}
+	if mo := s.Monthly; mo != nil {
+		m.Monthly = &models.AdvancedStorageScheduleMonthlyModel{
+			IsEnabled:        true,
+			DayNumberInMonth: models.EDayNumberInMonth(mo.DayNumberInMonth.ValueString()),
+			DayOfWeek:        models.EDayOfWeek(mo.DayOfWeek.ValueString()),
+			DayOfMonths:      int(mo.DayOfMonth.ValueInt64()),
+		}
+		if !mo.Months.IsNull() && !mo.Months.IsUnknown() {
+			var months []string
+			mo.Months.ElementsAs(context.Background(), &months, false)
+			for _, month := range months {
+				m.Monthly.Months = append(m.Monthly.Months, models.EMonth(month))
+			}
+		}
+	}
+	return m
+}
+
+func buildStorageDataModel(s *JobAdvancedStorage) *models.BackupStorageSettingModel {
+	m := &models.BackupStorageSettingModel{
+		InlineDataDedupEnabled:   s.InlineDedupeEnabled.ValueBool(),
+		ExcludeSwapFileBlocks:    s.ExcludeSwapFileBlocks.ValueBool(),
+		ExcludeDeletedFileBlocks: s.ExcludeDeletedFileBlocks.ValueBool(),
+		Encryption:               buildEncryptionModel(s.Encryption),
+	}
+	if !s.CompressionLevel.IsNull() && !s.CompressionLevel.IsUnknown() {
+		m.CompressionLevel = models.ECompressionLevel(s.CompressionLevel.ValueString())
+	}
+	if !s.StorageOptimization.IsNull() && !s.StorageOptimization.IsUnknown() {
+		m.StorageOptimization = models.EStorageOptimization(s.StorageOptimization.ValueString())
+	}
+	return m
+}
+
+// buildEncryptionModel converts an encryption block. An omitted block turns
+// encryption off.
+func buildEncryptionModel(e *JobStorageEncryption) *models.BackupStorageEncryptionModel {
+	if e == nil {
+		return &models.BackupStorageEncryptionModel{IsEnabled: false}
+	}
+	return &models.BackupStorageEncryptionModel{
+		IsEnabled:            true,
+		EncryptionPasswordID: e.EncryptionPasswordID.ValueString(),
+		KMSServerID:          e.KMSServerID.ValueString(),
+	}
+}
+
+// validateAdvancedSettings checks the advanced_settings rules that depend on
+// other attributes: the settings live under the storage block in the API, and
+// hyper_v needs a HyperVBackup job.
+func validateAdvancedSettings(data *BackupJobModel) error {
+	as := data.AdvancedSettings
+	if as == nil {
+		return nil
+	}
+	if data.Storage == nil {
+		return fmt.Errorf("advanced_settings requires the storage block")
+	}
+	if b := as.Backup; b != nil {
+		schedules := []struct {
+			name     string
+			schedule *JobAdvancedSchedule
+		}{
+			{"synthetic_full", b.SyntheticFull},
+			{"active_full", b.ActiveFull},
+			{"health_check", b.HealthCheck},
+			{"compact_full", b.CompactFull},
+		}
+		for _, s := range schedules {
+			if err := validateAdvancedSchedule(s.schedule); err != nil {
+				return fmt.Errorf("advanced_settings.backup.%s: %w", s.name, err)
+			}
+		}
+	}
+	if h := as.HyperV; h != nil {
+		if !data.Type.IsUnknown() && models.EJobType(data.Type.ValueString()) != models.JobTypeHyperVBackup {
+			return fmt.Errorf("advanced_settings.hyper_v is only supported for HyperVBackup jobs")
+		}
+		if h.CrashConsistent.ValueBool() && !h.GuestQuiescence.ValueBool() {
+			return fmt.Errorf("advanced_settings.hyper_v.crash_consistent requires guest_quiescence")
+		}
+	}
+	return nil
+}
+
+func validateAdvancedSchedule(s *JobAdvancedSchedule) error {
+	if s == nil {
+		return nil
+	}
+	if (s.Weekly == nil) == (s.Monthly == nil) {
+		return fmt.Errorf("set exactly one of weekly or monthly")
+	}
+	if s.Weekly != nil && !s.Weekly.Days.IsUnknown() && len(s.Weekly.Days.Elements()) == 0 {
+		return fmt.Errorf("weekly.days must list at least one day")
+	}
+	if mo := s.Monthly; mo != nil && !mo.DayNumberInMonth.IsUnknown() {
+		if models.EDayNumberInMonth(mo.DayNumberInMonth.ValueString()) == models.DayNumberOnDay {
+			if mo.DayOfMonth.IsNull() {
+				return fmt.Errorf("monthly.day_of_month is required when day_number_in_month is OnDay")
+			}
+		} else if mo.DayOfWeek.IsNull() {
+			return fmt.Errorf("monthly.day_of_week is required unless day_number_in_month is OnDay")
+		}
+	}
+	return nil
+}
+
 // buildGFSPolicyModel converts a JobGFSPolicy Terraform model into an API GFSPolicySettingsModel.
 // Returns nil when gfs is nil so the API field is omitted entirely.
 func buildGFSPolicyModel(gfs *JobGFSPolicy) *models.GFSPolicySettingsModel {
@@ -1450,60 +2908,334 @@ func buildAgentVolumesScopeModel(vs *AgentVolumesScope) *models.AgentBackupJobVo
 		_ = vs.VolumeNames.ElementsAs(context.Background(), &names, false)
 		m.VolumeNames = names
 	}
-	return m
+	return m
+}
+
+// buildAgentFilesScopeModel converts AgentFilesScope into the API files model.
+func buildAgentFilesScopeModel(fs *AgentFilesScope) *models.AgentBackupJobFilesModel {
+	if fs == nil {
+		return nil
+	}
+	m := &models.AgentBackupJobFilesModel{}
+	if !fs.IncludedFolders.IsNull() && !fs.IncludedFolders.IsUnknown() {
+		var folders []string
+		_ = fs.IncludedFolders.ElementsAs(context.Background(), &folders, false)
+		m.IncludedFolders = folders
+	}
+	if !fs.ExcludedFolders.IsNull() && !fs.ExcludedFolders.IsUnknown() {
+		var folders []string
+		_ = fs.ExcludedFolders.ElementsAs(context.Background(), &folders, false)
+		m.ExcludedFolders = folders
+	}
+	return m
+}
+
+func (r *BackupJob) buildGuestProcessingModel(gp *JobGuestProcessing) *models.BackupJobGuestProcessingModel {
+	if gp == nil {
+		return nil
+	}
+
+	m := &models.BackupJobGuestProcessingModel{
+		AppAwareProcessing: &models.BackupApplicationAwareProcessingModel{
+			IsEnabled: gp.AppAwareEnabled.ValueBool(),
+		},
+		GuestFSIndexing: &models.GuestFileSystemIndexingModel{
+			IsEnabled: gp.FSIndexingEnabled.ValueBool(),
+		},
+	}
+
+	// Include proxy settings only when meaningful to avoid sending an empty object.
+	if !gp.InteractionProxyAutoSelect.IsNull() {
+		m.GuestInteractionProxies = &models.GuestInteractionProxiesSettingsModel{
+			AutoSelectEnabled: gp.InteractionProxyAutoSelect.ValueBool(),
+		}
+	}
+
+	if gp.GuestCredentials != nil && !gp.GuestCredentials.CredentialsID.IsNull() &&
+		gp.GuestCredentials.CredentialsID.ValueString() != "" {
+		m.GuestCredentials = &models.GuestOsCredentialsModel{
+			CredentialsID: gp.GuestCredentials.CredentialsID.ValueString(),
+		}
+	}
+
+	for _, o := range gp.ObjectOverrides {
+		vm := buildVMObject(VMIncludeEntry{
+			Platform: o.Platform,
+			Type:     o.Type,
+			HostName: o.HostName,
+			Name:     o.Name,
+			ObjectID: o.ObjectID,
+		})
+
+		if o.AppAware != nil || o.Scripts != nil {
+			m.AppAwareProcessing.AppSettings = append(m.AppAwareProcessing.AppSettings,
+				buildApplicationSettingsModel(vm, o))
+		}
+
+		if o.Indexing != nil {
+			var paths []string
+			if !o.Indexing.Paths.IsNull() && !o.Indexing.Paths.IsUnknown() {
+				_ = o.Indexing.Paths.ElementsAs(context.Background(), &paths, false)
+			}
+			m.GuestFSIndexing.IndexingSettings = append(m.GuestFSIndexing.IndexingSettings,
+				models.BackupIndexingSettingsModel{
+					VMObject: vm,
+					WindowsIndexing: &models.GuestOsIndexingModel{
+						GuestFSIndexingMode: models.EGuestFSIndexingType(o.Indexing.Mode.ValueString()),
+						IndexingList:        paths,
+					},
+					LinuxIndexing: &models.GuestOsIndexingModel{
+						GuestFSIndexingMode: models.EGuestFSIndexingType(o.Indexing.Mode.ValueString()),
+						IndexingList:        paths,
+					},
+				})
+		}
+
+		if o.CredentialsID.ValueString() != "" || o.LinuxCredentialsID.ValueString() != "" {
+			if m.GuestCredentials == nil {
+				m.GuestCredentials = &models.GuestOsCredentialsModel{}
+			}
+			m.GuestCredentials.CredentialsPerMachine = append(m.GuestCredentials.CredentialsPerMachine,
+				models.GuestOsCredentialsPerMachineModel{
+					VMObject:       vm,
+					WindowsCredsID: o.CredentialsID.ValueString(),
+					LinuxCredsID:   o.LinuxCredentialsID.ValueString(),
+				})
+		}
+	}
+
+	return m
+}
+
+// buildApplicationSettingsModel converts the app_aware and scripts blocks of
+// an override into BackupApplicationSettingsModel. vss is required by the
+// API, so RequireSuccess is sent when it is not configured.
+func buildApplicationSettingsModel(vm models.InventoryObjectSpec, o JobGuestObjectOverride) models.BackupApplicationSettingsModel {
+	m := models.BackupApplicationSettingsModel{
+		VMObject: vm,
+		VSS:      models.ApplicationVSSRequireSuccess,
+	}
+
+	if a := o.AppAware; a != nil {
+		if v := a.VSS.ValueString(); v != "" {
+			m.VSS = models.EApplicationSettingsVSS(v)
+		}
+		m.TransactionLogs = models.ETransactionLogsSettings(a.TransactionLogs.ValueString())
+		if a.SQL != nil {
+			m.SQL = &models.BackupSQLSettingsModel{
+				LogsProcessing:   models.ESQLLogsProcessing(a.SQL.LogsProcessing.ValueString()),
+				BackupMinsCount:  int(a.SQL.BackupIntervalMinutes.ValueInt64()),
+				RetainLogBackups: models.ELogBackupRetention(a.SQL.RetainLogBackups.ValueString()),
+				KeepDaysCount:    int(a.SQL.RetainDays.ValueInt64()),
+			}
+		}
+		if a.Oracle != nil {
+			m.Oracle = &models.BackupOracleSettingsModel{
+				UseGuestCredentials: a.Oracle.CredentialsID.ValueString() == "",
+				CredentialsID:       a.Oracle.CredentialsID.ValueString(),
+				ArchiveLogs:         models.EArchiveLogsSettings(a.Oracle.ArchiveLogs.ValueString()),
+				DeleteHoursCount:    int(a.Oracle.DeleteOlderThanHours.ValueInt64()),
+				DeleteGBsCount:      int(a.Oracle.DeleteOverGB.ValueInt64()),
+				BackupLogs:          a.Oracle.BackupLogs.ValueBool(),
+				BackupMinsCount:     int(a.Oracle.BackupIntervalMinutes.ValueInt64()),
+			}
+		}
+	}
+
+	if sc := o.Scripts; sc != nil {
+		m.Scripts = &models.BackupScriptSettingsModel{
+			ScriptProcessingMode: models.EScriptProcessingMode(sc.Mode.ValueString()),
+		}
+		if sc.WindowsPreFreezeScript.ValueString() != "" || sc.WindowsPostThawScript.ValueString() != "" {
+			m.Scripts.WindowsScripts = &models.ScriptSettingsModel{
+				PreFreezeScript: sc.WindowsPreFreezeScript.ValueString(),
+				PostThawScript:  sc.WindowsPostThawScript.ValueString(),
+			}
+		}
+		if sc.LinuxPreFreezeScript.ValueString() != "" || sc.LinuxPostThawScript.ValueString() != "" {
+			m.Scripts.LinuxScripts = &models.ScriptSettingsModel{
+				PreFreezeScript: sc.LinuxPreFreezeScript.ValueString(),
+				PostThawScript:  sc.LinuxPostThawScript.ValueString(),
+			}
+		}
+	}
+
+	return m
+}
+
+// validateGuestObjectOverrides checks the guest_processing.object_overrides
+// entries for duplicates and for option combinations the API rejects. Values
+// that are unknown at plan time are skipped.
+func validateGuestObjectOverrides(gp *JobGuestProcessing) error {
+	if gp == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for i, o := range gp.ObjectOverrides {
+		prefix := fmt.Sprintf("guest_processing.object_overrides[%d] (%s)", i, o.ObjectID.ValueString())
+		if !o.ObjectID.IsUnknown() {
+			if seen[o.ObjectID.ValueString()] {
+				return fmt.Errorf("%s: object_id is listed more than once", prefix)
+			}
+			seen[o.ObjectID.ValueString()] = true
+		}
+
+		if o.CredentialsID.IsNull() && o.LinuxCredentialsID.IsNull() &&
+			o.AppAware == nil && o.Indexing == nil && o.Scripts == nil {
+			return fmt.Errorf("%s: set at least one of credentials_id, linux_credentials_id, "+
+				"app_aware, indexing or scripts", prefix)
+		}
+		if err := validateGuestAppAware(o.AppAware); err != nil {
+			return fmt.Errorf("%s: %w", prefix, err)
+		}
+		if err := validateGuestIndexing(o.Indexing); err != nil {
+			return fmt.Errorf("%s: %w", prefix, err)
+		}
+		if err := validateGuestScripts(o.Scripts); err != nil {
+			return fmt.Errorf("%s: %w", prefix, err)
+		}
+	}
+	return nil
+}
+
+// isConfigured reports whether an Optional+Computed attribute was set in
+// configuration. At apply time such attributes are unknown when omitted.
+func isConfigured(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+func validateGuestAppAware(a *JobGuestAppAware) error {
+	if a == nil {
+		return nil
+	}
+	switch models.EApplicationSettingsVSS(a.VSS.ValueString()) {
+	case "", models.ApplicationVSSRequireSuccess, models.ApplicationVSSIgnoreFailures, models.ApplicationVSSDisabled:
+	default:
+		return fmt.Errorf("unsupported app_aware.vss %q; expected one of RequireSuccess, IgnoreFailures, Disabled",
+			a.VSS.ValueString())
+	}
+	logs := models.ETransactionLogsSettings(a.TransactionLogs.ValueString())
+	switch logs {
+	case "", models.TransactionLogsProcess, models.TransactionLogsCopyOnly:
+	default:
+		return fmt.Errorf("unsupported app_aware.transaction_logs %q; expected one of Process, CopyOnly",
+			a.TransactionLogs.ValueString())
+	}
+	if logs == models.TransactionLogsCopyOnly && (a.SQL != nil || a.Oracle != nil) {
+		return fmt.Errorf("app_aware.sql and app_aware.oracle require transaction_logs = %q",
+			models.TransactionLogsProcess)
+	}
+
+	if sql := a.SQL; sql != nil && !sql.LogsProcessing.IsUnknown() {
+		mode := models.ESQLLogsProcessing(sql.LogsProcessing.ValueString())
+		switch mode {
+		case models.SQLLogsTruncate, models.SQLLogsPreventTruncation:
+			if isConfigured(sql.BackupIntervalMinutes) || isConfigured(sql.RetainLogBackups) || isConfigured(sql.RetainDays) {
+				return fmt.Errorf("app_aware.sql: backup_interval_minutes, retain_log_backups and retain_days "+
+					"require logs_processing = %q", models.SQLLogsBackupPeriodically)
+			}
+		case models.SQLLogsBackupPeriodically:
+			if sql.RetainLogBackups.IsUnknown() {
+				break
+			}
+			switch models.ELogBackupRetention(sql.RetainLogBackups.ValueString()) {
+			case "", models.LogBackupRetentionUntilBackupDeleted:
+				if isConfigured(sql.RetainDays) {
+					return fmt.Errorf("app_aware.sql: retain_days requires retain_log_backups = %q",
+						models.LogBackupRetentionKeepOnlyLastDays)
+				}
+			case models.LogBackupRetentionKeepOnlyLastDays:
+				if !isConfigured(sql.RetainDays) {
+					return fmt.Errorf("app_aware.sql: retain_days is required when retain_log_backups is %q",
+						models.LogBackupRetentionKeepOnlyLastDays)
+				}
+			default:
+				return fmt.Errorf("app_aware.sql: unsupported retain_log_backups %q; "+
+					"expected one of UntilBackupIsDeleted, KeepOnlyLastDays", sql.RetainLogBackups.ValueString())
+			}
+		default:
+			return fmt.Errorf("app_aware.sql: unsupported logs_processing %q; "+
+				"expected one of TruncateLogs, PreventTruncation, BackupLogsPeriodically", mode)
+		}
+	}
+
+	if ora := a.Oracle; ora != nil && !ora.ArchiveLogs.IsUnknown() {
+		mode := models.EArchiveLogsSettings(ora.ArchiveLogs.ValueString())
+		switch mode {
+		case models.ArchiveLogsDoNotDelete:
+			if isConfigured(ora.DeleteOlderThanHours) || isConfigured(ora.DeleteOverGB) {
+				return fmt.Errorf("app_aware.oracle: delete_older_than_hours and delete_over_gb "+
+					"cannot be set when archive_logs is %q", mode)
+			}
+		case models.ArchiveLogsDeleteOlderThanHrs:
+			if !isConfigured(ora.DeleteOlderThanHours) || isConfigured(ora.DeleteOverGB) {
+				return fmt.Errorf("app_aware.oracle: archive_logs %q requires delete_older_than_hours "+
+					"and no delete_over_gb", mode)
+			}
+		case models.ArchiveLogsDeleteOverGB:
+			if !isConfigured(ora.DeleteOverGB) || isConfigured(ora.DeleteOlderThanHours) {
+				return fmt.Errorf("app_aware.oracle: archive_logs %q requires delete_over_gb "+
+					"and no delete_older_than_hours", mode)
+			}
+		default:
+			return fmt.Errorf("app_aware.oracle: unsupported archive_logs %q; "+
+				"expected one of DoNotDelete, DeleteLogsOlderThanHours, DeleteLogsOverGb", mode)
+		}
+		if !ora.BackupLogs.IsUnknown() && !ora.BackupLogs.ValueBool() && isConfigured(ora.BackupIntervalMinutes) {
+			return fmt.Errorf("app_aware.oracle: backup_interval_minutes requires backup_logs = true")
+		}
+	}
+	return nil
 }
 
-// buildAgentFilesScopeModel converts AgentFilesScope into the API files model.
-func buildAgentFilesScopeModel(fs *AgentFilesScope) *models.AgentBackupJobFilesModel {
-	if fs == nil {
+func validateGuestIndexing(idx *JobGuestIndexing) error {
+	if idx == nil || idx.Mode.IsUnknown() {
 		return nil
 	}
-	m := &models.AgentBackupJobFilesModel{}
-	if !fs.IncludedFolders.IsNull() && !fs.IncludedFolders.IsUnknown() {
-		var folders []string
-		_ = fs.IncludedFolders.ElementsAs(context.Background(), &folders, false)
-		m.IncludedFolders = folders
-	}
-	if !fs.ExcludedFolders.IsNull() && !fs.ExcludedFolders.IsUnknown() {
-		var folders []string
-		_ = fs.ExcludedFolders.ElementsAs(context.Background(), &folders, false)
-		m.ExcludedFolders = folders
+	hasPaths := !idx.Paths.IsNull() && len(idx.Paths.Elements()) > 0
+	mode := models.EGuestFSIndexingType(idx.Mode.ValueString())
+	switch mode {
+	case models.GuestFSIndexingDisabled, models.GuestFSIndexingIndexAll:
+		if hasPaths {
+			return fmt.Errorf("indexing.paths cannot be set when mode is %q", mode)
+		}
+	case models.GuestFSIndexingIndexAllExcept, models.GuestFSIndexingIndexOnly:
+		if !hasPaths && !idx.Paths.IsUnknown() {
+			return fmt.Errorf("indexing.paths must list at least one folder when mode is %q", mode)
+		}
+	default:
+		return fmt.Errorf("unsupported indexing.mode %q; "+
+			"expected one of Disabled, IndexAll, IndexAllExcept, IndexOnly", mode)
 	}
-	return m
+	return nil
 }
 
-func (r *BackupJob) buildGuestProcessingModel(gp *JobGuestProcessing) *models.BackupJobGuestProcessingModel {
-	if gp == nil {
+func validateGuestScripts(sc *JobGuestScripts) error {
+	if sc == nil || sc.Mode.IsUnknown() {
 		return nil
 	}
-
-	m := &models.BackupJobGuestProcessingModel{
-		AppAwareProcessing: &models.BackupApplicationAwareProcessingModel{
-			IsEnabled: gp.AppAwareEnabled.ValueBool(),
-		},
-		GuestFSIndexing: &models.GuestFileSystemIndexingModel{
-			IsEnabled: gp.FSIndexingEnabled.ValueBool(),
-		},
-	}
-
-	// Include proxy settings only when meaningful to avoid sending an empty object.
-	if !gp.InteractionProxyAutoSelect.IsNull() {
-		m.GuestInteractionProxies = &models.GuestInteractionProxiesSettingsModel{
-			AutoSelectEnabled: gp.InteractionProxyAutoSelect.ValueBool(),
+	hasScript := false
+	for _, script := range []types.String{sc.WindowsPreFreezeScript, sc.WindowsPostThawScript,
+		sc.LinuxPreFreezeScript, sc.LinuxPostThawScript} {
+		if script.IsUnknown() || script.ValueString() != "" {
+			hasScript = true
 		}
 	}
-
-	if gp.GuestCredentials != nil && !gp.GuestCredentials.CredentialsID.IsNull() &&
-		gp.GuestCredentials.CredentialsID.ValueString() != "" {
-		m.GuestCredentials = &models.GuestOsCredentialsModel{
-			CredentialsID: gp.GuestCredentials.CredentialsID.ValueString(),
+	mode := models.EScriptProcessingMode(sc.Mode.ValueString())
+	switch mode {
+	case models.ScriptProcessingDisableExec:
+	case models.ScriptProcessingRequireSuccess, models.ScriptProcessingIgnoreExecFailures:
+		if !hasScript {
+			return fmt.Errorf("scripts: at least one script path is required when mode is %q", mode)
 		}
+	default:
+		return fmt.Errorf("scripts: unsupported mode %q; "+
+			"expected one of RequireSuccess, IgnoreExecFailures, DisableExec", mode)
 	}
-
-	return m
+	return nil
 }
 
-func (r *BackupJob) buildScheduleModel(s *JobScheduleSettings) *models.BackupScheduleModel {
+func buildScheduleModel(s *JobScheduleSettings) *models.BackupScheduleModel {
 	if s == nil {
 		return nil
 	}
@@ -1563,9 +3295,148 @@ func (r *BackupJob) buildScheduleModel(s *JobScheduleSettings) *models.BackupSch
 		}
 	}
 
+	m.BackupWindow = buildBackupWindowModel(s.BackupWindow)
+
 	return m
 }
 
+// backupWindowDays is the day order of the backup window hour grid.
+var backupWindowDays = []models.EDayOfWeek{
+	models.DaySunday, models.DayMonday, models.DayTuesday, models.DayWednesday,
+	models.DayThursday, models.DayFriday, models.DaySaturday,
+}
+
+// buildBackupWindowModel expands backup_window into the full seven-day grid
+// the API expects; days that are not listed get no permitted hours.
+func buildBackupWindowModel(w *JobBackupWindow) *models.ScheduleBackupWindowModel {
+	if w == nil {
+		return nil
+	}
+
+	permitted := map[models.EDayOfWeek][24]bool{}
+	for _, d := range w.Days {
+		var hours []int64
+		d.Hours.ElementsAs(context.Background(), &hours, false)
+		grid := permitted[models.EDayOfWeek(d.Day.ValueString())]
+		for _, h := range hours {
+			if h >= 0 && h < 24 {
+				grid[h] = true
+			}
+		}
+		permitted[models.EDayOfWeek(d.Day.ValueString())] = grid
+	}
+
+	days := make([]models.BackupWindowDayHoursModel, 0, len(backupWindowDays))
+	for _, day := range backupWindowDays {
+		grid := permitted[day]
+		flags := make([]string, 24)
+		for h, ok := range grid {
+			flags[h] = "0"
+			if ok {
+				flags[h] = "1"
+			}
+		}
+		days = append(days, models.BackupWindowDayHoursModel{Day: day, Hours: strings.Join(flags, ",")})
+	}
+	return &models.ScheduleBackupWindowModel{
+		IsEnabled:    true,
+		BackupWindow: &models.BackupWindowSettingModel{Days: days},
+	}
+}
+
+// validateBackupWindow checks the day names and hour ranges of backup_window.
+func validateBackupWindow(s *JobScheduleSettings) error {
+	if s == nil {
+		return nil
+	}
+	return validateBackupWindowDays(s.BackupWindow)
+}
+
+// validateBackupWindowDays checks a backup_window block on its own, for
+// resources that place it outside a schedule block.
+func validateBackupWindowDays(w *JobBackupWindow) error {
+	if w == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for _, d := range w.Days {
+		if d.Day.IsUnknown() {
+			continue
+		}
+		day := d.Day.ValueString()
+		valid := false
+		for _, known := range backupWindowDays {
+			valid = valid || day == string(known)
+		}
+		if !valid {
+			return fmt.Errorf("unsupported day %q: expected Monday … Sunday", day)
+		}
+		if seen[day] {
+			return fmt.Errorf("day %q is listed more than once", day)
+		}
+		seen[day] = true
+
+		var hours []int64
+		if d.Hours.IsUnknown() || d.Hours.ElementsAs(context.Background(), &hours, false).HasError() {
+			// The hours, or some of them, are unknown until apply.
+			continue
+		}
+		if len(hours) == 0 {
+			return fmt.Errorf("day %q must list at least one hour; leave the day out to deny it", day)
+		}
+		for _, h := range hours {
+			if h < 0 || h > 23 {
+				return fmt.Errorf("day %q: hour %d is outside 0–23", day, h)
+			}
+		}
+	}
+	return nil
+}
+
+// syncBackupWindowFromAPI converts the API hour grid back to backup_window.
+// Days without permitted hours are dropped, and days keep the order of
+// existing so reordering in the API does not show up as drift.
+func syncBackupWindowFromAPI(existing *JobBackupWindow, api *models.ScheduleBackupWindowModel) *JobBackupWindow {
+	if api == nil || !api.IsEnabled || api.BackupWindow == nil {
+		return nil
+	}
+
+	hoursByDay := map[string]types.Set{}
+	for _, d := range api.BackupWindow.Days {
+		var hours []attr.Value
+		for h, flag := range strings.Split(d.Hours, ",") {
+			if strings.TrimSpace(flag) == "1" {
+				hours = append(hours, types.Int64Value(int64(h)))
+			}
+		}
+		if len(hours) > 0 {
+			hoursByDay[string(d.Day)] = types.SetValueMust(types.Int64Type, hours)
+		}
+	}
+
+	var order []string
+	if existing != nil {
+		for _, d := range existing.Days {
+			order = append(order, d.Day.ValueString())
+		}
+	}
+	for _, d := range backupWindowDays {
+		order = append(order, string(d))
+	}
+
+	w := &JobBackupWindow{Days: []JobBackupWindowDay{}}
+	for _, day := range order {
+		hours, ok := hoursByDay[day]
+		if !ok {
+			continue
+		}
+		w.Days = append(w.Days, JobBackupWindowDay{Day: types.StringValue(day), Hours: hours})
+		delete(hoursByDay, day)
+	}
+	return w
+}
+
 // ---------------------------------------------------------------------------
 // Sync helpers — API response → Terraform state
 // ---------------------------------------------------------------------------
@@ -1585,25 +3456,29 @@ func (r *BackupJob) syncVMJobFromAPI(data *BackupJobModel, api *models.BackupJob
 
 	// Sync virtual_machines from API response when present.
 	if api.VirtualMachines != nil {
-		scope := &VMBackupScope{}
+		scope := &VMBackupScope{
+			ExcludeTemplates:                types.BoolValue(false),
+			ExcludeTemplatesFromIncremental: types.BoolValue(false),
+		}
 		includes := make([]VMIncludeEntry, 0, len(api.VirtualMachines.Includes))
 		for _, vm := range api.VirtualMachines.Includes {
-			includes = append(includes, VMIncludeEntry{
-				Platform: types.StringValue(vm.Platform),
-				Type:     types.StringValue(string(vm.Type)),
-				HostName: types.StringValue(vm.HostName),
-				Name:     types.StringValue(vm.Name),
-				ObjectID: types.StringValue(vm.ObjectID),
-			})
+			includes = append(includes, syncVMObjectFromAPI(vm))
 		}
 		scope.Includes = includes
 
-		if api.VirtualMachines.Excludes != nil &&
-			api.VirtualMachines.Excludes.Templates != nil {
-			scope.ExcludeTemplates = types.BoolValue(api.VirtualMachines.Excludes.Templates.IsEnabled)
-		} else {
-			scope.ExcludeTemplates = types.BoolValue(false)
+		var prior *VMExclusions
+		if data.VirtualMachines != nil {
+			prior = data.VirtualMachines.Excludes
+		}
+		ex := api.VirtualMachines.Excludes
+		if ex == nil {
+			ex = &models.BackupJobExclusions{}
 		}
+		if ex.Templates != nil {
+			scope.ExcludeTemplates = types.BoolValue(ex.Templates.IsEnabled)
+			scope.ExcludeTemplatesFromIncremental = types.BoolValue(ex.Templates.ExcludeFromIncremental)
+		}
+		scope.Excludes = syncVMExclusionsFromAPI(prior, ex)
 
 		data.VirtualMachines = scope
 	}
@@ -1614,8 +3489,16 @@ func (r *BackupJob) syncVMJobFromAPI(data *BackupJobModel, api *models.BackupJob
 			RepositoryID:    types.StringValue(api.Storage.BackupRepositoryID),
 			ProxyAutoSelect: types.BoolValue(false),
 		}
-		if api.Storage.BackupProxies != nil {
-			s.ProxyAutoSelect = types.BoolValue(api.Storage.BackupProxies.AutoSelectEnabled)
+		s.ProxyIDs = types.ListNull(types.StringType)
+		if p := api.Storage.BackupProxies; p != nil {
+			s.ProxyAutoSelect = types.BoolValue(p.AutoSelectEnabled)
+			if !p.AutoSelectEnabled && len(p.ProxyIDs) > 0 {
+				ids := make([]attr.Value, 0, len(p.ProxyIDs))
+				for _, id := range p.ProxyIDs {
+					ids = append(ids, types.StringValue(id))
+				}
+				s.ProxyIDs = types.ListValueMust(types.StringType, ids)
+			}
 		}
 		if api.Storage.RetentionPolicy != nil {
 			s.RetentionType = types.StringValue(string(api.Storage.RetentionPolicy.Type))
@@ -1623,6 +3506,12 @@ func (r *BackupJob) syncVMJobFromAPI(data *BackupJobModel, api *models.BackupJob
 		}
 		s.GFSPolicy = syncGFSPolicyFromAPI(api.Storage.GFSPolicy)
 		data.Storage = s
+
+		// Advanced settings are only tracked once configured; the API always
+		// returns them, which would otherwise show up as drift.
+		if data.AdvancedSettings != nil {
+			data.AdvancedSettings = syncAdvancedSettingsFromAPI(data.AdvancedSettings, api.Storage.AdvancedSettings)
+		}
 	}
 
 	// Sync guest processing.
@@ -1642,17 +3531,387 @@ func (r *BackupJob) syncVMJobFromAPI(data *BackupJobModel, api *models.BackupJob
 			gp.InteractionProxyAutoSelect = types.BoolValue(
 				api.GuestProcessing.GuestInteractionProxies.AutoSelectEnabled)
 		}
-		if api.GuestProcessing.GuestCredentials != nil {
+		if api.GuestProcessing.GuestCredentials != nil && api.GuestProcessing.GuestCredentials.CredentialsID != "" {
 			gp.GuestCredentials = &JobGuestCredentials{
 				CredentialsID: types.StringValue(api.GuestProcessing.GuestCredentials.CredentialsID),
 			}
 		}
+		var existing []JobGuestObjectOverride
+		if data.GuestProcessing != nil {
+			existing = data.GuestProcessing.ObjectOverrides
+		}
+		gp.ObjectOverrides = syncGuestObjectOverridesFromAPI(existing, api.GuestProcessing)
 		data.GuestProcessing = gp
 	}
 
 	// Sync schedule.
 	if api.Schedule != nil {
-		data.Schedule = r.syncScheduleFromAPI(data.Schedule, api.Schedule)
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
+
+// syncGuestObjectOverridesFromAPI folds the per-VM appSettings,
+// indexingSettings and credentialsPerMachine lists back into
+// object_overrides, keyed by object ID. Entries already in state keep their
+// order; VMs only present in the API are appended so console changes show
+// up as drift.
+func syncGuestObjectOverridesFromAPI(existing []JobGuestObjectOverride, api *models.BackupJobGuestProcessingModel) []JobGuestObjectOverride {
+	vms := map[string]models.InventoryObjectSpec{}
+	apps := map[string]*models.BackupApplicationSettingsModel{}
+	indexing := map[string]*models.BackupIndexingSettingsModel{}
+	creds := map[string]*models.GuestOsCredentialsPerMachineModel{}
+	var order []string
+	track := func(vm models.InventoryObjectSpec) {
+		if _, ok := vms[vm.ObjectID]; !ok {
+			vms[vm.ObjectID] = vm
+			order = append(order, vm.ObjectID)
+		}
+	}
+
+	if api.AppAwareProcessing != nil {
+		for i := range api.AppAwareProcessing.AppSettings {
+			a := &api.AppAwareProcessing.AppSettings[i]
+			track(a.VMObject)
+			apps[a.VMObject.ObjectID] = a
+		}
+	}
+	if api.GuestFSIndexing != nil {
+		for i := range api.GuestFSIndexing.IndexingSettings {
+			x := &api.GuestFSIndexing.IndexingSettings[i]
+			track(x.VMObject)
+			indexing[x.VMObject.ObjectID] = x
+		}
+	}
+	if api.GuestCredentials != nil {
+		for i := range api.GuestCredentials.CredentialsPerMachine {
+			c := &api.GuestCredentials.CredentialsPerMachine[i]
+			track(c.VMObject)
+			creds[c.VMObject.ObjectID] = c
+		}
+	}
+
+	if len(order) == 0 {
+		if existing != nil {
+			return []JobGuestObjectOverride{}
+		}
+		return nil
+	}
+
+	prevByID := map[string]*JobGuestObjectOverride{}
+	var ids []string
+	for i := range existing {
+		id := existing[i].ObjectID.ValueString()
+		prevByID[id] = &existing[i]
+		if _, ok := vms[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range order {
+		if _, ok := prevByID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	result := make([]JobGuestObjectOverride, 0, len(ids))
+	for _, id := range ids {
+		vm := syncVMObjectFromAPI(vms[id])
+		o := JobGuestObjectOverride{
+			Platform:           vm.Platform,
+			Type:               vm.Type,
+			HostName:           vm.HostName,
+			Name:               vm.Name,
+			ObjectID:           vm.ObjectID,
+			CredentialsID:      types.StringNull(),
+			LinuxCredentialsID: types.StringNull(),
+		}
+		prev := prevByID[id]
+
+		if c := creds[id]; c != nil {
+			o.CredentialsID = stringOrNull(c.WindowsCredsID)
+			o.LinuxCredentialsID = stringOrNull(c.LinuxCredsID)
+		}
+		if a := apps[id]; a != nil {
+			// The API returns vss / transactionLogs for every entry, so an
+			// entry created for scripts alone only gets app_aware on import.
+			if prev == nil || prev.AppAware != nil {
+				var prevApp *JobGuestAppAware
+				if prev != nil {
+					prevApp = prev.AppAware
+				}
+				o.AppAware = syncGuestAppAwareFromAPI(prevApp, a)
+			}
+			if a.Scripts != nil && a.Scripts.ScriptProcessingMode != "" && (prev == nil || prev.Scripts != nil) {
+				o.Scripts = syncGuestScriptsFromAPI(a.Scripts)
+			}
+		}
+		if x := indexing[id]; x != nil {
+			o.Indexing = syncGuestIndexingFromAPI(x)
+		}
+		result = append(result, o)
+	}
+	return result
+}
+
+// syncGuestAppAwareFromAPI maps BackupApplicationSettingsModel to app_aware.
+// The sql and oracle blocks are kept only when they were configured, since
+// the API reports defaults for both on every VM.
+func syncGuestAppAwareFromAPI(existing *JobGuestAppAware, api *models.BackupApplicationSettingsModel) *JobGuestAppAware {
+	a := &JobGuestAppAware{
+		VSS:             types.StringValue(string(api.VSS)),
+		TransactionLogs: types.StringValue(string(api.TransactionLogs)),
+	}
+	if api.SQL != nil && (existing == nil || existing.SQL != nil) {
+		a.SQL = &JobGuestSQL{
+			LogsProcessing:        types.StringValue(string(api.SQL.LogsProcessing)),
+			BackupIntervalMinutes: types.Int64Value(int64(api.SQL.BackupMinsCount)),
+			RetainLogBackups:      types.StringValue(string(api.SQL.RetainLogBackups)),
+			RetainDays:            types.Int64Value(int64(api.SQL.KeepDaysCount)),
+		}
+	}
+	if api.Oracle != nil && (existing == nil || existing.Oracle != nil) {
+		a.Oracle = &JobGuestOracle{
+			CredentialsID:         types.StringNull(),
+			ArchiveLogs:           types.StringValue(string(api.Oracle.ArchiveLogs)),
+			DeleteOlderThanHours:  types.Int64Value(int64(api.Oracle.DeleteHoursCount)),
+			DeleteOverGB:          types.Int64Value(int64(api.Oracle.DeleteGBsCount)),
+			BackupLogs:            types.BoolValue(api.Oracle.BackupLogs),
+			BackupIntervalMinutes: types.Int64Value(int64(api.Oracle.BackupMinsCount)),
+		}
+		if !api.Oracle.UseGuestCredentials {
+			a.Oracle.CredentialsID = stringOrNull(api.Oracle.CredentialsID)
+		}
+	}
+	return a
+}
+
+func syncGuestIndexingFromAPI(api *models.BackupIndexingSettingsModel) *JobGuestIndexing {
+	scope := api.WindowsIndexing
+	if scope == nil {
+		scope = api.LinuxIndexing
+	}
+	if scope == nil {
+		return nil
+	}
+	idx := &JobGuestIndexing{
+		Mode:  types.StringValue(string(scope.GuestFSIndexingMode)),
+		Paths: types.ListNull(types.StringType),
+	}
+	if len(scope.IndexingList) > 0 {
+		idx.Paths, _ = types.ListValueFrom(context.Background(), types.StringType, scope.IndexingList)
+	}
+	return idx
+}
+
+func syncGuestScriptsFromAPI(api *models.BackupScriptSettingsModel) *JobGuestScripts {
+	sc := &JobGuestScripts{
+		Mode:                   types.StringValue(string(api.ScriptProcessingMode)),
+		WindowsPreFreezeScript: types.StringNull(),
+		WindowsPostThawScript:  types.StringNull(),
+		LinuxPreFreezeScript:   types.StringNull(),
+		LinuxPostThawScript:    types.StringNull(),
+	}
+	if w := api.WindowsScripts; w != nil {
+		sc.WindowsPreFreezeScript = stringOrNull(w.PreFreezeScript)
+		sc.WindowsPostThawScript = stringOrNull(w.PostThawScript)
+	}
+	if l := api.LinuxScripts; l != nil {
+		sc.LinuxPreFreezeScript = stringOrNull(l.PreFreezeScript)
+		sc.LinuxPostThawScript = stringOrNull(l.PostThawScript)
+	}
+	return sc
+}
+
+// stringOrNull maps an empty API string to null so optional attributes left
+// unset in configuration do not drift.
+func stringOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func syncVMObjectFromAPI(vm models.InventoryObjectSpec) VMIncludeEntry {
+	return VMIncludeEntry{
+		Platform: types.StringValue(vm.Platform),
+		Type:     types.StringValue(vm.Type),
+		HostName: types.StringValue(vm.HostName),
+		Name:     types.StringValue(vm.Name),
+		ObjectID: types.StringValue(vm.ObjectID),
+	}
+}
+
+// syncVMExclusionsFromAPI maps the vms / disks excludes. The API omits empty
+// exclusions, so the shape of prior is kept for them: an omitted block stays
+// nil, and an empty block or empty list stays empty rather than null.
+func syncVMExclusionsFromAPI(prior *VMExclusions, api *models.BackupJobExclusions) *VMExclusions {
+	if prior == nil && len(api.VMs) == 0 && len(api.Disks) == 0 {
+		return nil
+	}
+
+	ex := &VMExclusions{}
+	if prior != nil {
+		if prior.VMs != nil {
+			ex.VMs = []VMIncludeEntry{}
+		}
+		if prior.Disks != nil {
+			ex.Disks = []VMDiskExclusion{}
+		}
+	}
+	for _, vm := range api.VMs {
+		ex.VMs = append(ex.VMs, syncVMObjectFromAPI(vm))
+	}
+	for _, d := range api.Disks {
+		vm := syncVMObjectFromAPI(d.VMObject)
+		disks := make([]attr.Value, 0, len(d.Disks))
+		for _, id := range d.Disks {
+			disks = append(disks, types.StringValue(id))
+		}
+		ex.Disks = append(ex.Disks, VMDiskExclusion{
+			Platform:                  vm.Platform,
+			Type:                      vm.Type,
+			HostName:                  vm.HostName,
+			Name:                      vm.Name,
+			ObjectID:                  vm.ObjectID,
+			DisksToProcess:            types.StringValue(string(d.DisksToProcess)),
+			Disks:                     types.ListValueMust(types.StringType, disks),
+			RemoveFromVMConfiguration: types.BoolValue(d.RemoveFromVMConfiguration),
+		})
+	}
+	return ex
+}
+
+// syncAdvancedSettingsFromAPI refreshes the configured advanced_settings
+// sections from the API; sections absent from existing stay unmanaged.
+func syncAdvancedSettingsFromAPI(existing *JobAdvancedSettings, api *models.BackupJobAdvancedSettingsModel) *JobAdvancedSettings {
+	as := &JobAdvancedSettings{}
+	*as = *existing
+	if api == nil {
+		return as
+	}
+
+	if as.Backup != nil {
+		b := &JobAdvancedBackup{
+			Mode:          types.StringValue(string(api.BackupModeType)),
+			SyntheticFull: syncAdvancedScheduleFromAPI(api.SyntheticFulls),
+			ActiveFull:    syncAdvancedScheduleFromAPI(api.ActiveFulls),
+			HealthCheck:   syncAdvancedScheduleFromAPI(api.BackupHealth),
+		}
+		if api.FullBackupMaintenance != nil {
+			b.CompactFull = syncAdvancedScheduleFromAPI(api.FullBackupMaintenance.DefragmentAndCompact)
+		}
+		as.Backup = b
+	}
+	if as.Storage != nil && api.StorageData != nil {
+		as.Storage = syncStorageDataFromAPI(api.StorageData)
+	}
+	if as.Notifications != nil && api.Notifications != nil {
+		as.Notifications = syncNotificationsFromAPI(as.Notifications, api.Notifications)
+	}
+	if as.HyperV != nil && api.HyperV != nil {
+		as.HyperV = &JobHyperVSettings{
+			GuestQuiescence:      types.BoolValue(api.HyperV.GuestQuiescence),
+			CrashConsistent:      types.BoolValue(api.HyperV.CrashConsistent),
+			ChangedBlockTracking: types.BoolValue(api.HyperV.ChangedBlockTracking),
+			VolumeSnapshots:      types.BoolValue(api.HyperV.VolumeSnapshot),
+		}
+	}
+	return as
+}
+
+// syncNotificationsFromAPI refreshes advanced_settings.notifications. With
+// global email settings the API does not return the custom triggers, so the
+// configured values are kept.
+func syncNotificationsFromAPI(existing *JobNotifications, api *models.NotificationSettingsModel) *JobNotifications {
+	n := &JobNotifications{SNMPEnabled: types.BoolValue(api.SendSNMPNotifications)}
+	e := api.EmailNotifications
+	if e == nil || !e.IsEnabled {
+		return n
+	}
+
+	email := &JobEmailNotifications{
+		Subject:                types.StringNull(),
+		NotifyOnSuccess:        types.BoolValue(true),
+		NotifyOnWarning:        types.BoolValue(true),
+		NotifyOnError:          types.BoolValue(true),
+		SuppressUntilLastRetry: types.BoolValue(true),
+	}
+	if existing.Email != nil {
+		*email = *existing.Email
+	}
+	recipients := make([]attr.Value, 0, len(e.Recipients))
+	for _, rcpt := range e.Recipients {
+		recipients = append(recipients, types.StringValue(rcpt))
+	}
+	email.Recipients = types.ListValueMust(types.StringType, recipients)
+	email.UseGlobalSettings = types.BoolValue(e.NotificationType == models.EmailNotificationUseGlobal)
+	if c := e.CustomNotificationSettings; c != nil && !email.UseGlobalSettings.ValueBool() {
+		email.Subject = types.StringValue(c.Subject)
+		email.NotifyOnSuccess = types.BoolValue(c.NotifyOnSuccess)
+		email.NotifyOnWarning = types.BoolValue(c.NotifyOnWarning)
+		email.NotifyOnError = types.BoolValue(c.NotifyOnError)
+		email.SuppressUntilLastRetry = types.BoolValue(c.SuppressNotificationUntilLastRetry)
+	}
+	n.Email = email
+	return n
+}
+
+// syncAdvancedScheduleFromAPI returns nil for a disabled operation, matching
+// an omitted schedule block.
+func syncAdvancedScheduleFromAPI(api *models.AdvancedStorageScheduleModel) *JobAdvancedSchedule {
+	if api == nil || !api.IsEnabled {
+		return nil
+	}
+
+	s := &JobAdvancedSchedule{}
+	if w := api.Weekly; w != nil && w.IsEnabled {
+		days := make([]attr.Value, 0, len(w.Days))
+		for _, d := range w.Days {
+			days = append(days, types.StringValue(string(d)))
+		}
+		s.Weekly = &JobAdvancedScheduleWeekly{Days: types.ListValueMust(types.StringType, days)}
+	}
+	if mo := api.Monthly; mo != nil && mo.IsEnabled {
+		months := make([]attr.Value, 0, len(mo.Months))
+		for _, m := range mo.Months {
+			months = append(months, types.StringValue(string(m)))
+		}
+		s.Monthly = &JobAdvancedScheduleMonthly{
+			DayNumberInMonth: types.StringValue(string(mo.DayNumberInMonth)),
+			DayOfWeek:        types.StringNull(),
+			DayOfMonth:       types.Int64Null(),
+			Months:           types.ListValueMust(types.StringType, months),
+		}
+		if mo.DayOfWeek != "" {
+			s.Monthly.DayOfWeek = types.StringValue(string(mo.DayOfWeek))
+		}
+		if mo.DayOfMonths > 0 {
+			s.Monthly.DayOfMonth = types.Int64Value(int64(mo.DayOfMonths))
+		}
+	}
+	return s
+}
+
+func syncStorageDataFromAPI(api *models.BackupStorageSettingModel) *JobAdvancedStorage {
+	s := &JobAdvancedStorage{
+		CompressionLevel:         types.StringValue(string(api.CompressionLevel)),
+		StorageOptimization:      types.StringValue(string(api.StorageOptimization)),
+		InlineDedupeEnabled:      types.BoolValue(api.InlineDataDedupEnabled),
+		ExcludeSwapFileBlocks:    types.BoolValue(api.ExcludeSwapFileBlocks),
+		ExcludeDeletedFileBlocks: types.BoolValue(api.ExcludeDeletedFileBlocks),
+	}
+	s.Encryption = syncEncryptionFromAPI(api.Encryption)
+	return s
+}
+
+// syncEncryptionFromAPI converts API encryption settings; disabled
+// encryption leaves the block null.
+func syncEncryptionFromAPI(e *models.BackupStorageEncryptionModel) *JobStorageEncryption {
+	if e == nil || !e.IsEnabled {
+		return nil
+	}
+	return &JobStorageEncryption{
+		EncryptionPasswordID: stringOrNull(e.EncryptionPasswordID),
+		KMSServerID:          stringOrNull(e.KMSServerID),
 	}
 }
 
@@ -1744,6 +4003,8 @@ func (r *BackupJob) syncAgentJobFromAPIMap(data *BackupJobModel, api map[string]
 		s := &JobStorageSettings{
 			RepositoryID:    types.StringValue(""),
 			ProxyAutoSelect: proxyAutoSelect,
+			// Agent jobs do not report proxies; keep the configured value.
+			ProxyIDs: data.Storage.ProxyIDs,
 		}
 		if v, ok := storageRaw["backupRepositoryId"].(string); ok {
 			s.RepositoryID = types.StringValue(v)
@@ -1757,6 +4018,13 @@ func (r *BackupJob) syncAgentJobFromAPIMap(data *BackupJobModel, api map[string]
 			}
 		}
 		data.Storage = s
+
+		if data.AdvancedSettings != nil {
+			var advanced models.BackupJobAdvancedSettingsModel
+			if fromJSONMap(storageRaw["advancedSettings"], &advanced) {
+				data.AdvancedSettings = syncAdvancedSettingsFromAPI(data.AdvancedSettings, &advanced)
+			}
+		}
 	}
 
 	// Sync volumes scope when present (agent jobs with backupMode=Volumes).
@@ -1816,7 +4084,7 @@ func (r *BackupJob) syncAgentJobFromAPIMap(data *BackupJobModel, api map[string]
 
 // syncScheduleFromAPI updates a JobScheduleSettings from an API BackupScheduleModel.
 // Preserves existing state values for fields not present in the API response.
-func (r *BackupJob) syncScheduleFromAPI(existing *JobScheduleSettings, api *models.BackupScheduleModel) *JobScheduleSettings {
+func syncScheduleFromAPI(existing *JobScheduleSettings, api *models.BackupScheduleModel) *JobScheduleSettings {
 	s := &JobScheduleSettings{}
 	if existing != nil {
 		// Start from existing to preserve user-set values not returned by the API.
@@ -1877,6 +4145,8 @@ func (r *BackupJob) syncScheduleFromAPI(existing *JobScheduleSettings, api *mode
 		s.RetryAwaitMinutes = types.Int64Null()
 	}
 
+	s.BackupWindow = syncBackupWindowFromAPI(s.BackupWindow, api.BackupWindow)
+
 	return s
 }
 
@@ -1901,9 +4171,23 @@ func (r *BackupJob) normalizeUnknownStateFields(data *BackupJobModel) {
 		data.UseSnapshotlessFileLevelBackup = types.BoolNull()
 	}
 
+	r.normalizeUnknownVMExclusionFields(data.VirtualMachines)
 	r.normalizeUnknownStorageFields(data.Storage)
+	r.normalizeUnknownAdvancedSettingsFields(data.AdvancedSettings)
 	r.normalizeUnknownGuestProcessingFields(data.GuestProcessing)
-	r.normalizeUnknownScheduleFields(data.Schedule)
+	normalizeUnknownScheduleFields(data.Schedule)
+}
+
+func (r *BackupJob) normalizeUnknownVMExclusionFields(scope *VMBackupScope) {
+	if scope == nil || scope.Excludes == nil {
+		return
+	}
+
+	for i := range scope.Excludes.Disks {
+		if scope.Excludes.Disks[i].Disks.IsUnknown() {
+			scope.Excludes.Disks[i].Disks = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+	}
 }
 
 func (r *BackupJob) normalizeUnknownStorageFields(storage *JobStorageSettings) {
@@ -1917,6 +4201,9 @@ func (r *BackupJob) normalizeUnknownStorageFields(storage *JobStorageSettings) {
 	if storage.ProxyAutoSelect.IsUnknown() {
 		storage.ProxyAutoSelect = types.BoolNull()
 	}
+	if storage.ProxyIDs.IsUnknown() {
+		storage.ProxyIDs = types.ListNull(types.StringType)
+	}
 	if storage.RetentionType.IsUnknown() {
 		storage.RetentionType = types.StringNull()
 	}
@@ -1925,6 +4212,36 @@ func (r *BackupJob) normalizeUnknownStorageFields(storage *JobStorageSettings) {
 	}
 }
 
+func (r *BackupJob) normalizeUnknownAdvancedSettingsFields(advanced *JobAdvancedSettings) {
+	if advanced == nil {
+		return
+	}
+
+	if b := advanced.Backup; b != nil {
+		if b.Mode.IsUnknown() {
+			b.Mode = types.StringNull()
+		}
+		for _, s := range []*JobAdvancedSchedule{b.SyntheticFull, b.ActiveFull, b.HealthCheck, b.CompactFull} {
+			if s != nil && s.Monthly != nil && s.Monthly.Months.IsUnknown() {
+				s.Monthly.Months = types.ListValueMust(types.StringType, []attr.Value{})
+			}
+		}
+	}
+	if n := advanced.Notifications; n != nil && n.Email != nil && n.Email.Subject.IsUnknown() {
+		n.Email.Subject = types.StringNull()
+	}
+	if advanced.Storage == nil {
+		return
+	}
+
+	if advanced.Storage.CompressionLevel.IsUnknown() {
+		advanced.Storage.CompressionLevel = types.StringNull()
+	}
+	if advanced.Storage.StorageOptimization.IsUnknown() {
+		advanced.Storage.StorageOptimization = types.StringNull()
+	}
+}
+
 func (r *BackupJob) normalizeUnknownGuestProcessingFields(guestProcessing *JobGuestProcessing) {
 	if guestProcessing == nil {
 		return
@@ -1939,9 +4256,49 @@ func (r *BackupJob) normalizeUnknownGuestProcessingFields(guestProcessing *JobGu
 	if guestProcessing.InteractionProxyAutoSelect.IsUnknown() {
 		guestProcessing.InteractionProxyAutoSelect = types.BoolNull()
 	}
+
+	for i := range guestProcessing.ObjectOverrides {
+		o := &guestProcessing.ObjectOverrides[i]
+		if o.Type.IsUnknown() {
+			o.Type = types.StringNull()
+		}
+		if o.HostName.IsUnknown() {
+			o.HostName = types.StringNull()
+		}
+		if a := o.AppAware; a != nil {
+			if a.VSS.IsUnknown() {
+				a.VSS = types.StringNull()
+			}
+			if a.TransactionLogs.IsUnknown() {
+				a.TransactionLogs = types.StringNull()
+			}
+			if sql := a.SQL; sql != nil {
+				if sql.BackupIntervalMinutes.IsUnknown() {
+					sql.BackupIntervalMinutes = types.Int64Null()
+				}
+				if sql.RetainLogBackups.IsUnknown() {
+					sql.RetainLogBackups = types.StringNull()
+				}
+				if sql.RetainDays.IsUnknown() {
+					sql.RetainDays = types.Int64Null()
+				}
+			}
+			if ora := a.Oracle; ora != nil {
+				if ora.DeleteOlderThanHours.IsUnknown() {
+					ora.DeleteOlderThanHours = types.Int64Null()
+				}
+				if ora.DeleteOverGB.IsUnknown() {
+					ora.DeleteOverGB = types.Int64Null()
+				}
+				if ora.BackupIntervalMinutes.IsUnknown() {
+					ora.BackupIntervalMinutes = types.Int64Null()
+				}
+			}
+		}
+	}
 }
 
-func (r *BackupJob) normalizeUnknownScheduleFields(schedule *JobScheduleSettings) {
+func normalizeUnknownScheduleFields(schedule *JobScheduleSettings) {
 	if schedule == nil {
 		return
 	}
@@ -2073,5 +4430,12 @@ func (r *BackupJob) syncScheduleFromAPIMap(existing *JobScheduleSettings, api ma
 		s.RetryEnabled = types.BoolValue(false)
 	}
 
+	var window models.ScheduleBackupWindowModel
+	if fromJSONMap(api["backupWindow"], &window) {
+		s.BackupWindow = syncBackupWindowFromAPI(s.BackupWindow, &window)
+	} else {
+		s.BackupWindow = nil
+	}
+
 	return s
 }
@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+// ---------------------------------------------------------------------------
+// Backup job data for the veeam_backup_job data source
+//
+// The data source in pkg/datasources returns the same nested structure as
+// the veeam_backup_job resource. Its schema is derived from the resource
+// schema with every attribute computed, and jobs are read through the
+// resource's own Read, the same way list resources return full data, so the
+// two cannot drift apart. The functions below are the only entry points the
+// data source uses.
+// ---------------------------------------------------------------------------
+
+// backupJobResourceOnlyAttributes are left out of the data source:
+// clone_from_job_id is a create-time input the API never returns, and Read
+// only refreshes the advanced_settings sections already held in state, which
+// a lookup has none of.
+var backupJobResourceOnlyAttributes = []string{"clone_from_job_id", "advanced_settings"}
+
+// BackupJobTypes returns the job types managed by veeam_backup_job.
+func BackupJobTypes() []string {
+	return slices.Clone(backupJobListSpec.types)
+}
+
+// BackupJobDataSourceAttributes returns the veeam_backup_job resource
+// attributes, except the resource-only ones, as computed data source
+// attributes with the same names, types and descriptions.
+func BackupJobDataSourceAttributes(ctx context.Context) (map[string]dsschema.Attribute, diag.Diagnostics) {
+	var resp resource.SchemaResponse
+	(&BackupJob{}).Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+	attrs := maps.Clone(resp.Schema.Attributes)
+	for _, name := range backupJobResourceOnlyAttributes {
+		delete(attrs, name)
+	}
+	return dataSourceAttributes(path.Empty(), attrs)
+}
+
+// ReadBackupJob finds the backup job with the given id or, when id is empty,
+// the given name, and returns it in the layout of the data source schema
+// built from BackupJobDataSourceAttributes.
+func ReadBackupJob(ctx context.Context, c client.APIClient, id, name string) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	jobID, jobType, err := resolveBackupJob(ctx, c, id, name)
+	if err != nil {
+		diags.AddError("Failed to find backup job", err.Error())
+		return tftypes.Value{}, diags
+	}
+	if !slices.Contains(backupJobListSpec.types, jobType) {
+		diags.AddError("Unexpected job type",
+			fmt.Sprintf("Job %s is a %s job; veeam_backup_job reads %s jobs only.",
+				jobID, jobType, strings.Join(backupJobListSpec.types, ", ")))
+		return tftypes.Value{}, diags
+	}
+
+	// Read the job as the resource would after an import. The resource picks
+	// the API model by the type held in state, so both id and type are seeded.
+	job := &BackupJob{client: c}
+	var schemaResp resource.SchemaResponse
+	job.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), jobID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("type"), jobType)...)
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+
+	readResp := &resource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}}
+	job.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	diags.Append(readResp.Diagnostics...)
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+	if readResp.State.Raw.IsNull() {
+		diags.AddError("Failed to find backup job", fmt.Sprintf("Backup job %s was deleted while it was read.", jobID))
+		return tftypes.Value{}, diags
+	}
+
+	raw, err := withoutAttributes(readResp.State.Raw, backupJobResourceOnlyAttributes)
+	if err != nil {
+		diags.AddError("Failed to read backup job", fmt.Sprintf("Backup job %s: %s", jobID, err))
+		return tftypes.Value{}, diags
+	}
+	return raw, diags
+}
+
+// withoutAttributes returns the object v without the named attributes.
+func withoutAttributes(v tftypes.Value, names []string) (tftypes.Value, error) {
+	objType, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("expected an object, got %s", v.Type())
+	}
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return tftypes.Value{}, err
+	}
+	attrTypes := maps.Clone(objType.AttributeTypes)
+	for _, name := range names {
+		delete(attrTypes, name)
+		delete(values, name)
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, values), nil
+}
+
+// resolveBackupJob returns the UUID and type of the job identified by id or name.
+func resolveBackupJob(ctx context.Context, c client.APIClient, id, name string) (string, string, error) {
+	if c == nil {
+		return "", "", errors.New("provider is not configured")
+	}
+
+	if id != "" {
+		endpoint := fmt.Sprintf(client.PathJobByID, id)
+		var job map[string]interface{}
+		if err := c.GetJSON(ctx, endpoint, &job); err != nil {
+			if isJobNotFound(err) {
+				return "", "", fmt.Errorf("no backup job with id %q was found", id)
+			}
+			return "", "", fmt.Errorf("GET %s: %w", endpoint, err)
+		}
+		return id, getStringValue(job, "type"), nil
+	}
+
+	matches, err := naturalKeyMatches(ctx, c, backupJobImport, "name", name)
+	if err != nil {
+		return "", "", err
+	}
+	switch len(matches) {
+	case 0:
+		return "", "", fmt.Errorf("no backup job with name %q was found", name)
+	case 1:
+		return getStringValue(matches[0], "id"), getStringValue(matches[0], "type"), nil
+	}
+	return "", "", fmt.Errorf("name %q matches %d jobs; look the job up by id instead. Candidates:\n  - %s",
+		name, len(matches), naturalKeyCandidates(matches, "name"))
+}
+
+// dataSourceAttributes converts resource schema attributes into computed data
+// source attributes; p is the path of the enclosing attribute.
+func dataSourceAttributes(p path.Path, attrs map[string]schema.Attribute) (map[string]dsschema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make(map[string]dsschema.Attribute, len(attrs))
+	for name, a := range attrs {
+		converted, d := dataSourceAttribute(p.AtName(name), a)
+		diags.Append(d...)
+		if converted != nil {
+			out[name] = converted
+		}
+	}
+	return out, diags
+}
+
+// dataSourceAttribute converts one resource attribute. An attribute kind it
+// does not know is reported as an error instead of being dropped, so the
+// data source never silently omits part of the resource structure.
+func dataSourceAttribute(p path.Path, a schema.Attribute) (dsschema.Attribute, diag.Diagnostics) {
+	desc := a.GetMarkdownDescription()
+	sensitive := a.IsSensitive()
+	switch a := a.(type) {
+	case schema.StringAttribute:
+		return dsschema.StringAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive}, nil
+	case schema.BoolAttribute:
+		return dsschema.BoolAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive}, nil
+	case schema.Int64Attribute:
+		return dsschema.Int64Attribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive}, nil
+	case schema.Float64Attribute:
+		return dsschema.Float64Attribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive}, nil
+	case schema.ListAttribute:
+		return dsschema.ListAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			ElementType: a.ElementType}, nil
+	case schema.SetAttribute:
+		return dsschema.SetAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			ElementType: a.ElementType}, nil
+	case schema.MapAttribute:
+		return dsschema.MapAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			ElementType: a.ElementType}, nil
+	case schema.ObjectAttribute:
+		return dsschema.ObjectAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			AttributeTypes: a.AttributeTypes}, nil
+	case schema.SingleNestedAttribute:
+		attrs, diags := dataSourceAttributes(p, a.Attributes)
+		return dsschema.SingleNestedAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			Attributes: attrs}, diags
+	case schema.ListNestedAttribute:
+		attrs, diags := dataSourceAttributes(p, a.NestedObject.Attributes)
+		return dsschema.ListNestedAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			NestedObject: dsschema.NestedAttributeObject{Attributes: attrs}}, diags
+	case schema.SetNestedAttribute:
+		attrs, diags := dataSourceAttributes(p, a.NestedObject.Attributes)
+		return dsschema.SetNestedAttribute{MarkdownDescription: desc, Computed: true, Sensitive: sensitive,
+			NestedObject: dsschema.NestedAttributeObject{Attributes: attrs}}, diags
+	}
+	var diags diag.Diagnostics
+	diags.AddError("Unsupported attribute type",
+		fmt.Sprintf("The veeam_backup_job data source cannot expose %s: attribute type %T is not supported.", p, a))
+	return nil, diags
+}
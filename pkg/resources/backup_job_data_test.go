@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func TestReadBackupJob_ByName(t *testing.T) {
+	ctx := context.Background()
+	mockClient := new(MockVeeamClient)
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*map[string]interface{}) = map[string]interface{}{
+				"data": []interface{}{
+					map[string]interface{}{"id": "job-2", "name": "Weekly-SQL", "type": "VSphereBackup"},
+					map[string]interface{}{"id": "job-1", "name": "Daily-VMs", "type": "VSphereBackup"},
+				},
+			}
+		}).Return(nil)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*models.BackupJobModel) = models.BackupJobModel{
+				JobModel:    models.JobModel{ID: "job-1", Name: "Daily-VMs", Type: models.JobTypeVSphereBackup},
+				Description: "Console-managed job",
+				VirtualMachines: &models.BackupJobVirtualMachinesModel{
+					Includes: []models.InventoryObjectSpec{{
+						Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "Production",
+						Type: string(models.VmwareTypeFolder), ObjectID: "group-v3",
+					}},
+					Excludes: &models.BackupJobExclusions{
+						VMs: []models.InventoryObjectSpec{{
+							Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "scratch01",
+							Type: string(models.VmwareTypeVirtualMachine), ObjectID: "vm-99",
+						}},
+					},
+				},
+				Storage: &models.BackupJobStorageModel{
+					BackupRepositoryID: "repo-1",
+					BackupProxies:      &models.BackupProxiesSettingsModel{AutoSelectEnabled: true},
+					RetentionPolicy: &models.BackupJobRetentionPolicySettings{
+						Type: models.RetentionPolicyTypeRestorePoints, Quantity: 14,
+					},
+					GFSPolicy: &models.GFSPolicySettingsModel{
+						IsEnabled: true,
+						Weekly: &models.GFSPolicySettingsWeeklyModel{
+							IsEnabled: true, KeepForNumberOfWeeks: 4, DesiredTime: models.DaySunday,
+						},
+					},
+				},
+				GuestProcessing: &models.BackupJobGuestProcessingModel{
+					AppAwareProcessing: &models.BackupApplicationAwareProcessingModel{IsEnabled: true},
+				},
+				Schedule: &models.BackupScheduleModel{
+					RunAutomatically: true,
+					Daily:            &models.ScheduleDailyModel{IsEnabled: true, LocalTime: "22:00", DailyKind: "Everyday"},
+				},
+			}
+		}).Return(nil)
+
+	raw, diags := ReadBackupJob(ctx, mockClient, "", "daily-vms")
+	require.False(t, diags.HasError(), "unexpected errors: %v", diags)
+
+	attrs, diags := BackupJobDataSourceAttributes(ctx)
+	require.False(t, diags.HasError())
+	state := tfsdk.State{Schema: dsschema.Schema{Attributes: attrs}, Raw: raw}
+	root := path.Root
+	want := map[string]struct {
+		path  path.Path
+		value attr.Value
+	}{
+		"id":          {root("id"), types.StringValue("job-1")},
+		"name":        {root("name"), types.StringValue("Daily-VMs")},
+		"type":        {root("type"), types.StringValue("VSphereBackup")},
+		"include":     {root("virtual_machines").AtName("includes").AtListIndex(0).AtName("object_id"), types.StringValue("group-v3")},
+		"exclude":     {root("virtual_machines").AtName("excludes").AtName("vms").AtListIndex(0).AtName("name"), types.StringValue("scratch01")},
+		"repository":  {root("storage").AtName("repository_id"), types.StringValue("repo-1")},
+		"retention":   {root("storage").AtName("retention_quantity"), types.Int64Value(14)},
+		"gfs weekly":  {root("storage").AtName("gfs_policy").AtName("weekly_keep_for"), types.Int64Value(4)},
+		"app aware":   {root("guest_processing").AtName("app_aware_enabled"), types.BoolValue(true)},
+		"daily":       {root("schedule").AtName("daily_local_time"), types.StringValue("22:00")},
+		"no clone":    {root("clone_from_job_id"), nil},
+		"no advanced": {root("advanced_settings"), nil},
+	}
+	for name, w := range want {
+		var got attr.Value
+		d := state.GetAttribute(ctx, w.path, &got)
+		if w.value == nil {
+			assert.True(t, d.HasError(), "%s: %s must not be exposed", name, w.path)
+			continue
+		}
+		require.False(t, d.HasError(), "%s: %v", name, d)
+		assert.Equal(t, w.value, got, name)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestReadBackupJob_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		lookup  string
+		mock    func(*MockVeeamClient)
+		wantErr string
+	}{
+		{
+			name: "unknown id",
+			id:   "job-9",
+			mock: func(m *MockVeeamClient) {
+				m.On("GetJSON", mock.Anything, "/api/v1/jobs/job-9", mock.Anything).
+					Return(errors.New("HTTP 404: NotFound"))
+			},
+			wantErr: `no backup job with id "job-9" was found`,
+		},
+		{
+			name:   "unknown name",
+			lookup: "Nightly",
+			mock: func(m *MockVeeamClient) {
+				m.On("GetJSON", mock.Anything, "/api/v1/jobs", mock.Anything).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*map[string]interface{}) = map[string]interface{}{"data": []interface{}{}}
+					}).Return(nil)
+			},
+			wantErr: `no backup job with name "Nightly" was found`,
+		},
+		{
+			name: "SureBackup job",
+			id:   "sb-1",
+			mock: func(m *MockVeeamClient) {
+				m.On("GetJSON", mock.Anything, "/api/v1/jobs/sb-1", mock.Anything).
+					Run(func(args mock.Arguments) {
+						*args.Get(2).(*map[string]interface{}) = map[string]interface{}{"id": "sb-1", "type": "SureBackup"}
+					}).Return(nil)
+			},
+			wantErr: "Job sb-1 is a SureBackup job",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			tt.mock(mockClient)
+			_, diags := ReadBackupJob(context.Background(), mockClient, tt.id, tt.lookup)
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+// TestDataSourceAttributes_Unsupported verifies that an attribute kind the
+// conversion does not know is reported rather than dropped.
+func TestDataSourceAttributes_Unsupported(t *testing.T) {
+	attrs, diags := dataSourceAttributes(path.Empty(), map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+		"storage": schema.SingleNestedAttribute{Optional: true, Attributes: map[string]schema.Attribute{
+			"extra": schema.DynamicAttribute{Optional: true},
+		}},
+	})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unsupported attribute type", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "cannot expose storage.extra")
+	assert.Contains(t, attrs, "name")
+}
@@ -13,8 +13,17 @@ package resources
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
-
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -54,6 +63,11 @@ func (m *MockVeeamClient) WaitForTask(ctx context.Context, sessionID string) err
 	return args.Error(0)
 }
 
+func (m *MockVeeamClient) WaitForTaskWithOptions(ctx context.Context, sessionID string, pollInterval, timeout time.Duration) error {
+	args := m.Called(ctx, sessionID, pollInterval, timeout)
+	return args.Error(0)
+}
+
 // ---------------------------------------------------------------------------
 // buildVMJobSpec tests
 // ---------------------------------------------------------------------------
@@ -110,7 +124,7 @@ func TestBackupJob_BuildVMSpec(t *testing.T) {
 	assert.Equal(t, "VSphere", spec.VirtualMachines.Includes[0].Platform)
 	assert.Equal(t, "vcenter.lab", spec.VirtualMachines.Includes[0].HostName)
 	assert.Equal(t, "vm-prod-01", spec.VirtualMachines.Includes[0].Name)
-	assert.Equal(t, models.VmwareTypeVirtualMachine, spec.VirtualMachines.Includes[0].Type)
+	assert.Equal(t, string(models.VmwareTypeVirtualMachine), spec.VirtualMachines.Includes[0].Type)
 	assert.Equal(t, "vm-101", spec.VirtualMachines.Includes[0].ObjectID)
 
 	// Storage
@@ -159,6 +173,1508 @@ func TestBackupJob_ExcludeTemplates(t *testing.T) {
 	assert.True(t, spec.VirtualMachines.Excludes.Templates.IsEnabled)
 }
 
+// TestBackupJob_Excludes verifies that excluded VMs, disk selections and
+// template options are sent in the API spec and read back unchanged.
+func TestBackupJob_Excludes(t *testing.T) {
+	r := &BackupJob{}
+
+	data := &BackupJobModel{
+		Name: types.StringValue("Job"),
+		Type: types.StringValue("VSphereBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{
+				{Platform: types.StringValue("VSphere"), Name: types.StringValue("Cluster01"), Type: types.StringValue("Cluster")},
+			},
+			Excludes: &VMExclusions{
+				VMs: []VMIncludeEntry{
+					{Platform: types.StringValue("VSphere"), Name: types.StringValue("scratch01"), ObjectID: types.StringValue("vm-7")},
+				},
+				Disks: []VMDiskExclusion{
+					{
+						Platform:       types.StringValue("VSphere"),
+						Name:           types.StringValue("sql01"),
+						ObjectID:       types.StringValue("vm-101"),
+						DisksToProcess: types.StringValue("SystemOnly"),
+						Disks:          types.ListNull(types.StringType),
+					},
+					{
+						Platform:                  types.StringValue("VSphere"),
+						Name:                      types.StringValue("sql02"),
+						ObjectID:                  types.StringValue("vm-102"),
+						DisksToProcess:            types.StringValue("SelectedDisks"),
+						Disks:                     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("scsi0:0")}),
+						RemoveFromVMConfiguration: types.BoolValue(true),
+					},
+				},
+			},
+			ExcludeTemplates:                types.BoolValue(false),
+			ExcludeTemplatesFromIncremental: types.BoolValue(true),
+		},
+	}
+	require.NoError(t, validateVMExclusions(data.VirtualMachines))
+
+	spec := r.buildVMJobSpec(data)
+	ex := spec.VirtualMachines.Excludes
+	require.NotNil(t, ex)
+	require.Len(t, ex.VMs, 1)
+	assert.Equal(t, "vm-7", ex.VMs[0].ObjectID)
+	require.Len(t, ex.Disks, 2)
+	assert.Equal(t, "sql01", ex.Disks[0].VMObject.Name)
+	assert.Equal(t, models.DisksTypeSystemOnly, ex.Disks[0].DisksToProcess)
+	assert.Equal(t, []string{}, ex.Disks[0].Disks)
+	assert.Equal(t, []string{"scsi0:0"}, ex.Disks[1].Disks)
+	assert.True(t, ex.Disks[1].RemoveFromVMConfiguration)
+	require.NotNil(t, ex.Templates)
+	assert.False(t, ex.Templates.IsEnabled)
+	assert.True(t, ex.Templates.ExcludeFromIncremental)
+
+	// Round-trip through the response model.
+	api := &models.BackupJobModel{
+		JobModel:        models.JobModel{ID: "job-1", Name: "Job", Type: models.JobTypeVSphereBackup},
+		VirtualMachines: r.buildVirtualMachinesModel(data.VirtualMachines),
+	}
+	synced := &BackupJobModel{}
+	r.syncVMJobFromAPI(synced, api)
+
+	require.NotNil(t, synced.VirtualMachines.Excludes)
+	require.Len(t, synced.VirtualMachines.Excludes.VMs, 1)
+	assert.Equal(t, "scratch01", synced.VirtualMachines.Excludes.VMs[0].Name.ValueString())
+	require.Len(t, synced.VirtualMachines.Excludes.Disks, 2)
+	assert.Equal(t, "SystemOnly", synced.VirtualMachines.Excludes.Disks[0].DisksToProcess.ValueString())
+	assert.Equal(t, "vm-102", synced.VirtualMachines.Excludes.Disks[1].ObjectID.ValueString())
+	assert.Len(t, synced.VirtualMachines.Excludes.Disks[1].Disks.Elements(), 1)
+	assert.True(t, synced.VirtualMachines.Excludes.Disks[1].RemoveFromVMConfiguration.ValueBool())
+	assert.False(t, synced.VirtualMachines.ExcludeTemplates.ValueBool())
+	assert.True(t, synced.VirtualMachines.ExcludeTemplatesFromIncremental.ValueBool())
+}
+
+// TestBackupJob_Excludes_TemplatesOnly verifies that an API response with only
+// template settings keeps the excludes block null.
+func TestBackupJob_Excludes_TemplatesOnly(t *testing.T) {
+	r := &BackupJob{}
+	data := &BackupJobModel{}
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Name: "Job", Type: models.JobTypeVSphereBackup},
+		VirtualMachines: &models.BackupJobVirtualMachinesModel{
+			Includes: []models.InventoryObjectSpec{{Platform: "VSphere", Name: "dc"}},
+			Excludes: &models.BackupJobExclusions{
+				Templates: &models.BackupJobExclusionsTemplates{IsEnabled: true},
+			},
+		},
+	})
+
+	require.NotNil(t, data.VirtualMachines)
+	assert.Nil(t, data.VirtualMachines.Excludes)
+	assert.True(t, data.VirtualMachines.ExcludeTemplates.ValueBool())
+	assert.False(t, data.VirtualMachines.ExcludeTemplatesFromIncremental.ValueBool())
+}
+
+func TestBackupJob_ValidateVMExclusions(t *testing.T) {
+	scope := &VMBackupScope{Excludes: &VMExclusions{Disks: []VMDiskExclusion{{
+		Name:           types.StringValue("sql01"),
+		DisksToProcess: types.StringValue("SelectedDisks"),
+		Disks:          types.ListNull(types.StringType),
+	}}}}
+	assert.ErrorContains(t, validateVMExclusions(scope), "must list at least one disk")
+
+	scope.Excludes.Disks[0].DisksToProcess = types.StringValue("Some")
+	assert.ErrorContains(t, validateVMExclusions(scope), "unsupported disks_to_process")
+}
+
+// backupJobConfig returns data as a veeam_backup_job configuration.
+func backupJobConfig(t *testing.T, data BackupJobModel) tfsdk.Config {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	NewBackupJob().Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), data).HasError())
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}
+}
+
+// validVSphereJob returns the smallest valid VSphereBackup configuration.
+func validVSphereJob() BackupJobModel {
+	return BackupJobModel{
+		Name:        types.StringValue("Job"),
+		Description: types.StringValue("desc"),
+		Type:        types.StringValue("VSphereBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{
+				Platform: types.StringValue("VSphere"),
+				Type:     types.StringValue("VirtualMachine"),
+				HostName: types.StringValue("vcsa01"),
+				Name:     types.StringValue("vm-1"),
+				ObjectID: types.StringValue("vm-101"),
+			}},
+		},
+	}
+}
+
+// TestBackupJob_ValidateConfig_Exclusions verifies that an invalid disk
+// exclusion fails at plan time, and that a disk list not yet known is
+// accepted.
+func TestBackupJob_ValidateConfig_Exclusions(t *testing.T) {
+	data := validVSphereJob()
+	data.VirtualMachines.Excludes = &VMExclusions{Disks: []VMDiskExclusion{{
+		Platform:       types.StringValue("VSphere"),
+		Name:           types.StringValue("sql01"),
+		ObjectID:       types.StringValue("vm-101"),
+		DisksToProcess: types.StringValue("SelectedDisks"),
+		Disks:          types.ListNull(types.StringType),
+	}}}
+
+	resp := &resource.ValidateConfigResponse{}
+	NewBackupJob().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(),
+		resource.ValidateConfigRequest{Config: backupJobConfig(t, data)}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "must list at least one disk")
+
+	data.VirtualMachines.Excludes.Disks[0].Disks = types.ListUnknown(types.StringType)
+	resp = &resource.ValidateConfigResponse{}
+	NewBackupJob().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(),
+		resource.ValidateConfigRequest{Config: backupJobConfig(t, data)}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+}
+
+// TestBackupJob_ValidateConfig_AdvancedBackup verifies that an incomplete
+// advanced_settings.backup schedule fails at plan time, and that a schedule
+// whose kind is not yet known is accepted.
+func TestBackupJob_ValidateConfig_AdvancedBackup(t *testing.T) {
+	data := validVSphereJob()
+	data.Storage = &JobStorageSettings{RepositoryID: types.StringValue("repo-1"), ProxyIDs: types.ListNull(types.StringType)}
+	data.AdvancedSettings = &JobAdvancedSettings{Backup: &JobAdvancedBackup{
+		Mode: types.StringValue("Incremental"),
+		ActiveFull: &JobAdvancedSchedule{Monthly: &JobAdvancedScheduleMonthly{
+			DayNumberInMonth: types.StringValue("OnDay"),
+			DayOfWeek:        types.StringNull(),
+			DayOfMonth:       types.Int64Null(),
+			Months:           types.ListNull(types.StringType),
+		}},
+	}}
+
+	resp := &resource.ValidateConfigResponse{}
+	NewBackupJob().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(),
+		resource.ValidateConfigRequest{Config: backupJobConfig(t, data)}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "advanced_settings.backup.active_full: monthly.day_of_month is required")
+
+	data.AdvancedSettings.Backup.ActiveFull.Monthly.DayNumberInMonth = types.StringUnknown()
+	resp = &resource.ValidateConfigResponse{}
+	NewBackupJob().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(),
+		resource.ValidateConfigRequest{Config: backupJobConfig(t, data)}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+}
+
+// TestBackupJob_Excludes_EmptyBlockRoundTrip verifies that excludes = {} and
+// empty lists read back as configured; the API omits empty exclusions.
+func TestBackupJob_Excludes_EmptyBlockRoundTrip(t *testing.T) {
+	r := &BackupJob{}
+	api := &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Name: "Job", Type: models.JobTypeVSphereBackup},
+		VirtualMachines: &models.BackupJobVirtualMachinesModel{
+			Includes: []models.InventoryObjectSpec{{Platform: "VSphere", Name: "vm-1"}},
+		},
+	}
+
+	data := validVSphereJob()
+	data.VirtualMachines.Excludes = &VMExclusions{}
+	assert.Nil(t, r.buildVirtualMachinesModel(data.VirtualMachines).Excludes, "nothing to exclude")
+	r.syncVMJobFromAPI(&data, api)
+	require.NotNil(t, data.VirtualMachines.Excludes, "empty block stays set")
+	assert.Nil(t, data.VirtualMachines.Excludes.VMs)
+	assert.Nil(t, data.VirtualMachines.Excludes.Disks)
+
+	data = validVSphereJob()
+	data.VirtualMachines.Excludes = &VMExclusions{VMs: []VMIncludeEntry{}}
+	r.syncVMJobFromAPI(&data, api)
+	require.NotNil(t, data.VirtualMachines.Excludes)
+	assert.NotNil(t, data.VirtualMachines.Excludes.VMs, "empty list stays empty, not null")
+	assert.Empty(t, data.VirtualMachines.Excludes.VMs)
+
+	data = validVSphereJob()
+	r.syncVMJobFromAPI(&data, api)
+	assert.Nil(t, data.VirtualMachines.Excludes, "omitted block stays omitted")
+}
+
+// TestBackupJob_Update_ClearsExcludeTemplates verifies that turning
+// exclude_templates off while keeping the incremental option sends
+// isEnabled = false instead of keeping the server value.
+func TestBackupJob_Update_ClearsExcludeTemplates(t *testing.T) {
+	r := &BackupJob{}
+	data := validVSphereJob()
+	data.VirtualMachines.ExcludeTemplates = types.BoolValue(false)
+	data.VirtualMachines.ExcludeTemplatesFromIncremental = types.BoolValue(true)
+
+	current := map[string]interface{}{
+		"virtualMachines": map[string]interface{}{
+			"excludes": map[string]interface{}{
+				"templates": map[string]interface{}{"isEnabled": true, "excludeFromIncremental": true},
+			},
+		},
+	}
+	merged, err := mergeManagedPayload(current, r.buildVMJobModel(&data, false), vmJobManagedPaths...)
+	require.NoError(t, err)
+	templates := merged["virtualMachines"].(map[string]interface{})["excludes"].(map[string]interface{})["templates"].(map[string]interface{})
+	assert.Equal(t, false, templates["isEnabled"])
+	assert.Equal(t, true, templates["excludeFromIncremental"])
+}
+
+// TestBackupJob_HyperVJob verifies that a HyperVBackup job sends Hyper-V
+// inventory objects and the hyperV advanced settings, and reads them back.
+func TestBackupJob_HyperVJob(t *testing.T) {
+	r := &BackupJob{}
+
+	hv := func(objType, name, objectID string) VMIncludeEntry {
+		return VMIncludeEntry{
+			Platform: types.StringValue("HyperV"),
+			Type:     types.StringValue(objType),
+			HostName: types.StringValue("scvmm01.corp.local"),
+			Name:     types.StringValue(name),
+			ObjectID: types.StringValue(objectID),
+		}
+	}
+	data := &BackupJobModel{
+		Name: types.StringValue("HV Job"),
+		Type: types.StringValue("HyperVBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{
+				hv("SCVMM", "scvmm01.corp.local", ""),
+				hv("Cluster", "hvcluster01", "1c2d3e4f-0000-0000-0000-000000000001"),
+			},
+			Excludes: &VMExclusions{VMs: []VMIncludeEntry{hv("VirtualMachine", "tmp-vm", "5b6a7c8d-0000-0000-0000-000000000002")}},
+		},
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1"), ProxyAutoSelect: types.BoolValue(true)},
+		AdvancedSettings: &JobAdvancedSettings{HyperV: &JobHyperVSettings{
+			GuestQuiescence:      types.BoolValue(true),
+			CrashConsistent:      types.BoolValue(true),
+			ChangedBlockTracking: types.BoolValue(false),
+			VolumeSnapshots:      types.BoolValue(true),
+		}},
+	}
+	require.NoError(t, validateVMObjects(data))
+	require.NoError(t, validateAdvancedSettings(data))
+
+	spec := r.buildVMJobSpec(data)
+	assert.Equal(t, models.JobTypeHyperVBackup, spec.Type)
+	require.Len(t, spec.VirtualMachines.Includes, 2)
+	assert.Equal(t, string(models.InventoryPlatformHyperV), spec.VirtualMachines.Includes[0].Platform)
+	assert.Equal(t, string(models.HyperVTypeSCVMM), spec.VirtualMachines.Includes[0].Type)
+	assert.Equal(t, "HyperV", spec.VirtualMachines.Excludes.VMs[0].Platform)
+	assert.Equal(t, &models.BackupJobAdvancedSettingsHyperVModel{
+		GuestQuiescence: true, CrashConsistent: true, ChangedBlockTracking: false, VolumeSnapshot: true,
+	}, spec.Storage.AdvancedSettings.HyperV)
+
+	// Disabled CBT must reach the API, or the merged PUT keeps the server value.
+	payload, err := toJSONMap(spec)
+	require.NoError(t, err)
+	hyperV := payload["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})["hyperV"].(map[string]interface{})
+	assert.Equal(t, false, hyperV["changedBlockTracking"])
+
+	api := &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Name: "HV Job", Type: models.JobTypeHyperVBackup},
+		VirtualMachines: &models.BackupJobVirtualMachinesModel{
+			Includes: spec.VirtualMachines.Includes,
+			Excludes: &models.BackupJobExclusions{VMs: spec.VirtualMachines.Excludes.VMs},
+		},
+		Storage: spec.Storage,
+	}
+	api.Storage.AdvancedSettings.HyperV.ChangedBlockTracking = true
+	r.syncVMJobFromAPI(data, api)
+
+	assert.Equal(t, "HyperVBackup", data.Type.ValueString())
+	assert.Equal(t, "SCVMM", data.VirtualMachines.Includes[0].Type.ValueString())
+	assert.Equal(t, "HyperV", data.VirtualMachines.Excludes.VMs[0].Platform.ValueString())
+	assert.True(t, data.AdvancedSettings.HyperV.ChangedBlockTracking.ValueBool())
+	assert.True(t, data.AdvancedSettings.HyperV.CrashConsistent.ValueBool())
+}
+
+// TestBackupJob_CloudDirectorJob verifies that a CloudDirectorBackup job sends
+// organisations, org VDCs and vApps as Cloud Director inventory objects.
+func TestBackupJob_CloudDirectorJob(t *testing.T) {
+	r := &BackupJob{}
+
+	vcd := func(objType, name, objectID string) VMIncludeEntry {
+		return VMIncludeEntry{
+			Platform: types.StringValue("CloudDirector"),
+			Type:     types.StringValue(objType),
+			HostName: types.StringValue("vcd.sp.local"),
+			Name:     types.StringValue(name),
+			ObjectID: types.StringValue(objectID),
+		}
+	}
+	data := &BackupJobModel{
+		Name: types.StringValue("Tenant-Acme"),
+		Type: types.StringValue("CloudDirectorBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{
+				vcd("Organization", "acme", "urn:vcloud:org:0001"),
+				vcd("OrganizationVDC", "acme-gold", "urn:vcloud:vdc:0002"),
+				vcd("vApp", "acme-erp", "urn:vcloud:vapp:0003"),
+			},
+			Excludes: &VMExclusions{VMs: []VMIncludeEntry{vcd("VirtualMachine", "acme-erp-tmp", "urn:vcloud:vm:0004")}},
+		},
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1"), ProxyAutoSelect: types.BoolValue(true)},
+	}
+	require.NoError(t, validateVMObjects(data))
+
+	spec := r.buildVMJobSpec(data)
+	assert.Equal(t, models.JobTypeCloudDirectorBackup, spec.Type)
+	require.Len(t, spec.VirtualMachines.Includes, 3)
+	assert.Equal(t, string(models.InventoryPlatformCloudDirector), spec.VirtualMachines.Includes[0].Platform)
+	assert.Equal(t, string(models.CloudDirectorTypeOrganizationVDC), spec.VirtualMachines.Includes[1].Type)
+	assert.Equal(t, "urn:vcloud:vapp:0003", spec.VirtualMachines.Includes[2].ObjectID)
+
+	api := &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Name: "Tenant-Acme", Type: models.JobTypeCloudDirectorBackup},
+		VirtualMachines: &models.BackupJobVirtualMachinesModel{
+			Includes: spec.VirtualMachines.Includes,
+			Excludes: &models.BackupJobExclusions{VMs: spec.VirtualMachines.Excludes.VMs},
+		},
+		Storage: spec.Storage,
+	}
+	r.syncVMJobFromAPI(data, api)
+
+	assert.Equal(t, "CloudDirectorBackup", data.Type.ValueString())
+	assert.Equal(t, "vApp", data.VirtualMachines.Includes[2].Type.ValueString())
+	assert.Equal(t, "vcd.sp.local", data.VirtualMachines.Includes[0].HostName.ValueString())
+	assert.Equal(t, "CloudDirector", data.VirtualMachines.Excludes.VMs[0].Platform.ValueString())
+}
+
+func TestBackupJob_ValidateVMObjects(t *testing.T) {
+	job := func(jobType, platform, objType string) *BackupJobModel {
+		return &BackupJobModel{
+			Type: types.StringValue(jobType),
+			VirtualMachines: &VMBackupScope{Includes: []VMIncludeEntry{{
+				Platform: types.StringValue(platform),
+				Type:     types.StringValue(objType),
+				Name:     types.StringValue("obj"),
+			}}},
+		}
+	}
+
+	assert.NoError(t, validateVMObjects(job("VSphereBackup", "VSphere", "Folder")))
+	assert.NoError(t, validateVMObjects(job("HyperVBackup", "HyperV", "HostGroup")))
+	assert.NoError(t, validateVMObjects(&BackupJobModel{
+		Type: types.StringValue("HyperVBackup"),
+		VirtualMachines: &VMBackupScope{Includes: []VMIncludeEntry{{
+			Platform: types.StringValue("HyperV"),
+			Type:     types.StringUnknown(),
+			Name:     types.StringValue("vm01"),
+		}}},
+	}), "an omitted type is inferred by the API")
+
+	assert.ErrorContains(t, validateVMObjects(job("HyperVBackup", "VSphere", "VirtualMachine")), `expected "HyperV"`)
+	assert.ErrorContains(t, validateVMObjects(job("VSphereBackup", "HyperV", "VirtualMachine")), `expected "VSphere"`)
+	assert.ErrorContains(t, validateVMObjects(job("HyperVBackup", "HyperV", "Datacenter")), "unsupported HyperV object type")
+	assert.ErrorContains(t, validateVMObjects(job("VSphereBackup", "VSphere", "SCVMM")), "unsupported VSphere object type")
+	assert.NoError(t, validateVMObjects(job("CloudDirectorBackup", "CloudDirector", "OrganizationVDC")))
+	assert.ErrorContains(t, validateVMObjects(job("CloudDirectorBackup", "VSphere", "VirtualApp")), `expected "CloudDirector"`)
+	assert.ErrorContains(t, validateVMObjects(job("CloudDirectorBackup", "CloudDirector", "Folder")),
+		"unsupported CloudDirector object type")
+
+	overrides := job("HyperVBackup", "HyperV", "Cluster")
+	overrides.GuestProcessing = &JobGuestProcessing{ObjectOverrides: []JobGuestObjectOverride{{
+		Platform: types.StringValue("VSphere"),
+		Type:     types.StringValue("VirtualMachine"),
+		Name:     types.StringValue("sql01"),
+	}}}
+	assert.ErrorContains(t, validateVMObjects(overrides), "guest_processing.object_overrides[0] (sql01)")
+
+	hyperVOnVSphere := job("VSphereBackup", "VSphere", "VirtualMachine")
+	hyperVOnVSphere.Storage = &JobStorageSettings{}
+	hyperVOnVSphere.AdvancedSettings = &JobAdvancedSettings{HyperV: &JobHyperVSettings{
+		GuestQuiescence: types.BoolValue(false), CrashConsistent: types.BoolValue(false),
+	}}
+	assert.ErrorContains(t, validateAdvancedSettings(hyperVOnVSphere), "only supported for HyperVBackup")
+
+	crashOnly := job("HyperVBackup", "HyperV", "VirtualMachine")
+	crashOnly.Storage = &JobStorageSettings{}
+	crashOnly.AdvancedSettings = &JobAdvancedSettings{HyperV: &JobHyperVSettings{
+		GuestQuiescence: types.BoolValue(false), CrashConsistent: types.BoolValue(true),
+	}}
+	assert.ErrorContains(t, validateAdvancedSettings(crashOnly), "requires guest_quiescence")
+}
+
+func TestBackupJob_AdvancedStorageSettings(t *testing.T) {
+	r := &BackupJob{}
+
+	data := &BackupJobModel{
+		Name: types.StringValue("Job"),
+		Type: types.StringValue("VSphereBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{Platform: types.StringValue("VSphere"), Name: types.StringValue("vm01")}},
+		},
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1"), ProxyAutoSelect: types.BoolValue(true)},
+		AdvancedSettings: &JobAdvancedSettings{Storage: &JobAdvancedStorage{
+			CompressionLevel:         types.StringValue("High"),
+			StorageOptimization:      types.StringUnknown(),
+			InlineDedupeEnabled:      types.BoolValue(false),
+			ExcludeSwapFileBlocks:    types.BoolValue(true),
+			ExcludeDeletedFileBlocks: types.BoolValue(false),
+			Encryption: &JobStorageEncryption{
+				EncryptionPasswordID: types.StringValue("pwd-1"),
+				KMSServerID:          types.StringNull(),
+			},
+		}},
+	}
+	require.NoError(t, validateAdvancedSettings(data))
+
+	spec := r.buildVMJobSpec(data)
+	require.NotNil(t, spec.Storage.AdvancedSettings)
+	sd := spec.Storage.AdvancedSettings.StorageData
+	require.NotNil(t, sd)
+	assert.Equal(t, models.CompressionHigh, sd.CompressionLevel)
+	assert.Empty(t, sd.StorageOptimization, "unknown storage_optimization must be left to the server")
+	assert.False(t, sd.InlineDataDedupEnabled)
+	assert.True(t, sd.ExcludeSwapFileBlocks)
+	assert.Equal(t, &models.BackupStorageEncryptionModel{IsEnabled: true, EncryptionPasswordID: "pwd-1"}, sd.Encryption)
+
+	// Disabled switches must reach the API, or the merged PUT keeps the server values.
+	payload, err := toJSONMap(spec)
+	require.NoError(t, err)
+	storageData := payload["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})["storageData"].(map[string]interface{})
+	assert.Equal(t, false, storageData["inlineDataDedupEnabled"])
+	assert.NotContains(t, payload["storage"].(map[string]interface{})["advancedSettings"], "backupModeType")
+
+	// Round-trip through the response model.
+	api := &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Name: "Job", Type: models.JobTypeVSphereBackup},
+		Storage:  spec.Storage,
+	}
+	api.Storage.AdvancedSettings.StorageData.StorageOptimization = models.StorageOptimization1MB
+	r.syncVMJobFromAPI(data, api)
+
+	got := data.AdvancedSettings.Storage
+	assert.Equal(t, "High", got.CompressionLevel.ValueString())
+	assert.Equal(t, "1MB", got.StorageOptimization.ValueString())
+	assert.False(t, got.InlineDedupeEnabled.ValueBool())
+	require.NotNil(t, got.Encryption)
+	assert.Equal(t, "pwd-1", got.Encryption.EncryptionPasswordID.ValueString())
+	assert.True(t, got.Encryption.KMSServerID.IsNull())
+}
+
+func TestBackupJob_AdvancedStorageSettings_NotTrackedWhenOmitted(t *testing.T) {
+	r := &BackupJob{}
+	data := &BackupJobModel{Type: types.StringValue("VSphereBackup")}
+
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Type: models.JobTypeVSphereBackup},
+		Storage: &models.BackupJobStorageModel{
+			BackupRepositoryID: "repo-1",
+			AdvancedSettings: &models.BackupJobAdvancedSettingsModel{
+				StorageData: &models.BackupStorageSettingModel{CompressionLevel: models.CompressionOptimal},
+			},
+		},
+	})
+
+	assert.Nil(t, data.AdvancedSettings)
+}
+
+func TestBackupJob_AdvancedStorageSettings_EncryptionDisabled(t *testing.T) {
+	sd := buildStorageDataModel(&JobAdvancedStorage{
+		CompressionLevel:    types.StringNull(),
+		StorageOptimization: types.StringNull(),
+	})
+	require.NotNil(t, sd.Encryption)
+	assert.False(t, sd.Encryption.IsEnabled)
+
+	synced := syncStorageDataFromAPI(sd)
+	assert.Nil(t, synced.Encryption)
+}
+
+func TestBackupJob_ValidateAdvancedSettings(t *testing.T) {
+	data := &BackupJobModel{
+		Storage:          &JobStorageSettings{},
+		AdvancedSettings: &JobAdvancedSettings{Storage: &JobAdvancedStorage{}},
+	}
+	assert.NoError(t, validateAdvancedSettings(&BackupJobModel{}))
+	assert.NoError(t, validateAdvancedSettings(data))
+
+	data.Storage = nil
+	assert.ErrorContains(t, validateAdvancedSettings(data), "requires the storage block")
+}
+
+func TestEncryptionKeySourceValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{"encryption_password_id": types.StringType, "kms_server_id": types.StringType}
+	tests := []struct {
+		name     string
+		password types.String
+		kms      types.String
+		wantErr  bool
+	}{
+		{"password", types.StringValue("pwd-1"), types.StringNull(), false},
+		{"kms", types.StringNull(), types.StringValue("kms-1"), false},
+		{"both", types.StringValue("pwd-1"), types.StringValue("kms-1"), true},
+		{"neither", types.StringNull(), types.StringNull(), true},
+		{"unknown until apply", types.StringUnknown(), types.StringNull(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"encryption_password_id": tt.password,
+				"kms_server_id":          tt.kms,
+			})
+			resp := &validator.ObjectResponse{}
+			encryptionKeySourceValidator{}.ValidateObject(context.Background(),
+				validator.ObjectRequest{Path: path.Root("encryption"), ConfigValue: value}, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+// TestBackupJob_Update_SwitchesEncryptionKeySource verifies that moving from
+// an encryption password to a KMS server drops the password ID from the
+// merged PUT, and that an unconfigured storage section keeps it.
+func TestBackupJob_Update_SwitchesEncryptionKeySource(t *testing.T) {
+	r := &BackupJob{}
+	current := func() map[string]interface{} {
+		return map[string]interface{}{
+			"storage": map[string]interface{}{
+				"advancedSettings": map[string]interface{}{
+					"storageData": map[string]interface{}{
+						"compressionLevel": "Optimal",
+						"encryption":       map[string]interface{}{"isEnabled": true, "encryptionPasswordId": "pwd-1"},
+					},
+				},
+			},
+		}
+	}
+	encryption := func(m map[string]interface{}) map[string]interface{} {
+		return m["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})["storageData"].(map[string]interface{})["encryption"].(map[string]interface{})
+	}
+
+	data := validVSphereJob()
+	data.Storage = &JobStorageSettings{RepositoryID: types.StringValue("repo-1")}
+	data.AdvancedSettings = &JobAdvancedSettings{Storage: &JobAdvancedStorage{
+		Encryption: &JobStorageEncryption{EncryptionPasswordID: types.StringNull(), KMSServerID: types.StringValue("kms-1")},
+	}}
+	merged, err := mergeManagedPayload(current(), r.buildVMJobModel(&data, false),
+		jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"isEnabled": true, "kmsServerId": "kms-1"}, encryption(merged))
+
+	data.AdvancedSettings = nil
+	merged, err = mergeManagedPayload(current(), r.buildVMJobModel(&data, false),
+		jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...)
+	require.NoError(t, err)
+	assert.Equal(t, "pwd-1", encryption(merged)["encryptionPasswordId"], "unmanaged section is kept")
+}
+
+// TestBackupJob_Update_RemovesHyperVSettings verifies that removing hyper_v
+// from advanced_settings drops the server's Hyper-V settings, while a job
+// without advanced_settings keeps them.
+func TestBackupJob_Update_RemovesHyperVSettings(t *testing.T) {
+	r := &BackupJob{}
+	current := func() map[string]interface{} {
+		return map[string]interface{}{
+			"storage": map[string]interface{}{
+				"advancedSettings": map[string]interface{}{
+					"backupModeType": "Incremental",
+					"hyperV":         map[string]interface{}{"guestQuiescence": true, "changedBlockTracking": true},
+				},
+			},
+		}
+	}
+	advanced := func(m map[string]interface{}) map[string]interface{} {
+		return m["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})
+	}
+
+	data := validVSphereJob()
+	data.Type = types.StringValue("HyperVBackup")
+	data.Storage = &JobStorageSettings{RepositoryID: types.StringValue("repo-1")}
+	data.AdvancedSettings = &JobAdvancedSettings{Backup: &JobAdvancedBackup{Mode: types.StringValue("Incremental")}}
+	merged, err := mergeManagedPayload(current(), r.buildVMJobModel(&data, false),
+		jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...)
+	require.NoError(t, err)
+	assert.NotContains(t, advanced(merged), "hyperV")
+
+	data.AdvancedSettings = nil
+	merged, err = mergeManagedPayload(current(), r.buildVMJobModel(&data, false),
+		jobManagedPaths(vmJobManagedPaths, data.AdvancedSettings)...)
+	require.NoError(t, err)
+	assert.Contains(t, advanced(merged), "hyperV", "unmanaged section is kept")
+}
+
+func TestBackupJob_SyncAgentFromAPI_AdvancedStorageSettings(t *testing.T) {
+	r := &BackupJob{}
+	data := &BackupJobModel{
+		Type:             types.StringValue("WindowsAgentBackup"),
+		Storage:          &JobStorageSettings{RepositoryID: types.StringValue("repo-1")},
+		AdvancedSettings: &JobAdvancedSettings{Storage: &JobAdvancedStorage{}},
+	}
+
+	r.syncAgentJobFromAPIMap(data, map[string]interface{}{
+		"type": "WindowsAgentBackup",
+		"storage": map[string]interface{}{
+			"backupRepositoryId": "repo-1",
+			"advancedSettings": map[string]interface{}{
+				"storageData": map[string]interface{}{
+					"compressionLevel":       "Extreme",
+					"inlineDataDedupEnabled": true,
+					"encryption":             map[string]interface{}{"isEnabled": true, "kmsServerId": "kms-1"},
+				},
+			},
+		},
+	})
+
+	got := data.AdvancedSettings.Storage
+	assert.Equal(t, "Extreme", got.CompressionLevel.ValueString())
+	assert.True(t, got.InlineDedupeEnabled.ValueBool())
+	require.NotNil(t, got.Encryption)
+	assert.Equal(t, "kms-1", got.Encryption.KMSServerID.ValueString())
+}
+
+func TestBackupJob_AdvancedBackupSettings(t *testing.T) {
+	r := &BackupJob{}
+	days := func(d ...string) types.List {
+		vals := make([]attr.Value, 0, len(d))
+		for _, v := range d {
+			vals = append(vals, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, vals)
+	}
+
+	data := &BackupJobModel{
+		Name:    types.StringValue("Job"),
+		Type:    types.StringValue("LinuxAgentBackup"),
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1")},
+		AdvancedSettings: &JobAdvancedSettings{Backup: &JobAdvancedBackup{
+			Mode: types.StringValue("Incremental"),
+			HealthCheck: &JobAdvancedSchedule{
+				Weekly: &JobAdvancedScheduleWeekly{Days: days("Saturday")},
+			},
+			CompactFull: &JobAdvancedSchedule{
+				Monthly: &JobAdvancedScheduleMonthly{
+					DayNumberInMonth: types.StringValue("Last"),
+					DayOfWeek:        types.StringValue("Sunday"),
+					DayOfMonth:       types.Int64Null(),
+					Months:           types.ListUnknown(types.StringType),
+				},
+			},
+		}},
+	}
+	require.NoError(t, validateAdvancedSettings(data))
+
+	spec := r.buildAgentJobSpec(data)
+	advanced := spec["storage"].(*models.AgentBackupJobStorageModel).AdvancedSettings
+	require.NotNil(t, advanced)
+	assert.Equal(t, models.BackupModeIncremental, advanced.BackupModeType)
+	assert.Nil(t, advanced.StorageData, "storage data stays unmanaged without advanced_settings.storage")
+
+	// Forever incremental: omitted fulls are sent disabled.
+	assert.Equal(t, &models.AdvancedStorageScheduleModel{IsEnabled: false}, advanced.SyntheticFulls)
+	assert.Equal(t, &models.AdvancedStorageScheduleModel{IsEnabled: false}, advanced.ActiveFulls)
+
+	require.True(t, advanced.BackupHealth.IsEnabled)
+	assert.Equal(t, []models.EDayOfWeek{models.DaySaturday}, advanced.BackupHealth.Weekly.Days)
+	assert.False(t, advanced.BackupHealth.Monthly.IsEnabled)
+
+	compact := advanced.FullBackupMaintenance.DefragmentAndCompact
+	require.True(t, compact.IsEnabled)
+	assert.False(t, compact.Weekly.IsEnabled)
+	assert.Equal(t, models.DayNumberLast, compact.Monthly.DayNumberInMonth)
+	assert.Empty(t, compact.Monthly.Months)
+
+	// Round-trip through the agent response map.
+	payload, err := toJSONMap(spec)
+	require.NoError(t, err)
+	compactRaw := payload["storage"].(map[string]interface{})["advancedSettings"].(map[string]interface{})["fullBackupMaintenance"].(map[string]interface{})["defragmentAndCompact"].(map[string]interface{})
+	compactRaw["monthly"].(map[string]interface{})["months"] = []interface{}{"January", "July"}
+	r.syncAgentJobFromAPIMap(data, payload)
+
+	got := data.AdvancedSettings.Backup
+	assert.Equal(t, "Incremental", got.Mode.ValueString())
+	assert.Nil(t, got.SyntheticFull)
+	assert.Nil(t, got.ActiveFull)
+	require.NotNil(t, got.HealthCheck)
+	assert.Equal(t, days("Saturday"), got.HealthCheck.Weekly.Days)
+	assert.Nil(t, got.HealthCheck.Monthly)
+	require.NotNil(t, got.CompactFull)
+	assert.Equal(t, "Sunday", got.CompactFull.Monthly.DayOfWeek.ValueString())
+	assert.True(t, got.CompactFull.Monthly.DayOfMonth.IsNull())
+	assert.Equal(t, days("January", "July"), got.CompactFull.Monthly.Months)
+}
+
+func TestBackupJob_ValidateAdvancedSchedule(t *testing.T) {
+	weekly := &JobAdvancedScheduleWeekly{Days: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Friday")})}
+	monthly := &JobAdvancedScheduleMonthly{
+		DayNumberInMonth: types.StringValue("OnDay"),
+		DayOfWeek:        types.StringNull(),
+		DayOfMonth:       types.Int64Value(1),
+	}
+
+	assert.NoError(t, validateAdvancedSchedule(nil))
+	assert.NoError(t, validateAdvancedSchedule(&JobAdvancedSchedule{Weekly: weekly}))
+	assert.NoError(t, validateAdvancedSchedule(&JobAdvancedSchedule{Monthly: monthly}))
+	assert.ErrorContains(t, validateAdvancedSchedule(&JobAdvancedSchedule{}), "exactly one of weekly or monthly")
+	assert.ErrorContains(t, validateAdvancedSchedule(&JobAdvancedSchedule{Weekly: weekly, Monthly: monthly}), "exactly one of weekly or monthly")
+	assert.ErrorContains(t, validateAdvancedSchedule(&JobAdvancedSchedule{
+		Weekly: &JobAdvancedScheduleWeekly{Days: types.ListValueMust(types.StringType, []attr.Value{})},
+	}), "at least one day")
+
+	monthly.DayOfMonth = types.Int64Null()
+	assert.ErrorContains(t, validateAdvancedSchedule(&JobAdvancedSchedule{Monthly: monthly}), "day_of_month is required")
+	monthly.DayNumberInMonth = types.StringValue("First")
+	assert.ErrorContains(t, validateAdvancedSchedule(&JobAdvancedSchedule{Monthly: monthly}), "day_of_week is required")
+
+	data := &BackupJobModel{
+		Storage: &JobStorageSettings{},
+		AdvancedSettings: &JobAdvancedSettings{Backup: &JobAdvancedBackup{
+			ActiveFull: &JobAdvancedSchedule{},
+		}},
+	}
+	assert.ErrorContains(t, validateAdvancedSettings(data), "advanced_settings.backup.active_full")
+}
+
+func TestBackupJob_AdvancedNotifications(t *testing.T) {
+	r := &BackupJob{}
+
+	data := &BackupJobModel{
+		Name: types.StringValue("Job"),
+		Type: types.StringValue("VSphereBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{Platform: types.StringValue("VSphere"), Name: types.StringValue("vm01")}},
+		},
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1")},
+		AdvancedSettings: &JobAdvancedSettings{Notifications: &JobNotifications{
+			SNMPEnabled: types.BoolValue(false),
+			Email: &JobEmailNotifications{
+				Recipients:             types.ListValueMust(types.StringType, []attr.Value{types.StringValue("app-team@example.com")}),
+				UseGlobalSettings:      types.BoolValue(false),
+				Subject:                types.StringValue("[%JobResult%] %JobName%"),
+				NotifyOnSuccess:        types.BoolValue(false),
+				NotifyOnWarning:        types.BoolValue(true),
+				NotifyOnError:          types.BoolValue(true),
+				SuppressUntilLastRetry: types.BoolValue(true),
+			},
+		}},
+	}
+
+	spec := r.buildVMJobSpec(data)
+	n := spec.Storage.AdvancedSettings.Notifications
+	require.NotNil(t, n)
+	assert.False(t, n.SendSNMPNotifications)
+	require.NotNil(t, n.EmailNotifications)
+	assert.True(t, n.EmailNotifications.IsEnabled)
+	assert.Equal(t, []string{"app-team@example.com"}, n.EmailNotifications.Recipients)
+	assert.Equal(t, models.EmailNotificationUseCustom, n.EmailNotifications.NotificationType)
+	assert.Equal(t, &models.EmailCustomNotificationSettingsModel{
+		Subject:                            "[%JobResult%] %JobName%",
+		NotifyOnWarning:                    true,
+		NotifyOnError:                      true,
+		SuppressNotificationUntilLastRetry: true,
+	}, n.EmailNotifications.CustomNotificationSettings)
+	assert.Nil(t, spec.Storage.AdvancedSettings.StorageData)
+
+	// Round-trip through the response model.
+	synced := &BackupJobModel{AdvancedSettings: &JobAdvancedSettings{Notifications: &JobNotifications{}}}
+	r.syncVMJobFromAPI(synced, &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Type: models.JobTypeVSphereBackup},
+		Storage:  spec.Storage,
+	})
+	assert.Equal(t, data.AdvancedSettings.Notifications, synced.AdvancedSettings.Notifications)
+}
+
+func TestBackupJob_AdvancedNotifications_GlobalAndDisabled(t *testing.T) {
+	n := buildNotificationsModel(&JobNotifications{SNMPEnabled: types.BoolValue(true)})
+	assert.True(t, n.SendSNMPNotifications)
+	assert.Equal(t, &models.EmailNotificationSettingsModel{IsEnabled: false}, n.EmailNotifications)
+	assert.Nil(t, syncNotificationsFromAPI(&JobNotifications{}, n).Email)
+
+	email := &JobEmailNotifications{
+		Recipients:             types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ops@example.com")}),
+		UseGlobalSettings:      types.BoolValue(true),
+		Subject:                types.StringUnknown(),
+		NotifyOnSuccess:        types.BoolValue(true),
+		NotifyOnWarning:        types.BoolValue(true),
+		NotifyOnError:          types.BoolValue(true),
+		SuppressUntilLastRetry: types.BoolValue(false),
+	}
+	n = buildNotificationsModel(&JobNotifications{SNMPEnabled: types.BoolValue(false), Email: email})
+	assert.Equal(t, models.EmailNotificationUseGlobal, n.EmailNotifications.NotificationType)
+	assert.Nil(t, n.EmailNotifications.CustomNotificationSettings)
+
+	// Configured triggers are kept when the API only reports global settings.
+	synced := syncNotificationsFromAPI(&JobNotifications{Email: email}, n)
+	require.NotNil(t, synced.Email)
+	assert.True(t, synced.Email.UseGlobalSettings.ValueBool())
+	assert.False(t, synced.Email.SuppressUntilLastRetry.ValueBool())
+}
+
+// validateProxySelection runs proxySelectionValidator against a config in
+// which only storage.proxy_auto_select and storage.proxy_ids are set.
+func validateProxySelection(t *testing.T, autoSelect types.Bool, proxyIDs types.List) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewBackupJob().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    nullObjectForResourceSchema(schemaResp.Schema.Type().TerraformType(ctx)),
+	}
+	require.False(t, state.SetAttribute(ctx, path.Root("storage").AtName("proxy_auto_select"), autoSelect).HasError())
+	require.False(t, state.SetAttribute(ctx, path.Root("storage").AtName("proxy_ids"), proxyIDs).HasError())
+
+	req := validator.BoolRequest{
+		Path:        path.Root("storage").AtName("proxy_auto_select"),
+		ConfigValue: autoSelect,
+		Config:      tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+	}
+	var resp validator.BoolResponse
+	proxySelectionValidator{}.ValidateBool(ctx, req, &resp)
+	return resp.Diagnostics
+}
+
+func TestBackupJob_ProxySelectionValidator(t *testing.T) {
+	proxies := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proxy-1")})
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+	none := types.ListNull(types.StringType)
+
+	assert.False(t, validateProxySelection(t, types.BoolNull(), none).HasError())
+	assert.False(t, validateProxySelection(t, types.BoolValue(false), proxies).HasError())
+	assert.False(t, validateProxySelection(t, types.BoolValue(false), types.ListUnknown(types.StringType)).HasError())
+
+	diags := validateProxySelection(t, types.BoolValue(false), empty)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "at least one veeam_proxy")
+	assert.True(t, validateProxySelection(t, types.BoolValue(false), none).HasError())
+
+	diags = validateProxySelection(t, types.BoolNull(), proxies)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "proxy_auto_select = false")
+}
+
+func TestBackupJob_ManualProxies(t *testing.T) {
+	r := &BackupJob{}
+	storage := &JobStorageSettings{
+		RepositoryID:    types.StringValue("repo-1"),
+		ProxyAutoSelect: types.BoolValue(false),
+		ProxyIDs:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("proxy-1"), types.StringValue("proxy-2")}),
+	}
+
+	m := r.buildStorageModel(storage)
+	assert.False(t, m.BackupProxies.AutoSelectEnabled)
+	assert.Equal(t, []string{"proxy-1", "proxy-2"}, m.BackupProxies.ProxyIDs)
+
+	data := &BackupJobModel{}
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{
+		JobModel: models.JobModel{ID: "job-1", Type: models.JobTypeVSphereBackup},
+		Storage:  m,
+	})
+	assert.Equal(t, storage.ProxyIDs, data.Storage.ProxyIDs)
+
+	storage.ProxyAutoSelect = types.BoolValue(true)
+	m = r.buildStorageModel(storage)
+	assert.Empty(t, m.BackupProxies.ProxyIDs, "proxies are only sent with manual selection")
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{Storage: m})
+	assert.True(t, data.Storage.ProxyIDs.IsNull())
+}
+
+func TestBackupJob_BackupWindow(t *testing.T) {
+	r := &BackupJob{}
+	hours := func(h ...int64) types.Set {
+		vals := make([]attr.Value, 0, len(h))
+		for _, v := range h {
+			vals = append(vals, types.Int64Value(v))
+		}
+		return types.SetValueMust(types.Int64Type, vals)
+	}
+	night := hours(22, 23, 0, 1, 2, 3, 4, 5)
+
+	schedule := &JobScheduleSettings{
+		RunAutomatically: types.BoolValue(true),
+		BackupWindow: &JobBackupWindow{Days: []JobBackupWindowDay{
+			{Day: types.StringValue("Monday"), Hours: night},
+			{Day: types.StringValue("Sunday"), Hours: hours(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23)},
+		}},
+	}
+	require.NoError(t, validateBackupWindow(schedule))
+
+	m := buildScheduleModel(schedule)
+	require.NotNil(t, m.BackupWindow)
+	assert.True(t, m.BackupWindow.IsEnabled)
+	days := m.BackupWindow.BackupWindow.Days
+	require.Len(t, days, 7)
+	assert.Equal(t, models.DaySunday, days[0].Day)
+	assert.Equal(t, strings.TrimSuffix(strings.Repeat("1,", 24), ","), days[0].Hours)
+	assert.Equal(t, models.DayMonday, days[1].Day)
+	assert.Equal(t, "1,1,1,1,1,1,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,0,1,1", days[1].Hours)
+	assert.Equal(t, strings.TrimSuffix(strings.Repeat("0,", 24), ","), days[2].Hours)
+
+	// Denied days are dropped and the configured day order is kept.
+	synced := syncScheduleFromAPI(schedule, m)
+	require.NotNil(t, synced.BackupWindow)
+	require.Len(t, synced.BackupWindow.Days, 2)
+	assert.Equal(t, "Monday", synced.BackupWindow.Days[0].Day.ValueString())
+	assert.True(t, night.Equal(synced.BackupWindow.Days[0].Hours), "hours past midnight read back unchanged")
+	assert.Equal(t, "Sunday", synced.BackupWindow.Days[1].Day.ValueString())
+
+	// Agent jobs decode the window from the raw response.
+	raw, err := toJSONMap(m)
+	require.NoError(t, err)
+	synced = r.syncScheduleFromAPIMap(&JobScheduleSettings{}, raw)
+	require.NotNil(t, synced.BackupWindow)
+	assert.Equal(t, "Sunday", synced.BackupWindow.Days[0].Day.ValueString())
+
+	schedule.BackupWindow = nil
+	assert.Nil(t, buildScheduleModel(schedule).BackupWindow)
+	assert.Nil(t, r.syncScheduleFromAPIMap(&JobScheduleSettings{}, map[string]interface{}{}).BackupWindow)
+}
+
+func TestBackupJob_ValidateBackupWindow(t *testing.T) {
+	window := func(days ...JobBackupWindowDay) *JobScheduleSettings {
+		return &JobScheduleSettings{BackupWindow: &JobBackupWindow{Days: days}}
+	}
+	hour := func(h int64) types.Set {
+		return types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(h)})
+	}
+
+	assert.NoError(t, validateBackupWindow(nil))
+	assert.ErrorContains(t, validateBackupWindow(window(JobBackupWindowDay{Day: types.StringValue("Mon"), Hours: hour(1)})), "unsupported day")
+	assert.ErrorContains(t, validateBackupWindow(window(
+		JobBackupWindowDay{Day: types.StringValue("Monday"), Hours: hour(1)},
+		JobBackupWindowDay{Day: types.StringValue("Monday"), Hours: hour(2)},
+	)), "more than once")
+	assert.ErrorContains(t, validateBackupWindow(window(JobBackupWindowDay{Day: types.StringValue("Friday"), Hours: hour(24)})), "outside 0–23")
+	assert.ErrorContains(t, validateBackupWindow(window(JobBackupWindowDay{
+		Day: types.StringValue("Friday"), Hours: types.SetValueMust(types.Int64Type, []attr.Value{}),
+	})), "at least one hour")
+}
+
+func TestBackupJob_GuestObjectOverrides(t *testing.T) {
+	r := &BackupJob{}
+	sqlVM := JobGuestObjectOverride{
+		Platform:           types.StringValue("VSphere"),
+		Type:               types.StringValue("VirtualMachine"),
+		HostName:           types.StringValue("vcenter.example.com"),
+		Name:               types.StringValue("sql-01"),
+		ObjectID:           types.StringValue("vm-101"),
+		CredentialsID:      types.StringValue("creds-sql"),
+		LinuxCredentialsID: types.StringNull(),
+		AppAware: &JobGuestAppAware{
+			VSS:             types.StringValue("RequireSuccess"),
+			TransactionLogs: types.StringValue("Process"),
+			SQL: &JobGuestSQL{
+				LogsProcessing:        types.StringValue("BackupLogsPeriodically"),
+				BackupIntervalMinutes: types.Int64Value(15),
+				RetainLogBackups:      types.StringValue("KeepOnlyLastDays"),
+				RetainDays:            types.Int64Value(7),
+			},
+		},
+		Scripts: &JobGuestScripts{
+			Mode:                   types.StringValue("RequireSuccess"),
+			WindowsPreFreezeScript: types.StringValue(`C:\scripts\freeze.cmd`),
+			WindowsPostThawScript:  types.StringValue(`C:\scripts\thaw.cmd`),
+			LinuxPreFreezeScript:   types.StringNull(),
+			LinuxPostThawScript:    types.StringNull(),
+		},
+	}
+	fileVM := JobGuestObjectOverride{
+		Platform:           types.StringValue("VSphere"),
+		Type:               types.StringValue("VirtualMachine"),
+		HostName:           types.StringValue("vcenter.example.com"),
+		Name:               types.StringValue("files-01"),
+		ObjectID:           types.StringValue("vm-202"),
+		CredentialsID:      types.StringNull(),
+		LinuxCredentialsID: types.StringValue("creds-linux"),
+		Indexing: &JobGuestIndexing{
+			Mode:  types.StringValue("IndexOnly"),
+			Paths: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/srv/share")}),
+		},
+	}
+	gp := &JobGuestProcessing{
+		AppAwareEnabled:            types.BoolValue(true),
+		FSIndexingEnabled:          types.BoolValue(true),
+		InteractionProxyAutoSelect: types.BoolValue(true),
+		ObjectOverrides:            []JobGuestObjectOverride{sqlVM, fileVM},
+	}
+	require.NoError(t, validateGuestObjectOverrides(gp))
+
+	m := r.buildGuestProcessingModel(gp)
+	require.Len(t, m.AppAwareProcessing.AppSettings, 1)
+	app := m.AppAwareProcessing.AppSettings[0]
+	assert.Equal(t, "vm-101", app.VMObject.ObjectID)
+	assert.Equal(t, models.SQLLogsBackupPeriodically, app.SQL.LogsProcessing)
+	assert.Equal(t, 7, app.SQL.KeepDaysCount)
+	assert.Nil(t, app.Oracle)
+	require.NotNil(t, app.Scripts.WindowsScripts)
+	assert.Nil(t, app.Scripts.LinuxScripts)
+
+	require.Len(t, m.GuestFSIndexing.IndexingSettings, 1)
+	assert.Equal(t, models.GuestFSIndexingIndexOnly, m.GuestFSIndexing.IndexingSettings[0].LinuxIndexing.GuestFSIndexingMode)
+	assert.Equal(t, []string{"/srv/share"}, m.GuestFSIndexing.IndexingSettings[0].WindowsIndexing.IndexingList)
+
+	// Per-machine credentials are sent without job-wide credentials.
+	require.NotNil(t, m.GuestCredentials)
+	assert.Empty(t, m.GuestCredentials.CredentialsID)
+	require.Len(t, m.GuestCredentials.CredentialsPerMachine, 2)
+	assert.Equal(t, "creds-linux", m.GuestCredentials.CredentialsPerMachine[1].LinuxCredsID)
+
+	// Round-trip keeps configured order and values, and no job-wide
+	// guest_credentials block appears.
+	data := &BackupJobModel{GuestProcessing: &JobGuestProcessing{ObjectOverrides: []JobGuestObjectOverride{fileVM, sqlVM}}}
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{GuestProcessing: m})
+	require.Nil(t, data.GuestProcessing.GuestCredentials)
+	require.Len(t, data.GuestProcessing.ObjectOverrides, 2)
+	assert.Equal(t, fileVM, data.GuestProcessing.ObjectOverrides[0])
+	assert.Equal(t, sqlVM, data.GuestProcessing.ObjectOverrides[1])
+
+	// An override added in the console shows up as drift.
+	m.AppAwareProcessing.AppSettings = append(m.AppAwareProcessing.AppSettings, models.BackupApplicationSettingsModel{
+		VMObject:        models.InventoryObjectSpec{Platform: "VSphere", Name: "ora-01", ObjectID: "vm-303"},
+		VSS:             models.ApplicationVSSIgnoreFailures,
+		TransactionLogs: models.TransactionLogsProcess,
+		Oracle: &models.BackupOracleSettingsModel{
+			UseGuestCredentials: true,
+			ArchiveLogs:         models.ArchiveLogsDeleteOverGB,
+			DeleteGBsCount:      10,
+		},
+	})
+	r.syncVMJobFromAPI(data, &models.BackupJobModel{GuestProcessing: m})
+	require.Len(t, data.GuestProcessing.ObjectOverrides, 3)
+	ora := data.GuestProcessing.ObjectOverrides[2]
+	assert.Equal(t, "vm-303", ora.ObjectID.ValueString())
+	require.NotNil(t, ora.AppAware.Oracle)
+	assert.True(t, ora.AppAware.Oracle.CredentialsID.IsNull())
+	assert.Equal(t, int64(10), ora.AppAware.Oracle.DeleteOverGB.ValueInt64())
+	assert.Nil(t, ora.Scripts)
+
+	// Removing every override clears the API lists.
+	gp.ObjectOverrides = nil
+	m = r.buildGuestProcessingModel(gp)
+	assert.Empty(t, m.AppAwareProcessing.AppSettings)
+	assert.Nil(t, m.GuestCredentials)
+	payload, err := mergeManagedPayload(map[string]interface{}{
+		"guestProcessing": map[string]interface{}{
+			"appAwareProcessing": map[string]interface{}{"isEnabled": true, "appSettings": []interface{}{"x"}},
+			"guestFSIndexing":    map[string]interface{}{"isEnabled": true, "indexingSettings": []interface{}{"x"}},
+		},
+	}, &models.BackupJobModel{GuestProcessing: m}, vmJobManagedPaths...)
+	require.NoError(t, err)
+	gpRaw := payload["guestProcessing"].(map[string]interface{})
+	assert.NotContains(t, gpRaw["appAwareProcessing"], "appSettings")
+	assert.NotContains(t, gpRaw["guestFSIndexing"], "indexingSettings")
+}
+
+func TestBackupJob_ValidateGuestObjectOverrides(t *testing.T) {
+	override := func(mut func(o *JobGuestObjectOverride)) *JobGuestProcessing {
+		o := JobGuestObjectOverride{
+			ObjectID:           types.StringValue("vm-1"),
+			CredentialsID:      types.StringNull(),
+			LinuxCredentialsID: types.StringNull(),
+		}
+		mut(&o)
+		return &JobGuestProcessing{ObjectOverrides: []JobGuestObjectOverride{o}}
+	}
+	sql := func(mode string) *JobGuestSQL {
+		return &JobGuestSQL{
+			LogsProcessing:        types.StringValue(mode),
+			BackupIntervalMinutes: types.Int64Unknown(),
+			RetainLogBackups:      types.StringUnknown(),
+			RetainDays:            types.Int64Unknown(),
+		}
+	}
+
+	assert.NoError(t, validateGuestObjectOverrides(nil))
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {})), "at least one of")
+
+	dup := override(func(o *JobGuestObjectOverride) { o.CredentialsID = types.StringValue("c") })
+	dup.ObjectOverrides = append(dup.ObjectOverrides, dup.ObjectOverrides[0])
+	assert.ErrorContains(t, validateGuestObjectOverrides(dup), "more than once")
+
+	// Omitted Optional+Computed values are unknown at apply time.
+	assert.NoError(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.AppAware = &JobGuestAppAware{VSS: types.StringUnknown(), TransactionLogs: types.StringUnknown(), SQL: sql("TruncateLogs")}
+	})))
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		s := sql("TruncateLogs")
+		s.BackupIntervalMinutes = types.Int64Value(30)
+		o.AppAware = &JobGuestAppAware{SQL: s}
+	})), "require logs_processing")
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		s := sql("BackupLogsPeriodically")
+		s.RetainLogBackups = types.StringValue("KeepOnlyLastDays")
+		o.AppAware = &JobGuestAppAware{SQL: s}
+	})), "retain_days is required")
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.AppAware = &JobGuestAppAware{TransactionLogs: types.StringValue("CopyOnly"), SQL: sql("TruncateLogs")}
+	})), "require transaction_logs")
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.AppAware = &JobGuestAppAware{Oracle: &JobGuestOracle{
+			ArchiveLogs:           types.StringValue("DeleteLogsOlderThanHours"),
+			DeleteOlderThanHours:  types.Int64Unknown(),
+			DeleteOverGB:          types.Int64Unknown(),
+			BackupLogs:            types.BoolValue(false),
+			BackupIntervalMinutes: types.Int64Unknown(),
+		}}
+	})), "requires delete_older_than_hours")
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.Indexing = &JobGuestIndexing{Mode: types.StringValue("IndexAllExcept"), Paths: types.ListNull(types.StringType)}
+	})), "at least one folder")
+	assert.ErrorContains(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.Scripts = &JobGuestScripts{Mode: types.StringValue("RequireSuccess")}
+	})), "at least one script path")
+	assert.NoError(t, validateGuestObjectOverrides(override(func(o *JobGuestObjectOverride) {
+		o.Scripts = &JobGuestScripts{Mode: types.StringValue("DisableExec")}
+	})))
+}
+
+// TestBackupJob_ValidateConfig_GuestObjectOverrides verifies that a duplicated
+// override fails at plan time, and that an override whose object_id or script
+// mode is not yet known is accepted.
+func TestBackupJob_ValidateConfig_GuestObjectOverrides(t *testing.T) {
+	override := func(objectID, mode types.String) JobGuestObjectOverride {
+		return JobGuestObjectOverride{
+			Platform:           types.StringValue("VSphere"),
+			Type:               types.StringValue("VirtualMachine"),
+			HostName:           types.StringValue("vcsa01"),
+			Name:               types.StringValue("sql01"),
+			ObjectID:           objectID,
+			CredentialsID:      types.StringNull(),
+			LinuxCredentialsID: types.StringNull(),
+			Scripts: &JobGuestScripts{
+				Mode:                   mode,
+				WindowsPreFreezeScript: types.StringValue(`C:\scripts\freeze.cmd`),
+				WindowsPostThawScript:  types.StringNull(),
+				LinuxPreFreezeScript:   types.StringNull(),
+				LinuxPostThawScript:    types.StringNull(),
+			},
+		}
+	}
+	validate := func(overrides ...JobGuestObjectOverride) diag.Diagnostics {
+		data := validVSphereJob()
+		data.GuestProcessing = &JobGuestProcessing{
+			AppAwareEnabled:            types.BoolValue(true),
+			FSIndexingEnabled:          types.BoolNull(),
+			InteractionProxyAutoSelect: types.BoolNull(),
+			ObjectOverrides:            overrides,
+		}
+		resp := &resource.ValidateConfigResponse{}
+		NewBackupJob().(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(),
+			resource.ValidateConfigRequest{Config: backupJobConfig(t, data)}, resp)
+		return resp.Diagnostics
+	}
+
+	sql01 := override(types.StringValue("vm-101"), types.StringValue("RequireSuccess"))
+	diags := validate(sql01, sql01)
+	require.True(t, diags.HasError())
+	assert.Equal(t, path.Root("guest_processing").AtName("object_overrides"),
+		diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+	assert.Contains(t, diags.Errors()[0].Detail(), "listed more than once")
+
+	diags = validate(
+		override(types.StringUnknown(), types.StringValue("RequireSuccess")),
+		override(types.StringUnknown(), types.StringUnknown()),
+	)
+	assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+}
+
+func TestBackupJob_Update_TogglesDisabledState(t *testing.T) {
+	tests := []struct {
+		name         string
+		stateValue   bool
+		planValue    types.Bool
+		wantEndpoint string
+		want         bool
+	}{
+		{name: "disable", stateValue: false, planValue: types.BoolValue(true), wantEndpoint: "/api/v1/jobs/job-1/disable", want: true},
+		{name: "enable", stateValue: true, planValue: types.BoolValue(false), wantEndpoint: "/api/v1/jobs/job-1/enable", want: false},
+		{name: "omitted keeps current", stateValue: true, planValue: types.BoolUnknown(), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			r := &BackupJob{client: mockClient}
+
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+				Run(func(args mock.Arguments) {
+					result := args.Get(2).(*map[string]interface{})
+					*result = map[string]interface{}{"id": "job-1", "type": "VSphereBackup", "isDisabled": tt.stateValue}
+				}).Return(nil)
+			var sent map[string]interface{}
+			mockClient.On("PutJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					sent = args.Get(2).(map[string]interface{})
+					result := args.Get(3).(*models.BackupJobModel)
+					result.ID = "job-1"
+					result.Type = models.JobTypeVSphereBackup
+					result.IsDisabled = tt.stateValue
+				}).Return(nil)
+			if tt.wantEndpoint != "" {
+				mockClient.On("PostJSON", mock.Anything, tt.wantEndpoint, nil, nil).Return(nil)
+			}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+			tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+			data := BackupJobModel{
+				ID:             types.StringValue("job-1"),
+				Name:           types.StringValue("Job"),
+				Description:    types.StringValue("desc"),
+				Type:           types.StringValue("VSphereBackup"),
+				IsHighPriority: types.BoolValue(false),
+				IsDisabled:     types.BoolValue(tt.stateValue),
+				VirtualMachines: &VMBackupScope{
+					Includes: []VMIncludeEntry{{
+						Platform: types.StringValue("VSphere"),
+						Type:     types.StringValue("VirtualMachine"),
+						HostName: types.StringValue("vcsa01"),
+						Name:     types.StringValue("vm-1"),
+						ObjectID: types.StringValue("vm-101"),
+					}},
+					ExcludeTemplates: types.BoolValue(false),
+				},
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+			require.False(t, state.Set(context.Background(), data).HasError())
+			data.IsDisabled = tt.planValue
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+			require.False(t, plan.Set(context.Background(), data).HasError())
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+			// The PUT never changes the enabled state itself.
+			assert.Equal(t, tt.stateValue, sent["isDisabled"])
+			var got types.Bool
+			require.False(t, resp.State.GetAttribute(context.Background(), path.Root("is_disabled"), &got).HasError())
+			assert.Equal(t, tt.want, got.ValueBool())
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBackupJob_Create_Disabled(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*models.BackupJobModel)
+			result.ID = "job-9"
+			result.Name = "Frozen"
+			result.Type = models.JobTypeVSphereBackup
+		}).Return(nil)
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-9/disable", nil, nil).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), BackupJobModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("Frozen"),
+		Type:           types.StringValue("VSphereBackup"),
+		IsHighPriority: types.BoolValue(false),
+		IsDisabled:     types.BoolValue(true),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{
+				Platform: types.StringValue("VSphere"),
+				Name:     types.StringValue("vm-1"),
+				ObjectID: types.StringValue("vm-101"),
+			}},
+		},
+	}).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var got types.Bool
+	require.False(t, resp.State.GetAttribute(context.Background(), path.Root("is_disabled"), &got).HasError())
+	assert.True(t, got.ValueBool())
+	mockClient.AssertExpectations(t)
+}
+
+// TestBackupJob_Create_DisableFails verifies that a job whose disable request
+// fails after the POST is still saved to state, with the enabled state it
+// actually has, so it is not orphaned.
+func TestBackupJob_Create_DisableFails(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*models.BackupJobModel)
+			result.ID = "job-9"
+			result.Name = "Frozen"
+			result.Type = models.JobTypeVSphereBackup
+		}).Return(nil)
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-9/disable", nil, nil).
+		Return(errors.New("job is running"))
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	data := validVSphereJob()
+	data.ID = types.StringUnknown()
+	data.IsHighPriority = types.BoolValue(false)
+	data.IsDisabled = types.BoolValue(true)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), data).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed to disable backup job", resp.Diagnostics.Errors()[0].Summary())
+
+	var got BackupJobModel
+	require.False(t, resp.State.Get(context.Background(), &got).HasError())
+	assert.Equal(t, "job-9", got.ID.ValueString())
+	assert.False(t, got.IsDisabled.ValueBool(), "state records the job as still enabled")
+	mockClient.AssertExpectations(t)
+}
+
+func TestBackupJob_Create_CloneFromJob(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/template-1/clone",
+		&models.JobCloneSpec{Name: "App-01"}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*map[string]interface{})
+			*result = map[string]interface{}{"id": "job-c", "type": "VSphereBackup", "isDisabled": true}
+		}).Return(nil)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-c", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{
+				"id":         "job-c",
+				"name":       "Copy of Template",
+				"type":       "VSphereBackup",
+				"isDisabled": true,
+				"storage": map[string]interface{}{
+					"backupRepositoryId": "repo-template",
+					"advancedSettings":   map[string]interface{}{"storageData": map[string]interface{}{"compressionLevel": "High"}},
+				},
+			}
+		}).Return(nil)
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/jobs/job-c", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+			result := args.Get(3).(*models.BackupJobModel)
+			result.ID = "job-c"
+			result.Name = "App-01"
+			result.Type = models.JobTypeVSphereBackup
+			result.IsDisabled = true
+		}).Return(nil)
+	// The source template is disabled; the clone is enabled because
+	// is_disabled is not set.
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-c/enable", nil, nil).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), BackupJobModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("App-01"),
+		Type:           types.StringValue("VSphereBackup"),
+		IsHighPriority: types.BoolValue(false),
+		IsDisabled:     types.BoolUnknown(),
+		CloneFromJobID: types.StringValue("template-1"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{
+				Platform: types.StringValue("VSphere"),
+				Name:     types.StringValue("app-01"),
+				ObjectID: types.StringValue("vm-501"),
+			}},
+		},
+		Storage: &JobStorageSettings{
+			RepositoryID:    types.StringValue("repo-app"),
+			ProxyAutoSelect: types.BoolValue(true),
+			ProxyIDs:        types.ListNull(types.StringType),
+		},
+	}).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	require.NotNil(t, sent)
+	assert.Equal(t, "App-01", sent["name"])
+	assert.Equal(t, true, sent["isDisabled"], "the PUT keeps the current enabled state")
+	storage := sent["storage"].(map[string]interface{})
+	assert.Equal(t, "repo-app", storage["backupRepositoryId"])
+	assert.Equal(t, "High",
+		storage["advancedSettings"].(map[string]interface{})["storageData"].(map[string]interface{})["compressionLevel"],
+		"settings not modelled in HCL are kept from the source job")
+
+	var state BackupJobModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "job-c", state.ID.ValueString())
+	assert.Equal(t, "template-1", state.CloneFromJobID.ValueString())
+	assert.False(t, state.IsDisabled.ValueBool())
+	mockClient.AssertExpectations(t)
+}
+
+func TestBackupJob_CloneJob_TypeMismatch(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/template-1/clone", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*map[string]interface{})
+			*result = map[string]interface{}{"id": "job-c", "type": "HyperVBackup"}
+		}).Return(nil)
+
+	id, _, err := r.cloneJob(context.Background(), &BackupJobModel{
+		Name:           types.StringValue("App-01"),
+		Type:           types.StringValue("VSphereBackup"),
+		CloneFromJobID: types.StringValue("template-1"),
+	})
+	assert.ErrorContains(t, err, "is of type HyperVBackup")
+	assert.Equal(t, "job-c", id, "the clone ID is returned so it can be saved to state")
+}
+
+// TestBackupJob_Create_CloneKeptWhenPutFails verifies that a clone whose
+// settings PUT fails is saved to state instead of being orphaned.
+func TestBackupJob_Create_CloneKeptWhenPutFails(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/template-1/clone", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*map[string]interface{})
+			*result = map[string]interface{}{"id": "job-c", "type": "VSphereBackup"}
+		}).Return(nil)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-c", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{"id": "job-c", "type": "VSphereBackup"}
+		}).Return(nil)
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/jobs/job-c", mock.Anything, mock.Anything).
+		Return(errors.New("repository not found"))
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	data := validVSphereJob()
+	data.ID = types.StringUnknown()
+	data.IsHighPriority = types.BoolValue(false)
+	data.CloneFromJobID = types.StringValue("template-1")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), data).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed to apply settings to cloned backup job", resp.Diagnostics.Errors()[0].Summary())
+
+	var id types.String
+	require.False(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+	assert.Equal(t, "job-c", id.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
 // TestBackupJob_ScheduleAfterJob verifies that after_job_name is sent as
 // "jobName" (not "jobId") in the API payload, matching the v1.3-rev1 spec.
 func TestBackupJob_ScheduleAfterJob(t *testing.T) {
@@ -283,12 +1799,12 @@ func TestBackupJob_SyncVMFromAPI(t *testing.T) {
 		Description:    "Test backup job",
 		IsHighPriority: true,
 		VirtualMachines: &models.BackupJobVirtualMachinesModel{
-			Includes: []models.VmwareObjectSpec{
+			Includes: []models.InventoryObjectSpec{
 				{
 					Platform: "VSphere",
 					HostName: "vcenter.lab",
 					Name:     "vm-01",
-					Type:     models.VmwareTypeVirtualMachine,
+					Type:     string(models.VmwareTypeVirtualMachine),
 					ObjectID: "vm-101",
 				},
 			},
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &CloudCredential{}
 	_ resource.ResourceWithConfigure   = &CloudCredential{}
 	_ resource.ResourceWithImportState = &CloudCredential{}
+	_ resource.ResourceWithIdentity    = &CloudCredential{}
 )
 
 type CloudCredential struct {
@@ -49,6 +49,17 @@ type CloudCredentialModel struct {
 
 func (r *CloudCredential) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cloud_credential"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// cloudCredentialIdentity keys cloud credentials by UUID and display name.
+var cloudCredentialIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Cloud credential name.",
+}
+
+func (r *CloudCredential) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = cloudCredentialIdentity.schema()
 }
 
 func (r *CloudCredential) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -119,6 +130,7 @@ func (r *CloudCredential) Create(ctx context.Context, req resource.CreateRequest
 		data.Description = types.StringNull()
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(cloudCredentialIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *CloudCredential) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,6 +149,7 @@ func (r *CloudCredential) Read(ctx context.Context, req resource.ReadRequest, re
 
 	r.syncFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(cloudCredentialIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *CloudCredential) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -158,6 +171,7 @@ func (r *CloudCredential) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(cloudCredentialIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *CloudCredential) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -175,7 +189,7 @@ func (r *CloudCredential) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *CloudCredential) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	cloudCredentialIdentity.importState(ctx, r.client, req, resp)
 }
 
 func NewCloudCredential() resource.Resource {
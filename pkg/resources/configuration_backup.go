@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &ConfigurationBackup{}
 	_ resource.ResourceWithConfigure   = &ConfigurationBackup{}
 	_ resource.ResourceWithImportState = &ConfigurationBackup{}
+	_ resource.ResourceWithIdentity    = &ConfigurationBackup{}
 )
 
 type ConfigurationBackup struct {
@@ -43,6 +43,10 @@ func (r *ConfigurationBackup) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_configuration_backup"
 }
 
+func (r *ConfigurationBackup) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *ConfigurationBackup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Veeam configuration backup settings and can trigger a configuration backup.",
@@ -101,6 +105,7 @@ func (r *ConfigurationBackup) Create(ctx context.Context, req resource.CreateReq
 
 	data.ID = types.StringValue("config-backup")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *ConfigurationBackup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -143,6 +148,7 @@ func (r *ConfigurationBackup) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *ConfigurationBackup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -168,6 +174,7 @@ func (r *ConfigurationBackup) Update(ctx context.Context, req resource.UpdateReq
 
 	data.ID = types.StringValue("config-backup")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *ConfigurationBackup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -193,7 +200,7 @@ func (r *ConfigurationBackup) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *ConfigurationBackup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 func NewConfigurationBackup() resource.Resource {
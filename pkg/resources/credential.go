@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +21,7 @@ var (
 	_ resource.Resource                = &Credential{}
 	_ resource.ResourceWithConfigure   = &Credential{}
 	_ resource.ResourceWithImportState = &Credential{}
+	_ resource.ResourceWithIdentity    = &Credential{}
 )
 
 // Credential implements the veeam_credential resource.
@@ -48,6 +48,18 @@ type CredentialModel struct {
 
 func (r *Credential) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_credential"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// credentialIdentity pairs the credential UUID with its user name.
+var credentialIdentity = resourceIdentity{
+	key:            "username",
+	keyDescription: "Credential user name.",
+	lookup:         &credentialImport,
+}
+
+func (r *Credential) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = credentialIdentity.schema()
 }
 
 func (r *Credential) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -159,6 +171,7 @@ func (r *Credential) Create(ctx context.Context, req resource.CreateRequest, res
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(credentialIdentity.set(ctx, resp.Identity, data.ID, data.Username)...)
 }
 
 func (r *Credential) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -181,6 +194,7 @@ func (r *Credential) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	r.syncModelFromAPI(&data, &result)
 	// Password is never returned by the API — keep current state value.
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(credentialIdentity.set(ctx, resp.Identity, data.ID, data.Username)...)
 }
 
 func (r *Credential) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -202,6 +216,7 @@ func (r *Credential) Update(ctx context.Context, req resource.UpdateRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(credentialIdentity.set(ctx, resp.Identity, data.ID, data.Username)...)
 }
 
 func (r *Credential) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -221,8 +236,15 @@ func (r *Credential) Delete(ctx context.Context, req resource.DeleteRequest, res
 	}
 }
 
+// credentialImport resolves "username:<user name>" import IDs in addition to UUIDs.
+var credentialImport = naturalKeyImport{
+	kind:         "credential",
+	listEndpoint: client.PathCredentials,
+	keys:         map[string]string{"username": "username"},
+}
+
 func (r *Credential) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	credentialIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewCredential returns a new veeam_credential resource instance.
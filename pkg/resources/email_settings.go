@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &EmailSettings{}
 	_ resource.ResourceWithConfigure   = &EmailSettings{}
 	_ resource.ResourceWithImportState = &EmailSettings{}
+	_ resource.ResourceWithIdentity    = &EmailSettings{}
 )
 
 // EmailSettings manages the Veeam email notification settings singleton.
@@ -56,6 +56,10 @@ func (r *EmailSettings) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_email_settings"
 }
 
+func (r *EmailSettings) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *EmailSettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateForUnknownBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
 	useStateForUnknownString := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
@@ -199,6 +203,7 @@ func (r *EmailSettings) Create(ctx context.Context, req resource.CreateRequest,
 
 	data.ID = types.StringValue("email-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EmailSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -217,6 +222,7 @@ func (r *EmailSettings) Read(ctx context.Context, req resource.ReadRequest, resp
 	r.syncModelFromAPI(&data, raw)
 	data.ID = types.StringValue("email-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EmailSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -250,6 +256,7 @@ func (r *EmailSettings) Update(ctx context.Context, req resource.UpdateRequest,
 
 	data.ID = types.StringValue("email-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EmailSettings) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
@@ -257,7 +264,7 @@ func (r *EmailSettings) Delete(_ context.Context, _ resource.DeleteRequest, _ *r
 }
 
 func (r *EmailSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // putEmailSettings GETs the current server config, merges plan fields, and PUTs the result.
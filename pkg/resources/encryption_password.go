@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +21,7 @@ var (
 	_ resource.Resource                = &EncryptionPassword{}
 	_ resource.ResourceWithConfigure   = &EncryptionPassword{}
 	_ resource.ResourceWithImportState = &EncryptionPassword{}
+	_ resource.ResourceWithIdentity    = &EncryptionPassword{}
 )
 
 // EncryptionPassword implements the veeam_encryption_password resource.
@@ -40,6 +40,10 @@ func (r *EncryptionPassword) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_encryption_password"
 }
 
+func (r *EncryptionPassword) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *EncryptionPassword) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Veeam encryption password used for backup encryption.",
@@ -107,6 +111,7 @@ func (r *EncryptionPassword) Create(ctx context.Context, req resource.CreateRequ
 	data.ID = types.StringValue(result.ID)
 	data.Hint = types.StringValue(result.Hint)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EncryptionPassword) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -129,6 +134,7 @@ func (r *EncryptionPassword) Read(ctx context.Context, req resource.ReadRequest,
 	data.Hint = types.StringValue(result.Hint)
 	// Password is never returned by the API — keep current state value.
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EncryptionPassword) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -153,6 +159,7 @@ func (r *EncryptionPassword) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EncryptionPassword) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -210,7 +217,7 @@ func isConfigBackupPasswordInUseError(err error) bool {
 }
 
 func (r *EncryptionPassword) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewEncryptionPassword returns a new veeam_encryption_password resource instance.
@@ -0,0 +1,337 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &EntraIDAuditLogBackupJob{}
+	_ resource.ResourceWithConfigure      = &EntraIDAuditLogBackupJob{}
+	_ resource.ResourceWithImportState    = &EntraIDAuditLogBackupJob{}
+	_ resource.ResourceWithIdentity       = &EntraIDAuditLogBackupJob{}
+	_ resource.ResourceWithValidateConfig = &EntraIDAuditLogBackupJob{}
+)
+
+// EntraIDAuditLogBackupJob implements the veeam_entra_id_audit_log_backup_job resource.
+type EntraIDAuditLogBackupJob struct {
+	client client.APIClient
+}
+
+// EntraIDAuditLogBackupJobResourceModel is the Terraform state model for
+// veeam_entra_id_audit_log_backup_job.
+type EntraIDAuditLogBackupJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	// EntraIDTenantID is the Veeam UUID of the tenant (veeam_entra_id_tenant.id).
+	EntraIDTenantID types.String `tfsdk:"entra_id_tenant_id"`
+
+	RepositoryID      types.String `tfsdk:"repository_id"`
+	RetentionType     types.String `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64  `tfsdk:"retention_quantity"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+func (r *EntraIDAuditLogBackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entra_id_audit_log_backup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// entraIDAuditLogBackupJobIdentity keys audit log backup jobs by UUID and
+// name.
+var entraIDAuditLogBackupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Entra ID audit log backup job name.",
+	lookup:         &backupJobImport,
+}
+
+var entraIDAuditLogBackupJobResource = jobResource{
+	typeName: "veeam_entra_id_audit_log_backup_job",
+	kind:     "Entra ID audit log backup job",
+	jobTypes: []models.EJobType{models.JobTypeEntraIDAuditLogBackup},
+}
+
+func (r *EntraIDAuditLogBackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = entraIDAuditLogBackupJobIdentity.schema()
+}
+
+func (r *EntraIDAuditLogBackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the audit log backup job (UUID assigned by Veeam).",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the audit log backup job. Must be unique across all jobs.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+			Required:            true,
+		},
+		"is_disabled": schema.BoolAttribute{
+			MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+				"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"entra_id_tenant_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the tenant whose logs are backed up (`veeam_entra_id_tenant.id`). " +
+				"Changing this forces a new job.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"repository_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the backup repository that stores the audit logs.",
+			Required:            true,
+		},
+		"schedule": scheduleAttribute(),
+	}
+	for k, v := range unstructuredRetentionAttributes("Retention of audit logs in `repository_id`.",
+		models.UnstructuredRetentionYears, 1) {
+		attrs[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam Entra ID audit log backup job (`EntraIDAuditLogBackup`) that " +
+			"keeps the sign-in and audit logs of a registered tenant in a backup repository.",
+		Attributes: attrs,
+	}
+}
+
+// ValidateConfig reports an unsupported retention unit or a malformed backup
+// window during plan.
+func (r *EntraIDAuditLogBackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EntraIDAuditLogBackupJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+	if err := validateEntraIDAuditLogBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID audit log backup job configuration", err.Error())
+	}
+}
+
+func (r *EntraIDAuditLogBackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *EntraIDAuditLogBackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EntraIDAuditLogBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateEntraIDAuditLogBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID audit log backup job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildEntraIDAuditLogBackupJobModel(&data, false)
+	spec := &models.EntraIDAuditLogBackupJobSpec{
+		JobSpec:     models.JobSpec{Name: model.Name, Type: model.Type},
+		Description: model.Description,
+		TenantID:    model.TenantID,
+		Storage:     model.Storage,
+		Schedule:    model.Schedule,
+	}
+
+	var result models.EntraIDAuditLogBackupJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create Entra ID audit log backup job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create Entra ID audit log backup job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncEntraIDAuditLogBackupJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(entraIDAuditLogBackupJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, entraIDAuditLogBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *EntraIDAuditLogBackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EntraIDAuditLogBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.EntraIDAuditLogBackupJobModel
+	if !entraIDAuditLogBackupJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncEntraIDAuditLogBackupJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(entraIDAuditLogBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *EntraIDAuditLogBackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state EntraIDAuditLogBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateEntraIDAuditLogBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID audit log backup job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.EntraIDAuditLogBackupJobModel
+	payload := buildEntraIDAuditLogBackupJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, entraIDAuditLogBackupJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update Entra ID audit log backup job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncEntraIDAuditLogBackupJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(entraIDAuditLogBackupJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, entraIDAuditLogBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *EntraIDAuditLogBackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EntraIDAuditLogBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entraIDAuditLogBackupJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *EntraIDAuditLogBackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entraIDAuditLogBackupJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewEntraIDAuditLogBackupJob returns a new veeam_entra_id_audit_log_backup_job resource instance.
+func NewEntraIDAuditLogBackupJob() resource.Resource {
+	return &EntraIDAuditLogBackupJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// entraIDAuditLogBackupJobManagedPaths are cleared on the server when the
+// plan leaves them out, so removing a schedule block takes effect on update.
+var entraIDAuditLogBackupJobManagedPaths = []string{
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// validateEntraIDAuditLogBackupJob checks the retention unit and quantity and
+// the backup window.
+func validateEntraIDAuditLogBackupJob(data *EntraIDAuditLogBackupJobResourceModel) error {
+	if err := validateUnstructuredRetention("", data.RetentionType, data.RetentionQuantity); err != nil {
+		return err
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// buildEntraIDAuditLogBackupJobModel converts the plan into the full job
+// model used for PUT; Create derives the POST spec from it.
+func buildEntraIDAuditLogBackupJobModel(data *EntraIDAuditLogBackupJobResourceModel, isDisabled bool) *models.EntraIDAuditLogBackupJobModel {
+	return &models.EntraIDAuditLogBackupJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeEntraIDAuditLogBackup,
+			IsDisabled: isDisabled,
+		},
+		Description: data.Description.ValueString(),
+		TenantID:    data.EntraIDTenantID.ValueString(),
+		Storage: &models.UnstructuredBackupStorageModel{
+			BackupRepositoryID: data.RepositoryID.ValueString(),
+			RetentionPolicy:    buildUnstructuredRetention(data.RetentionType, data.RetentionQuantity),
+		},
+		Schedule: buildScheduleModel(data.Schedule),
+	}
+}
+
+// syncEntraIDAuditLogBackupJobFromAPI refreshes the state from a job response.
+func syncEntraIDAuditLogBackupJobFromAPI(data *EntraIDAuditLogBackupJobResourceModel, api *models.EntraIDAuditLogBackupJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+	data.EntraIDTenantID = types.StringValue(api.TenantID)
+
+	if s := api.Storage; s != nil {
+		data.RepositoryID = types.StringValue(s.BackupRepositoryID)
+		if rp := s.RetentionPolicy; rp != nil {
+			data.RetentionType = types.StringValue(string(rp.Type))
+			data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+		}
+	}
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
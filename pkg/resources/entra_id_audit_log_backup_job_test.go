@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func TestEntraIDAuditLogBackupJob_BuildModel(t *testing.T) {
+	m := buildEntraIDAuditLogBackupJobModel(&EntraIDAuditLogBackupJobResourceModel{
+		EntraIDTenantID:   types.StringValue("tenant-1"),
+		RepositoryID:      types.StringValue("repo-1"),
+		RetentionType:     types.StringValue("Years"),
+		RetentionQuantity: types.Int64Value(2),
+	}, true)
+	assert.Equal(t, models.JobTypeEntraIDAuditLogBackup, m.Type)
+	assert.True(t, m.IsDisabled)
+	assert.Equal(t, "tenant-1", m.TenantID)
+	assert.Equal(t, &models.UnstructuredBackupStorageModel{
+		BackupRepositoryID: "repo-1",
+		RetentionPolicy:    &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionYears, Quantity: 2},
+	}, m.Storage)
+	assert.Nil(t, m.Schedule)
+}
+
+func TestEntraIDAuditLogBackupJob_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "defaults",
+			attrs: map[string]interface{}{},
+		},
+		{
+			name:  "kept for two years",
+			attrs: map[string]interface{}{"retention_type": "Years", "retention_quantity": 2},
+		},
+		{
+			name:    "retention in weeks",
+			attrs:   map[string]interface{}{"retention_type": "Weeks"},
+			wantErr: `retention_type "Weeks" is not supported`,
+		},
+		{
+			name:    "kept for no months",
+			attrs:   map[string]interface{}{"retention_type": "Months", "retention_quantity": 0},
+			wantErr: "retention_quantity must be at least 1",
+		},
+		{
+			name:    "backup window hour outside the day",
+			attrs:   map[string]interface{}{"schedule": &JobScheduleSettings{BackupWindow: copyWindow(24)}},
+			wantErr: `schedule.day "Monday": hour 24 is outside 0–23`,
+		},
+		{
+			name:  "retention from variables",
+			attrs: map[string]interface{}{"retention_type": types.StringUnknown(), "retention_quantity": types.Int64Unknown()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &EntraIDAuditLogBackupJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// TestEntraIDAuditLogBackupJob_SyncFromAPI checks that a response without a
+// schedule leaves the configured schedule alone while storage changes made
+// in the console are picked up.
+func TestEntraIDAuditLogBackupJob_SyncFromAPI(t *testing.T) {
+	schedule := &JobScheduleSettings{DailyLocalTime: types.StringValue("02:00")}
+	data := EntraIDAuditLogBackupJobResourceModel{
+		RepositoryID: types.StringValue("repo-1"),
+		Schedule:     schedule,
+	}
+	syncEntraIDAuditLogBackupJobFromAPI(&data, &models.EntraIDAuditLogBackupJobModel{
+		JobModel: models.JobModel{ID: "log-1", Name: "Corp-Audit-Logs", Type: models.JobTypeEntraIDAuditLogBackup, IsDisabled: true},
+		TenantID: "tenant-1",
+		Storage: &models.UnstructuredBackupStorageModel{
+			BackupRepositoryID: "repo-2",
+			RetentionPolicy:    &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionMonths, Quantity: 18},
+		},
+	})
+	assert.True(t, data.IsDisabled.ValueBool())
+	assert.Equal(t, "repo-2", data.RepositoryID.ValueString())
+	assert.Equal(t, "Months", data.RetentionType.ValueString())
+	assert.Equal(t, int64(18), data.RetentionQuantity.ValueInt64())
+	assert.Same(t, schedule, data.Schedule)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &EntraIDTenant{}
 	_ resource.ResourceWithConfigure   = &EntraIDTenant{}
 	_ resource.ResourceWithImportState = &EntraIDTenant{}
+	_ resource.ResourceWithIdentity    = &EntraIDTenant{}
 )
 
 // EntraIDTenant implements the veeam_entra_id_tenant resource.
@@ -43,6 +43,17 @@ func NewEntraIDTenant() resource.Resource {
 
 func (r *EntraIDTenant) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_entra_id_tenant"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// entraIDTenantIdentity keys tenants by UUID and Microsoft Entra tenant ID.
+var entraIDTenantIdentity = resourceIdentity{
+	key:            "tenant_id",
+	keyDescription: "Microsoft Entra tenant ID.",
+}
+
+func (r *EntraIDTenant) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = entraIDTenantIdentity.schema()
 }
 
 func (r *EntraIDTenant) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -125,6 +136,7 @@ func (r *EntraIDTenant) Create(ctx context.Context, req resource.CreateRequest,
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(entraIDTenantIdentity.set(ctx, resp.Identity, data.ID, data.TenantID)...)
 }
 
 func (r *EntraIDTenant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -146,6 +158,7 @@ func (r *EntraIDTenant) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(entraIDTenantIdentity.set(ctx, resp.Identity, data.ID, data.TenantID)...)
 }
 
 func (r *EntraIDTenant) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -167,6 +180,7 @@ func (r *EntraIDTenant) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(entraIDTenantIdentity.set(ctx, resp.Identity, data.ID, data.TenantID)...)
 }
 
 func (r *EntraIDTenant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -187,7 +201,7 @@ func (r *EntraIDTenant) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *EntraIDTenant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	entraIDTenantIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -0,0 +1,389 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &EntraIDTenantBackupJob{}
+	_ resource.ResourceWithConfigure      = &EntraIDTenantBackupJob{}
+	_ resource.ResourceWithImportState    = &EntraIDTenantBackupJob{}
+	_ resource.ResourceWithIdentity       = &EntraIDTenantBackupJob{}
+	_ resource.ResourceWithValidateConfig = &EntraIDTenantBackupJob{}
+)
+
+// EntraIDTenantBackupJob implements the veeam_entra_id_tenant_backup_job resource.
+type EntraIDTenantBackupJob struct {
+	client client.APIClient
+}
+
+// EntraIDTenantBackupJobResourceModel is the Terraform state model for
+// veeam_entra_id_tenant_backup_job.
+type EntraIDTenantBackupJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	// EntraIDTenantID is the Veeam UUID of the tenant (veeam_entra_id_tenant.id).
+	EntraIDTenantID types.String `tfsdk:"entra_id_tenant_id"`
+	ObjectTypes     types.List   `tfsdk:"object_types"`
+
+	RetentionType     types.String `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64  `tfsdk:"retention_quantity"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+func (r *EntraIDTenantBackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entra_id_tenant_backup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// entraIDTenantBackupJobIdentity keys tenant backup jobs by UUID and name.
+var entraIDTenantBackupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Entra ID tenant backup job name.",
+	lookup:         &backupJobImport,
+}
+
+var entraIDTenantBackupJobResource = jobResource{
+	typeName: "veeam_entra_id_tenant_backup_job",
+	kind:     "Entra ID tenant backup job",
+	jobTypes: []models.EJobType{models.JobTypeEntraIDTenantBackup},
+}
+
+func (r *EntraIDTenantBackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = entraIDTenantBackupJobIdentity.schema()
+}
+
+func (r *EntraIDTenantBackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the tenant backup job (UUID assigned by Veeam).",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the tenant backup job. Must be unique across all jobs.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+			Required:            true,
+		},
+		"is_disabled": schema.BoolAttribute{
+			MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+				"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"entra_id_tenant_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the protected tenant (`veeam_entra_id_tenant.id`). " +
+				"Changing this forces a new job.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"object_types": schema.ListAttribute{
+			MarkdownDescription: "Object types to protect: " + strings.Join(entraIDObjectTypes, ", ") +
+				". When omitted, the entire tenant is protected.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"schedule": scheduleAttribute(),
+	}
+	for k, v := range unstructuredRetentionAttributes("Retention of tenant restore points.",
+		models.UnstructuredRetentionDays, 30) {
+		attrs[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam Entra ID tenant backup job (`EntraIDTenantBackup`) that " +
+			"protects the directory objects of a registered tenant.",
+		Attributes: attrs,
+	}
+}
+
+// ValidateConfig checks object_types and the retention policy when the plan
+// is built.
+func (r *EntraIDTenantBackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EntraIDTenantBackupJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// An unknown schedule block cannot be decoded yet.
+		return
+	}
+	if err := validateEntraIDTenantBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID tenant backup job configuration", err.Error())
+	}
+}
+
+func (r *EntraIDTenantBackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *EntraIDTenantBackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EntraIDTenantBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateEntraIDTenantBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID tenant backup job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildEntraIDTenantBackupJobModel(&data, false)
+	spec := &models.EntraIDTenantBackupJobSpec{
+		JobSpec:         models.JobSpec{Name: model.Name, Type: model.Type},
+		Description:     model.Description,
+		TenantID:        model.TenantID,
+		Objects:         model.Objects,
+		RetentionPolicy: model.RetentionPolicy,
+		Schedule:        model.Schedule,
+	}
+
+	var result models.EntraIDTenantBackupJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create Entra ID tenant backup job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create Entra ID tenant backup job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncEntraIDTenantBackupJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(entraIDTenantBackupJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, entraIDTenantBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *EntraIDTenantBackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EntraIDTenantBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.EntraIDTenantBackupJobModel
+	if !entraIDTenantBackupJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncEntraIDTenantBackupJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(entraIDTenantBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *EntraIDTenantBackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state EntraIDTenantBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateEntraIDTenantBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid Entra ID tenant backup job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.EntraIDTenantBackupJobModel
+	payload := buildEntraIDTenantBackupJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, entraIDTenantBackupJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update Entra ID tenant backup job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncEntraIDTenantBackupJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(entraIDTenantBackupJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, entraIDTenantBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *EntraIDTenantBackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EntraIDTenantBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entraIDTenantBackupJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *EntraIDTenantBackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entraIDTenantBackupJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewEntraIDTenantBackupJob returns a new veeam_entra_id_tenant_backup_job resource instance.
+func NewEntraIDTenantBackupJob() resource.Resource {
+	return &EntraIDTenantBackupJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// entraIDTenantBackupJobManagedPaths are cleared on the server when the plan
+// leaves them out, so removing object_types switches the job back to the
+// entire tenant and removing a schedule block takes effect on update.
+var entraIDTenantBackupJobManagedPaths = []string{
+	"objects.objectTypes",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// entraIDObjectTypes lists the accepted object_types values.
+var entraIDObjectTypes = []string{
+	string(models.EntraIDObjectUsers),
+	string(models.EntraIDObjectGroups),
+	string(models.EntraIDObjectAdministrativeUnits),
+	string(models.EntraIDObjectApplications),
+	string(models.EntraIDObjectServicePrincipals),
+	string(models.EntraIDObjectDirectoryRoles),
+	string(models.EntraIDObjectConditionalAccessPolicies),
+}
+
+// validateEntraIDTenantBackupJob checks that object_types, when set, lists
+// supported types once each, and that retention and the backup window are
+// well formed.
+func validateEntraIDTenantBackupJob(data *EntraIDTenantBackupJobResourceModel) error {
+	if isConfigured(data.ObjectTypes) && len(data.ObjectTypes.Elements()) == 0 {
+		return errors.New("object_types must not be empty; omit it to protect the entire tenant")
+	}
+	seen := map[string]bool{}
+	for i, e := range data.ObjectTypes.Elements() {
+		v, ok := e.(types.String)
+		if !ok || !isConfigured(v) {
+			continue
+		}
+		t := v.ValueString()
+		if !slices.Contains(entraIDObjectTypes, t) {
+			return fmt.Errorf("object_types[%d]: %q is not supported; expected one of %s",
+				i, t, strings.Join(entraIDObjectTypes, ", "))
+		}
+		if seen[t] {
+			return fmt.Errorf("object_types[%d]: %q is listed more than once", i, t)
+		}
+		seen[t] = true
+	}
+	if err := validateUnstructuredRetention("", data.RetentionType, data.RetentionQuantity); err != nil {
+		return err
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// buildEntraIDTenantBackupJobModel converts the plan into the full job model
+// used for PUT; Create derives the POST spec from it.
+func buildEntraIDTenantBackupJobModel(data *EntraIDTenantBackupJobResourceModel, isDisabled bool) *models.EntraIDTenantBackupJobModel {
+	m := &models.EntraIDTenantBackupJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeEntraIDTenantBackup,
+			IsDisabled: isDisabled,
+		},
+		Description:     data.Description.ValueString(),
+		TenantID:        data.EntraIDTenantID.ValueString(),
+		Objects:         &models.EntraIDTenantBackupJobObjectsModel{IsEntireTenant: true},
+		RetentionPolicy: buildUnstructuredRetention(data.RetentionType, data.RetentionQuantity),
+		Schedule:        buildScheduleModel(data.Schedule),
+	}
+	if objectTypes := listStrings(data.ObjectTypes); len(objectTypes) > 0 {
+		m.Objects.IsEntireTenant = false
+		for _, t := range objectTypes {
+			m.Objects.ObjectTypes = append(m.Objects.ObjectTypes, models.EEntraIDObjectType(t))
+		}
+	}
+	return m
+}
+
+// syncEntraIDTenantBackupJobFromAPI refreshes the state from a job response.
+func syncEntraIDTenantBackupJobFromAPI(data *EntraIDTenantBackupJobResourceModel, api *models.EntraIDTenantBackupJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+	data.EntraIDTenantID = types.StringValue(api.TenantID)
+
+	if o := api.Objects; o != nil {
+		data.ObjectTypes = types.ListNull(types.StringType)
+		if !o.IsEntireTenant {
+			objectTypes := make([]string, 0, len(o.ObjectTypes))
+			for _, t := range o.ObjectTypes {
+				objectTypes = append(objectTypes, string(t))
+			}
+			data.ObjectTypes = stringListOrNull(objectTypes)
+		}
+	}
+
+	if rp := api.RetentionPolicy; rp != nil {
+		data.RetentionType = types.StringValue(string(rp.Type))
+		data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+	}
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
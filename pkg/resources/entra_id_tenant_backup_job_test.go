@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// ---------------------------------------------------------------------------
+// EntraIDTenantBackupJob — buildEntraIDTenantBackupJobModel
+// ---------------------------------------------------------------------------
+
+func TestEntraIDTenantBackupJob_BuildModel(t *testing.T) {
+	tests := []struct {
+		name        string
+		objectTypes types.List
+		want        *models.EntraIDTenantBackupJobObjectsModel
+	}{
+		{
+			name:        "entire tenant",
+			objectTypes: types.ListNull(types.StringType),
+			want:        &models.EntraIDTenantBackupJobObjectsModel{IsEntireTenant: true},
+		},
+		{
+			name:        "users and groups",
+			objectTypes: stringList("Users", "Groups"),
+			want: &models.EntraIDTenantBackupJobObjectsModel{
+				ObjectTypes: []models.EEntraIDObjectType{models.EntraIDObjectUsers, models.EntraIDObjectGroups},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := buildEntraIDTenantBackupJobModel(&EntraIDTenantBackupJobResourceModel{
+				EntraIDTenantID:   types.StringValue("tenant-1"),
+				ObjectTypes:       tt.objectTypes,
+				RetentionType:     types.StringValue("Months"),
+				RetentionQuantity: types.Int64Value(6),
+			}, false)
+			assert.Equal(t, models.JobTypeEntraIDTenantBackup, m.Type)
+			assert.Equal(t, "tenant-1", m.TenantID)
+			assert.Equal(t, tt.want, m.Objects)
+			assert.Equal(t, &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionMonths, Quantity: 6}, m.RetentionPolicy)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// EntraIDTenantBackupJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestEntraIDTenantBackupJob_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "entire tenant",
+			attrs: map[string]interface{}{},
+		},
+		{
+			name:  "directory roles and policies",
+			attrs: map[string]interface{}{"object_types": stringList("DirectoryRoles", "ConditionalAccessPolicies")},
+		},
+		{
+			name:    "empty object types",
+			attrs:   map[string]interface{}{"object_types": stringList()},
+			wantErr: "object_types must not be empty; omit it to protect the entire tenant",
+		},
+		{
+			name:    "devices",
+			attrs:   map[string]interface{}{"object_types": stringList("Users", "Devices")},
+			wantErr: `object_types[1]: "Devices" is not supported`,
+		},
+		{
+			name:    "users twice",
+			attrs:   map[string]interface{}{"object_types": stringList("Users", "Groups", "Users")},
+			wantErr: `object_types[2]: "Users" is listed more than once`,
+		},
+		{
+			name:    "retention in weeks",
+			attrs:   map[string]interface{}{"retention_type": "Weeks"},
+			wantErr: `retention_type "Weeks" is not supported`,
+		},
+		{
+			name:  "object types from a variable",
+			attrs: map[string]interface{}{"object_types": types.ListUnknown(types.StringType)},
+		},
+		{
+			name: "one object type from a variable",
+			attrs: map[string]interface{}{"object_types": types.ListValueMust(types.StringType,
+				[]attr.Value{types.StringUnknown()})},
+		},
+		{
+			name: "known type after an unknown one",
+			attrs: map[string]interface{}{"object_types": types.ListValueMust(types.StringType,
+				[]attr.Value{types.StringUnknown(), types.StringValue("Devices")})},
+			wantErr: `object_types[1]: "Devices" is not supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &EntraIDTenantBackupJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// EntraIDTenantBackupJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestEntraIDTenantBackupJob_ManagedPaths checks that removing object_types
+// drops the stored type list instead of merging it back, and that a changed
+// list replaces the stored one.
+func TestEntraIDTenantBackupJob_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"objects": map[string]interface{}{
+			"isEntireTenant": false,
+			"objectTypes":    []interface{}{"Users", "Groups"},
+		},
+	}
+	tests := []struct {
+		name        string
+		objectTypes types.List
+		want        interface{}
+	}{
+		{
+			name:        "back to the entire tenant",
+			objectTypes: types.ListNull(types.StringType),
+			want:        map[string]interface{}{"isEntireTenant": true},
+		},
+		{
+			name:        "applications only",
+			objectTypes: stringList("Applications"),
+			want:        map[string]interface{}{"isEntireTenant": false, "objectTypes": []interface{}{"Applications"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := buildEntraIDTenantBackupJobModel(&EntraIDTenantBackupJobResourceModel{ObjectTypes: tt.objectTypes}, false)
+			merged, err := mergeManagedPayload(current, desired, entraIDTenantBackupJobManagedPaths...)
+			require.NoError(t, err)
+			got, _ := lookupJSONPath(merged, []string{"objects"})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// EntraIDTenantBackupJob — syncEntraIDTenantBackupJobFromAPI
+// ---------------------------------------------------------------------------
+
+func TestEntraIDTenantBackupJob_SyncFromAPI(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects *models.EntraIDTenantBackupJobObjectsModel
+		want    types.List
+	}{
+		{
+			name: "switched to the entire tenant in the console",
+			objects: &models.EntraIDTenantBackupJobObjectsModel{
+				IsEntireTenant: true,
+				ObjectTypes:    []models.EEntraIDObjectType{models.EntraIDObjectUsers, models.EntraIDObjectGroups},
+			},
+			want: types.ListNull(types.StringType),
+		},
+		{
+			name: "narrowed to groups in the console",
+			objects: &models.EntraIDTenantBackupJobObjectsModel{
+				ObjectTypes: []models.EEntraIDObjectType{models.EntraIDObjectGroups},
+			},
+			want: stringList("Groups"),
+		},
+		{
+			name:    "no objects in the response",
+			objects: nil,
+			want:    stringList("Users"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := EntraIDTenantBackupJobResourceModel{ObjectTypes: stringList("Users")}
+			syncEntraIDTenantBackupJobFromAPI(&data, &models.EntraIDTenantBackupJobModel{
+				JobModel: models.JobModel{ID: "ten-1", Name: "Corp-Tenant", Type: models.JobTypeEntraIDTenantBackup},
+				TenantID: "tenant-1",
+				Objects:  tt.objects,
+			})
+			assert.Equal(t, tt.want, data.ObjectTypes)
+			assert.Equal(t, "tenant-1", data.EntraIDTenantID.ValueString())
+		})
+	}
+}
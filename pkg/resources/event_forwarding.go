@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &EventForwarding{}
 	_ resource.ResourceWithConfigure   = &EventForwarding{}
 	_ resource.ResourceWithImportState = &EventForwarding{}
+	_ resource.ResourceWithIdentity    = &EventForwarding{}
 )
 
 // eventForwardingID is the fixed singleton ID used in Terraform state.
@@ -54,6 +54,10 @@ func (r *EventForwarding) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_event_forwarding"
 }
 
+func (r *EventForwarding) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *EventForwarding) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateString := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
 	useStateBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
@@ -156,6 +160,7 @@ func (r *EventForwarding) Create(ctx context.Context, req resource.CreateRequest
 
 	data.ID = types.StringValue(eventForwardingID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *EventForwarding) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,6 +179,7 @@ func (r *EventForwarding) Read(ctx context.Context, req resource.ReadRequest, re
 	syncEventForwardingFromPayload(raw, &data)
 	data.ID = types.StringValue(eventForwardingID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Update applies plan changes via GET → merge → PUT.
@@ -191,6 +197,7 @@ func (r *EventForwarding) Update(ctx context.Context, req resource.UpdateRequest
 
 	data.ID = types.StringValue(eventForwardingID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Delete removes the resource from Terraform state only. The server-side
@@ -200,7 +207,7 @@ func (r *EventForwarding) Delete(_ context.Context, _ resource.DeleteRequest, _
 }
 
 func (r *EventForwarding) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
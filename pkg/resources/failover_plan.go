@@ -0,0 +1,396 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &FailoverPlan{}
+	_ resource.ResourceWithConfigure      = &FailoverPlan{}
+	_ resource.ResourceWithImportState    = &FailoverPlan{}
+	_ resource.ResourceWithIdentity       = &FailoverPlan{}
+	_ resource.ResourceWithValidateConfig = &FailoverPlan{}
+)
+
+// FailoverPlan implements the veeam_failover_plan resource.
+type FailoverPlan struct {
+	client client.APIClient
+}
+
+// FailoverPlanResourceModel is the Terraform state model for veeam_failover_plan.
+type FailoverPlanResourceModel struct {
+	ID                 types.String     `tfsdk:"id"`
+	Name               types.String     `tfsdk:"name"`
+	Description        types.String     `tfsdk:"description"`
+	VirtualMachines    []FailoverPlanVM `tfsdk:"virtual_machines"`
+	PreFailoverScript  types.String     `tfsdk:"pre_failover_script"`
+	PostFailoverScript types.String     `tfsdk:"post_failover_script"`
+}
+
+// FailoverPlanVM maps to FailoverPlanVMModel.
+type FailoverPlanVM struct {
+	ReplicaID        types.String `tfsdk:"replica_id"`
+	BootOrder        types.Int64  `tfsdk:"boot_order"`
+	BootDelaySeconds types.Int64  `tfsdk:"boot_delay_seconds"`
+}
+
+func (r *FailoverPlan) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failover_plan"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// failoverPlanImport resolves "name:<plan>" import IDs.
+var failoverPlanImport = naturalKeyImport{
+	kind:         "failover plan",
+	listEndpoint: client.PathFailoverPlans,
+	keys:         map[string]string{"name": "name"},
+}
+
+// failoverPlanIdentity pairs the plan UUID with the plan name.
+var failoverPlanIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Failover plan name.",
+	lookup:         &failoverPlanImport,
+}
+
+func (r *FailoverPlan) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = failoverPlanIdentity.schema()
+}
+
+func (r *FailoverPlan) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam failover plan: an ordered group of replica VMs that are " +
+			"failed over together, with boot delays and optional pre/post-failover scripts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the failover plan (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the failover plan.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"virtual_machines": schema.ListNestedAttribute{
+				MarkdownDescription: "Replica VMs in the plan. VMs start in ascending `boot_order`.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"replica_id": schema.StringAttribute{
+							MarkdownDescription: "UUID of the replica, as returned by the `veeam_replicas` data source.",
+							Required:            true,
+						},
+						"boot_order": schema.Int64Attribute{
+							MarkdownDescription: "Position in the start sequence, starting at `1`. Must be unique within the plan.",
+							Required:            true,
+						},
+						"boot_delay_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Seconds to wait after this VM starts before the next VM is started. " +
+								"Defaults to `60`.",
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(60),
+						},
+					},
+				},
+			},
+			"pre_failover_script": schema.StringAttribute{
+				MarkdownDescription: "Path on the backup server of a script run before the failover starts.",
+				Optional:            true,
+			},
+			"post_failover_script": schema.StringAttribute{
+				MarkdownDescription: "Path on the backup server of a script run after all VMs are failed over.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects duplicate replicas and boot orders while planning,
+// so a broken start sequence never reaches the backup server.
+func (r *FailoverPlan) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FailoverPlanResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// The VM list comes from another resource and is known at apply.
+		return
+	}
+	if err := validateFailoverPlan(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid failover plan configuration", err.Error())
+	}
+}
+
+func (r *FailoverPlan) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *FailoverPlan) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FailoverPlanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateFailoverPlan(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid failover plan configuration", err.Error())
+		return
+	}
+
+	var result models.FailoverPlanModel
+	if err := r.client.PostJSON(ctx, client.PathFailoverPlans, buildFailoverPlanSpec(&data), &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create failover plan",
+			fmt.Sprintf("POST %s: %s", client.PathFailoverPlans, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create failover plan",
+			fmt.Sprintf("POST %s returned no failover plan ID.", client.PathFailoverPlans))
+		return
+	}
+
+	syncFailoverPlanFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(failoverPlanIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *FailoverPlan) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FailoverPlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathFailoverPlanByID, data.ID.ValueString())
+	var result models.FailoverPlanModel
+	if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
+		if isFailoverPlanNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read failover plan",
+			fmt.Sprintf("GET %s: %s", endpoint, err))
+		return
+	}
+
+	syncFailoverPlanFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(failoverPlanIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *FailoverPlan) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FailoverPlanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateFailoverPlan(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid failover plan configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	endpoint := fmt.Sprintf(client.PathFailoverPlanByID, data.ID.ValueString())
+	var result models.FailoverPlanModel
+	if err := putMergedPayload(ctx, r.client, endpoint, buildFailoverPlanSpec(&data), &result, failoverPlanManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update failover plan",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncFailoverPlanFromAPI(&data, &result)
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(failoverPlanIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *FailoverPlan) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FailoverPlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathFailoverPlanByID, data.ID.ValueString())
+	if err := r.client.DeleteJSON(ctx, endpoint); err != nil {
+		resp.Diagnostics.AddError("Failed to delete failover plan",
+			fmt.Sprintf("DELETE %s (failover plan %s): %s", endpoint, data.ID.ValueString(), err))
+	}
+}
+
+func (r *FailoverPlan) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	failoverPlanIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewFailoverPlan returns a new veeam_failover_plan resource instance.
+func NewFailoverPlan() resource.Resource {
+	return &FailoverPlan{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// failoverPlanManagedPaths are cleared on the server when the plan leaves them
+// out: a removed script is sent as disabled without a path, and the stored
+// path must not survive the merge.
+var failoverPlanManagedPaths = []string{
+	"preFailoverScript.scriptPath",
+	"postFailoverScript.scriptPath",
+}
+
+// validateFailoverPlan checks that each replica is listed once, that boot
+// orders are unique and start at 1, and that boot delays are not negative.
+func validateFailoverPlan(data *FailoverPlanResourceModel) error {
+	if len(data.VirtualMachines) == 0 {
+		return errors.New("virtual_machines must list at least one replica")
+	}
+	replicas := map[string]bool{}
+	orders := map[int64]string{}
+	for i, vm := range data.VirtualMachines {
+		id := vm.ReplicaID.ValueString()
+		if isConfigured(vm.ReplicaID) {
+			if replicas[id] {
+				return fmt.Errorf("virtual_machines[%d]: replica %s is listed more than once", i, id)
+			}
+			replicas[id] = true
+		}
+		if isConfigured(vm.BootOrder) {
+			order := vm.BootOrder.ValueInt64()
+			if order < 1 {
+				return fmt.Errorf("virtual_machines[%d]: boot_order must be at least 1", i)
+			}
+			if other, ok := orders[order]; ok {
+				return fmt.Errorf("virtual_machines[%d]: boot_order %d is already used by replica %s", i, order, other)
+			}
+			orders[order] = id
+		}
+		if isConfigured(vm.BootDelaySeconds) && vm.BootDelaySeconds.ValueInt64() < 0 {
+			return fmt.Errorf("virtual_machines[%d]: boot_delay_seconds must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// buildFailoverPlanSpec converts the plan into the request body. VMs are sent
+// in boot order; scripts are always sent so that removing one disables it.
+func buildFailoverPlanSpec(data *FailoverPlanResourceModel) *models.FailoverPlanSpec {
+	spec := &models.FailoverPlanSpec{
+		Name:               data.Name.ValueString(),
+		Description:        data.Description.ValueString(),
+		Type:               models.FailoverPlanTypeVSphere,
+		VirtualMachines:    make([]models.FailoverPlanVMModel, 0, len(data.VirtualMachines)),
+		PreFailoverScript:  buildFailoverPlanScript(data.PreFailoverScript),
+		PostFailoverScript: buildFailoverPlanScript(data.PostFailoverScript),
+	}
+	for _, vm := range data.VirtualMachines {
+		spec.VirtualMachines = append(spec.VirtualMachines, models.FailoverPlanVMModel{
+			ReplicaID:    vm.ReplicaID.ValueString(),
+			BootOrder:    int(vm.BootOrder.ValueInt64()),
+			BootDelaySec: int(vm.BootDelaySeconds.ValueInt64()),
+		})
+	}
+	sort.SliceStable(spec.VirtualMachines, func(i, j int) bool {
+		return spec.VirtualMachines[i].BootOrder < spec.VirtualMachines[j].BootOrder
+	})
+	return spec
+}
+
+func buildFailoverPlanScript(path types.String) *models.FailoverPlanScriptModel {
+	if !isConfigured(path) || path.ValueString() == "" {
+		return &models.FailoverPlanScriptModel{IsEnabled: false}
+	}
+	return &models.FailoverPlanScriptModel{IsEnabled: true, ScriptPath: path.ValueString()}
+}
+
+// syncFailoverPlanFromAPI refreshes the state from a plan response. VMs keep
+// the order of the existing state so that listing them in a different order
+// than the boot order does not cause a diff; new VMs are appended.
+func syncFailoverPlanFromAPI(data *FailoverPlanResourceModel, api *models.FailoverPlanModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+
+	byReplica := make(map[string]models.FailoverPlanVMModel, len(api.VirtualMachines))
+	for _, vm := range api.VirtualMachines {
+		byReplica[vm.ReplicaID] = vm
+	}
+	toState := func(vm models.FailoverPlanVMModel) FailoverPlanVM {
+		return FailoverPlanVM{
+			ReplicaID:        types.StringValue(vm.ReplicaID),
+			BootOrder:        types.Int64Value(int64(vm.BootOrder)),
+			BootDelaySeconds: types.Int64Value(int64(vm.BootDelaySec)),
+		}
+	}
+
+	vms := make([]FailoverPlanVM, 0, len(api.VirtualMachines))
+	for _, existing := range data.VirtualMachines {
+		id := existing.ReplicaID.ValueString()
+		if vm, ok := byReplica[id]; ok {
+			vms = append(vms, toState(vm))
+			delete(byReplica, id)
+		}
+	}
+	for _, vm := range api.VirtualMachines {
+		if _, ok := byReplica[vm.ReplicaID]; ok {
+			vms = append(vms, toState(vm))
+		}
+	}
+	data.VirtualMachines = vms
+
+	data.PreFailoverScript = syncFailoverPlanScriptFromAPI(api.PreFailoverScript)
+	data.PostFailoverScript = syncFailoverPlanScriptFromAPI(api.PostFailoverScript)
+}
+
+func syncFailoverPlanScriptFromAPI(s *models.FailoverPlanScriptModel) types.String {
+	if s == nil || !s.IsEnabled {
+		return types.StringNull()
+	}
+	return stringOrNull(s.ScriptPath)
+}
+
+// isFailoverPlanNotFound reports whether a plan GET failed because the plan
+// was deleted outside Terraform.
+func isFailoverPlanNotFound(err error) bool {
+	var apiErr *models.APIError
+	if errors.As(err, &apiErr) && strings.EqualFold(apiErr.ErrorCode, "NotFound") {
+		return true
+	}
+	errText := strings.ToLower(err.Error())
+	return strings.Contains(errText, "http 404") || strings.Contains(errText, "notfound")
+}
@@ -0,0 +1,263 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func failoverVM(replicaID string, order, delay int64) FailoverPlanVM {
+	return FailoverPlanVM{
+		ReplicaID:        types.StringValue(replicaID),
+		BootOrder:        types.Int64Value(order),
+		BootDelaySeconds: types.Int64Value(delay),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FailoverPlan — buildFailoverPlanSpec
+// ---------------------------------------------------------------------------
+
+func TestFailoverPlan_BuildSpec(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       FailoverPlanResourceModel
+		vms        []models.FailoverPlanVMModel
+		preScript  *models.FailoverPlanScriptModel
+		postScript *models.FailoverPlanScriptModel
+	}{
+		{
+			name: "VMs listed out of boot order",
+			data: FailoverPlanResourceModel{VirtualMachines: []FailoverPlanVM{
+				failoverVM("rep-app", 2, 30), failoverVM("rep-sql", 1, 120), failoverVM("rep-web", 3, 0),
+			}},
+			vms: []models.FailoverPlanVMModel{
+				{ReplicaID: "rep-sql", BootOrder: 1, BootDelaySec: 120},
+				{ReplicaID: "rep-app", BootOrder: 2, BootDelaySec: 30},
+				{ReplicaID: "rep-web", BootOrder: 3, BootDelaySec: 0},
+			},
+			preScript:  &models.FailoverPlanScriptModel{IsEnabled: false},
+			postScript: &models.FailoverPlanScriptModel{IsEnabled: false},
+		},
+		{
+			name: "pre-failover script only",
+			data: FailoverPlanResourceModel{
+				VirtualMachines:    []FailoverPlanVM{failoverVM("rep-sql", 1, 60)},
+				PreFailoverScript:  types.StringValue(`C:\Scripts\pre-failover.ps1`),
+				PostFailoverScript: types.StringNull(),
+			},
+			vms:        []models.FailoverPlanVMModel{{ReplicaID: "rep-sql", BootOrder: 1, BootDelaySec: 60}},
+			preScript:  &models.FailoverPlanScriptModel{IsEnabled: true, ScriptPath: `C:\Scripts\pre-failover.ps1`},
+			postScript: &models.FailoverPlanScriptModel{IsEnabled: false},
+		},
+		{
+			name: "empty post-failover path disables the script",
+			data: FailoverPlanResourceModel{
+				VirtualMachines:    []FailoverPlanVM{failoverVM("rep-sql", 1, 60)},
+				PostFailoverScript: types.StringValue(""),
+			},
+			vms:        []models.FailoverPlanVMModel{{ReplicaID: "rep-sql", BootOrder: 1, BootDelaySec: 60}},
+			preScript:  &models.FailoverPlanScriptModel{IsEnabled: false},
+			postScript: &models.FailoverPlanScriptModel{IsEnabled: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := buildFailoverPlanSpec(&tt.data)
+			assert.Equal(t, models.FailoverPlanTypeVSphere, spec.Type)
+			assert.Equal(t, tt.vms, spec.VirtualMachines)
+			assert.Equal(t, tt.preScript, spec.PreFailoverScript)
+			assert.Equal(t, tt.postScript, spec.PostFailoverScript)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FailoverPlan — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestFailoverPlan_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		vms     []FailoverPlanVM
+		wantErr string
+	}{
+		{
+			name: "two tiers",
+			vms:  []FailoverPlanVM{failoverVM("rep-sql", 1, 120), failoverVM("rep-app", 2, 30)},
+		},
+		{
+			name:    "no VMs",
+			vms:     []FailoverPlanVM{},
+			wantErr: "at least one replica",
+		},
+		{
+			name:    "replica listed twice",
+			vms:     []FailoverPlanVM{failoverVM("rep-sql", 1, 120), failoverVM("rep-sql", 2, 30)},
+			wantErr: "virtual_machines[1]: replica rep-sql is listed more than once",
+		},
+		{
+			name:    "boot order used twice",
+			vms:     []FailoverPlanVM{failoverVM("rep-sql", 1, 120), failoverVM("rep-app", 1, 30)},
+			wantErr: "boot_order 1 is already used by replica rep-sql",
+		},
+		{
+			name:    "boot order zero",
+			vms:     []FailoverPlanVM{failoverVM("rep-sql", 0, 120)},
+			wantErr: "boot_order must be at least 1",
+		},
+		{
+			name:    "negative boot delay",
+			vms:     []FailoverPlanVM{failoverVM("rep-sql", 1, -5)},
+			wantErr: "boot_delay_seconds must not be negative",
+		},
+		{
+			name: "replica IDs from the replicas data source",
+			vms: []FailoverPlanVM{
+				{ReplicaID: types.StringUnknown(), BootOrder: types.Int64Value(1), BootDelaySeconds: types.Int64Null()},
+				{ReplicaID: types.StringUnknown(), BootOrder: types.Int64Value(2), BootDelaySeconds: types.Int64Null()},
+			},
+		},
+		{
+			name: "boot order from a variable",
+			vms: []FailoverPlanVM{
+				failoverVM("rep-sql", 1, 120),
+				{ReplicaID: types.StringValue("rep-app"), BootOrder: types.Int64Unknown(), BootDelaySeconds: types.Int64Null()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &FailoverPlan{}, map[string]interface{}{"name": "Tier1-Failover", "virtual_machines": tt.vms})
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FailoverPlan — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestFailoverPlan_ManagedPaths checks that a script removed from HCL loses
+// its stored path on the server, while a script that is still configured
+// and fields the provider does not model are merged as usual.
+func TestFailoverPlan_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"preFailoverScript":  map[string]interface{}{"isEnabled": true, "scriptPath": `C:\Scripts\old-pre.ps1`},
+		"postFailoverScript": map[string]interface{}{"isEnabled": true, "scriptPath": `C:\Scripts\old-post.ps1`},
+		"consoleOnlySetting": map[string]interface{}{"keep": true},
+	}
+	merged, err := mergeManagedPayload(current, buildFailoverPlanSpec(&FailoverPlanResourceModel{
+		VirtualMachines:    []FailoverPlanVM{failoverVM("rep-sql", 1, 60)},
+		PostFailoverScript: types.StringValue(`C:\Scripts\post.ps1`),
+	}), failoverPlanManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+	}{
+		{"preFailoverScript", map[string]interface{}{"isEnabled": false}},
+		{"postFailoverScript", map[string]interface{}{"isEnabled": true, "scriptPath": `C:\Scripts\post.ps1`}},
+		{"consoleOnlySetting", map[string]interface{}{"keep": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FailoverPlan — syncFailoverPlanFromAPI
+// ---------------------------------------------------------------------------
+
+func TestFailoverPlan_SyncFromAPI(t *testing.T) {
+	api := &models.FailoverPlanModel{
+		ID:   "plan-1",
+		Name: "Tier1-Failover",
+		VirtualMachines: []models.FailoverPlanVMModel{
+			{ReplicaID: "rep-sql", BootOrder: 1, BootDelaySec: 120},
+			{ReplicaID: "rep-app", BootOrder: 2, BootDelaySec: 45},
+			{ReplicaID: "rep-dc", BootOrder: 3, BootDelaySec: 60},
+		},
+		PreFailoverScript:  &models.FailoverPlanScriptModel{IsEnabled: false, ScriptPath: `C:\old.ps1`},
+		PostFailoverScript: &models.FailoverPlanScriptModel{IsEnabled: true, ScriptPath: `C:\post.ps1`},
+	}
+	tests := []struct {
+		name  string
+		prior []FailoverPlanVM
+		want  []FailoverPlanVM
+	}{
+		{
+			name:  "configured order is kept",
+			prior: []FailoverPlanVM{failoverVM("rep-dc", 3, 60), failoverVM("rep-app", 2, 30), failoverVM("rep-sql", 1, 120)},
+			want:  []FailoverPlanVM{failoverVM("rep-dc", 3, 60), failoverVM("rep-app", 2, 45), failoverVM("rep-sql", 1, 120)},
+		},
+		{
+			name:  "VMs added in the console are appended",
+			prior: []FailoverPlanVM{failoverVM("rep-app", 2, 30)},
+			want:  []FailoverPlanVM{failoverVM("rep-app", 2, 45), failoverVM("rep-sql", 1, 120), failoverVM("rep-dc", 3, 60)},
+		},
+		{
+			name:  "VMs removed in the console are dropped",
+			prior: []FailoverPlanVM{failoverVM("rep-web", 4, 0), failoverVM("rep-sql", 1, 120)},
+			want:  []FailoverPlanVM{failoverVM("rep-sql", 1, 120), failoverVM("rep-app", 2, 45), failoverVM("rep-dc", 3, 60)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := FailoverPlanResourceModel{VirtualMachines: tt.prior}
+			syncFailoverPlanFromAPI(&data, api)
+			assert.Equal(t, tt.want, data.VirtualMachines)
+			assert.True(t, data.PreFailoverScript.IsNull(), "disabled scripts read back as null")
+			assert.Equal(t, `C:\post.ps1`, data.PostFailoverScript.ValueString())
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FailoverPlan — Read
+// ---------------------------------------------------------------------------
+
+func TestFailoverPlan_Read_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantRemoved bool
+	}{
+		{name: "API error code", err: &models.APIError{ErrorCode: "NotFound", Message: "Failover plan not found"}, wantRemoved: true},
+		{name: "plain 404", err: errors.New("HTTP 404: Not Found"), wantRemoved: true},
+		{name: "server error", err: errors.New("HTTP 500: Internal Server Error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/failoverPlans/plan-1", mock.Anything).Return(tt.err)
+			r := &FailoverPlan{client: mockClient}
+
+			state := buildNullResourceState(r)
+			require.False(t, state.SetAttribute(context.Background(), path.Root("id"), "plan-1").HasError())
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+			assert.Equal(t, !tt.wantRemoved, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,644 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &FileBackupJob{}
+	_ resource.ResourceWithConfigure      = &FileBackupJob{}
+	_ resource.ResourceWithImportState    = &FileBackupJob{}
+	_ resource.ResourceWithIdentity       = &FileBackupJob{}
+	_ resource.ResourceWithValidateConfig = &FileBackupJob{}
+)
+
+// FileBackupJob implements the veeam_file_backup_job resource.
+type FileBackupJob struct {
+	client client.APIClient
+}
+
+// FileBackupJobResourceModel is the Terraform state model for veeam_file_backup_job.
+type FileBackupJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	// Objects lists the protected shares and paths.
+	Objects []FileBackupObject `tfsdk:"objects"`
+
+	RepositoryID      types.String `tfsdk:"repository_id"`
+	RetentionType     types.String `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64  `tfsdk:"retention_quantity"`
+
+	Archive    *UnstructuredArchive `tfsdk:"archive"`
+	BackupCopy *FileBackupCopy      `tfsdk:"backup_copy"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+// FileBackupObject maps to FileBackupJobObjectModel.
+type FileBackupObject struct {
+	ServerID       types.String `tfsdk:"server_id"`
+	Path           types.String `tfsdk:"path"`
+	InclusionMasks types.List   `tfsdk:"inclusion_masks"`
+	ExclusionMasks types.List   `tfsdk:"exclusion_masks"`
+}
+
+// UnstructuredArchive maps to UnstructuredBackupArchiveModel.
+type UnstructuredArchive struct {
+	RepositoryID      types.String `tfsdk:"repository_id"`
+	RetentionType     types.String `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64  `tfsdk:"retention_quantity"`
+	InclusionMasks    types.List   `tfsdk:"inclusion_masks"`
+	ExclusionMasks    types.List   `tfsdk:"exclusion_masks"`
+}
+
+// FileBackupCopy maps to FileBackupJobCopyModel.
+type FileBackupCopy struct {
+	RepositoryID types.String `tfsdk:"repository_id"`
+}
+
+func (r *FileBackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_backup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// fileBackupJobIdentity keys file backup jobs by UUID and name.
+var fileBackupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "File backup job name.",
+	lookup:         &backupJobImport,
+}
+
+var fileBackupJobResource = jobResource{
+	typeName: "veeam_file_backup_job",
+	kind:     "file backup job",
+	jobTypes: []models.EJobType{models.JobTypeFileBackup},
+}
+
+func (r *FileBackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = fileBackupJobIdentity.schema()
+}
+
+func (r *FileBackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the file backup job (UUID assigned by Veeam).",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the file backup job. Must be unique across all jobs.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+			Required:            true,
+		},
+		"is_disabled": schema.BoolAttribute{
+			MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+				"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"objects": schema.ListNestedAttribute{
+			MarkdownDescription: "Shares and paths to protect. At least one entry is required.",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"server_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the file server or share " +
+							"(`veeam_unstructured_data_server.id`).",
+						Required: true,
+					},
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Share or folder to protect, e.g. `\\\\fs01\\finance` or " +
+							"`/exports/home`. When omitted, the whole server or share is protected.",
+						Optional: true,
+					},
+					"inclusion_masks": schema.ListAttribute{
+						MarkdownDescription: "Back up only files matching these masks, e.g. `*.docx`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"exclusion_masks": schema.ListAttribute{
+						MarkdownDescription: "Skip files matching these masks, e.g. `*.tmp`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"repository_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the backup repository that stores the file versions.",
+			Required:            true,
+		},
+		"archive": unstructuredArchiveAttribute("files"),
+		"backup_copy": schema.SingleNestedAttribute{
+			MarkdownDescription: "Copies the backups to a second repository with a linked " +
+				"`FileBackupCopy` job. Removing the block disables the copy.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"repository_id": schema.StringAttribute{
+					MarkdownDescription: "UUID of the repository that receives the backup copies.",
+					Required:            true,
+				},
+			},
+		},
+		"schedule": scheduleAttribute(),
+	}
+	for k, v := range unstructuredRetentionAttributes("Short-term retention of file versions in `repository_id`.",
+		models.UnstructuredRetentionDays, 28) {
+		attrs[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam file backup job (`FileBackup`) that protects shares " +
+			"and paths of unstructured data servers.",
+		Attributes: attrs,
+	}
+}
+
+// ValidateConfig checks share paths, masks, retention and the archive and
+// backup copy repositories while planning.
+func (r *FileBackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FileBackupJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// objects built with for_each over another resource is unknown here.
+		return
+	}
+	if err := validateFileBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid file backup job configuration", err.Error())
+	}
+}
+
+func (r *FileBackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *FileBackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FileBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateFileBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid file backup job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildFileBackupJobModel(&data, false)
+	spec := &models.FileBackupJobSpec{
+		JobSpec:     models.JobSpec{Name: model.Name, Type: model.Type},
+		Description: model.Description,
+		Objects:     model.Objects,
+		Storage:     model.Storage,
+		Archive:     model.Archive,
+		BackupCopy:  model.BackupCopy,
+		Schedule:    model.Schedule,
+	}
+
+	var result models.FileBackupJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create file backup job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create file backup job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncFileBackupJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(fileBackupJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, fileBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *FileBackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FileBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.FileBackupJobModel
+	if !fileBackupJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncFileBackupJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(fileBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *FileBackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state FileBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateFileBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid file backup job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.FileBackupJobModel
+	payload := buildFileBackupJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, fileBackupJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update file backup job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncFileBackupJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(fileBackupJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, fileBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *FileBackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FileBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileBackupJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *FileBackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fileBackupJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewFileBackupJob returns a new veeam_file_backup_job resource instance.
+func NewFileBackupJob() resource.Resource {
+	return &FileBackupJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// fileBackupJobManagedPaths are cleared on the server when the plan leaves
+// them out, so removing the archive block or its masks, backup_copy or a
+// schedule block takes effect on update.
+var fileBackupJobManagedPaths = []string{
+	"archive.archiveRepositoryId",
+	"archive.retentionPolicy",
+	"archive.inclusionMask",
+	"archive.exclusionMask",
+	"backupCopy.repositoryId",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// unstructuredRetentionTypes lists the accepted retention_type values.
+var unstructuredRetentionTypes = []string{
+	string(models.UnstructuredRetentionDays),
+	string(models.UnstructuredRetentionMonths),
+	string(models.UnstructuredRetentionYears),
+}
+
+// unstructuredRetentionAttributes returns the retention_type and
+// retention_quantity attributes with the given defaults.
+func unstructuredRetentionAttributes(description string, defaultType models.EUnstructuredRetentionType, defaultQuantity int64) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"retention_type": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("%s Unit of `retention_quantity`: `Days`, `Months` or `Years`. "+
+				"Defaults to `%s`.", description, defaultType),
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(string(defaultType)),
+		},
+		"retention_quantity": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("Number of days, months or years versions are kept. "+
+				"Defaults to `%d`.", defaultQuantity),
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultQuantity),
+		},
+	}
+}
+
+// unstructuredArchiveAttribute returns the long-term retention block of
+// file and object storage backup jobs. items names what is archived.
+func unstructuredArchiveAttribute(items string) schema.SingleNestedAttribute {
+	attrs := map[string]schema.Attribute{
+		"repository_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the archive repository, typically object storage.",
+			Required:            true,
+		},
+		"inclusion_masks": schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("Archive only %s matching these masks.", items),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"exclusion_masks": schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("Do not archive %s matching these masks.", items),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+	for k, v := range unstructuredRetentionAttributes("Long-term retention in the archive repository.",
+		models.UnstructuredRetentionYears, 3) {
+		attrs[k] = v
+	}
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("Long-term retention. Versions older than the short-term "+
+			"retention are moved to an archive repository. Removing the block stops archiving %s.", items),
+		Optional:   true,
+		Attributes: attrs,
+	}
+}
+
+// validateFileBackupJob checks that each share path is listed once with
+// valid masks, that retention and archive settings are consistent, that
+// backup_copy targets another repository and that the backup window is well
+// formed.
+func validateFileBackupJob(data *FileBackupJobResourceModel) error {
+	if len(data.Objects) == 0 {
+		return errors.New("objects must list at least one share or path")
+	}
+	seen := map[string]bool{}
+	for i, o := range data.Objects {
+		if isConfigured(o.ServerID) && isConfigured(o.Path) {
+			key := o.ServerID.ValueString() + "|" + o.Path.ValueString()
+			if seen[key] {
+				return fmt.Errorf("objects[%d]: server %s path %q is listed more than once",
+					i, o.ServerID.ValueString(), o.Path.ValueString())
+			}
+			seen[key] = true
+		}
+		if err := validatePatternLists(fmt.Sprintf("objects[%d]", i), "inclusion_masks", "exclusion_masks", o.InclusionMasks, o.ExclusionMasks); err != nil {
+			return err
+		}
+	}
+
+	if err := validateUnstructuredRetention("", data.RetentionType, data.RetentionQuantity); err != nil {
+		return err
+	}
+	if err := validateUnstructuredArchive(data.Archive, data.RepositoryID); err != nil {
+		return err
+	}
+	if bc := data.BackupCopy; bc != nil && isConfigured(bc.RepositoryID) && isConfigured(data.RepositoryID) &&
+		bc.RepositoryID.ValueString() == data.RepositoryID.ValueString() {
+		return errors.New("backup_copy.repository_id must differ from repository_id")
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// validateUnstructuredRetention checks a retention_type / retention_quantity
+// pair; prefix is prepended to the attribute names in errors.
+func validateUnstructuredRetention(prefix string, retentionType types.String, quantity types.Int64) error {
+	if isConfigured(retentionType) && !slices.Contains(unstructuredRetentionTypes, retentionType.ValueString()) {
+		return fmt.Errorf("%sretention_type %q is not supported; expected one of %s",
+			prefix, retentionType.ValueString(), strings.Join(unstructuredRetentionTypes, ", "))
+	}
+	if isConfigured(quantity) && quantity.ValueInt64() < 1 {
+		return fmt.Errorf("%sretention_quantity must be at least 1", prefix)
+	}
+	return nil
+}
+
+// validateUnstructuredArchive checks the archive block against the primary
+// repository.
+func validateUnstructuredArchive(a *UnstructuredArchive, repositoryID types.String) error {
+	if a == nil {
+		return nil
+	}
+	if isConfigured(a.RepositoryID) && isConfigured(repositoryID) && a.RepositoryID.ValueString() == repositoryID.ValueString() {
+		return errors.New("archive.repository_id must differ from repository_id")
+	}
+	if err := validateUnstructuredRetention("archive.", a.RetentionType, a.RetentionQuantity); err != nil {
+		return err
+	}
+	return validatePatternLists("archive", "inclusion_masks", "exclusion_masks", a.InclusionMasks, a.ExclusionMasks)
+}
+
+// validatePatternLists rejects empty entries and entries that are both
+// included and excluded, e.g. file masks or object prefixes.
+func validatePatternLists(path, includeAttr, excludeAttr string, include, exclude types.List) error {
+	included := listStrings(include)
+	for _, v := range included {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%s.%s must not contain empty entries", path, includeAttr)
+		}
+	}
+	for _, v := range listStrings(exclude) {
+		if strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%s.%s must not contain empty entries", path, excludeAttr)
+		}
+		if slices.Contains(included, v) {
+			return fmt.Errorf("%s: %q is both included and excluded", path, v)
+		}
+	}
+	return nil
+}
+
+// buildFileBackupJobModel converts the plan into the full job model used for
+// PUT; Create derives the POST spec from it. Archive and backup copy are
+// always sent so that removing them in HCL disables them on the job.
+func buildFileBackupJobModel(data *FileBackupJobResourceModel, isDisabled bool) *models.FileBackupJobModel {
+	m := &models.FileBackupJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeFileBackup,
+			IsDisabled: isDisabled,
+		},
+		Description: data.Description.ValueString(),
+		Objects:     []models.FileBackupJobObjectModel{},
+		Storage: &models.UnstructuredBackupStorageModel{
+			BackupRepositoryID: data.RepositoryID.ValueString(),
+			RetentionPolicy:    buildUnstructuredRetention(data.RetentionType, data.RetentionQuantity),
+		},
+		Archive:    buildUnstructuredArchive(data.Archive),
+		BackupCopy: &models.FileBackupJobCopyModel{},
+		Schedule:   buildScheduleModel(data.Schedule),
+	}
+
+	for _, o := range data.Objects {
+		m.Objects = append(m.Objects, models.FileBackupJobObjectModel{
+			FileServerID:  o.ServerID.ValueString(),
+			Path:          o.Path.ValueString(),
+			InclusionMask: listStrings(o.InclusionMasks),
+			ExclusionMask: listStrings(o.ExclusionMasks),
+		})
+	}
+
+	if bc := data.BackupCopy; bc != nil {
+		m.BackupCopy = &models.FileBackupJobCopyModel{
+			IsEnabled:    true,
+			RepositoryID: bc.RepositoryID.ValueString(),
+		}
+	}
+	return m
+}
+
+func buildUnstructuredRetention(retentionType types.String, quantity types.Int64) *models.UnstructuredRetentionPolicyModel {
+	return &models.UnstructuredRetentionPolicyModel{
+		Type:     models.EUnstructuredRetentionType(retentionType.ValueString()),
+		Quantity: int(quantity.ValueInt64()),
+	}
+}
+
+// buildUnstructuredArchive returns a disabled archive when a is nil.
+func buildUnstructuredArchive(a *UnstructuredArchive) *models.UnstructuredBackupArchiveModel {
+	if a == nil {
+		return &models.UnstructuredBackupArchiveModel{IsEnabled: false}
+	}
+	return &models.UnstructuredBackupArchiveModel{
+		IsEnabled:           true,
+		ArchiveRepositoryID: a.RepositoryID.ValueString(),
+		RetentionPolicy:     buildUnstructuredRetention(a.RetentionType, a.RetentionQuantity),
+		InclusionMask:       listStrings(a.InclusionMasks),
+		ExclusionMask:       listStrings(a.ExclusionMasks),
+	}
+}
+
+// syncFileBackupJobFromAPI refreshes the state from a job response.
+func syncFileBackupJobFromAPI(data *FileBackupJobResourceModel, api *models.FileBackupJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+
+	if api.Objects != nil {
+		data.Objects = nil
+		for _, o := range api.Objects {
+			data.Objects = append(data.Objects, FileBackupObject{
+				ServerID:       types.StringValue(o.FileServerID),
+				Path:           stringOrNull(o.Path),
+				InclusionMasks: stringListOrNull(o.InclusionMask),
+				ExclusionMasks: stringListOrNull(o.ExclusionMask),
+			})
+		}
+	}
+
+	if s := api.Storage; s != nil {
+		data.RepositoryID = types.StringValue(s.BackupRepositoryID)
+		if rp := s.RetentionPolicy; rp != nil {
+			data.RetentionType = types.StringValue(string(rp.Type))
+			data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+		}
+	}
+
+	data.Archive = syncUnstructuredArchiveFromAPI(api.Archive)
+
+	data.BackupCopy = nil
+	if bc := api.BackupCopy; bc != nil && bc.IsEnabled {
+		data.BackupCopy = &FileBackupCopy{RepositoryID: types.StringValue(bc.RepositoryID)}
+	}
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
+
+// syncUnstructuredArchiveFromAPI returns nil for a missing or disabled archive.
+func syncUnstructuredArchiveFromAPI(api *models.UnstructuredBackupArchiveModel) *UnstructuredArchive {
+	if api == nil || !api.IsEnabled {
+		return nil
+	}
+	a := &UnstructuredArchive{
+		RepositoryID:   types.StringValue(api.ArchiveRepositoryID),
+		InclusionMasks: stringListOrNull(api.InclusionMask),
+		ExclusionMasks: stringListOrNull(api.ExclusionMask),
+	}
+	if rp := api.RetentionPolicy; rp != nil {
+		a.RetentionType = types.StringValue(string(rp.Type))
+		a.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+	} else {
+		a.RetentionType = types.StringValue(string(models.UnstructuredRetentionYears))
+		a.RetentionQuantity = types.Int64Value(3)
+	}
+	return a
+}
+
+// listStrings returns the known elements of a string list; null and unknown
+// lists yield nil.
+func listStrings(l types.List) []string {
+	if l.IsNull() || l.IsUnknown() {
+		return nil
+	}
+	var values []string
+	for _, e := range l.Elements() {
+		if s, ok := e.(types.String); ok && isConfigured(s) {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values
+}
+
+// stringListOrNull maps an empty slice to a null list so that omitted
+// optional lists do not show a diff.
+func stringListOrNull(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	l, _ := types.ListValueFrom(context.Background(), types.StringType, values)
+	return l
+}
@@ -0,0 +1,307 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// fileShare protects path on serverID without file masks.
+func fileShare(serverID, path string) FileBackupObject {
+	return FileBackupObject{
+		ServerID:       types.StringValue(serverID),
+		Path:           types.StringValue(path),
+		InclusionMasks: types.ListNull(types.StringType),
+		ExclusionMasks: types.ListNull(types.StringType),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FileBackupJob — buildFileBackupJobModel
+// ---------------------------------------------------------------------------
+
+func TestFileBackupJob_BuildModel(t *testing.T) {
+	finance := fileShare("fs-1", `\\fs01\finance`)
+	finance.ExclusionMasks = stringList("*.tmp", "~$*")
+	tests := []struct {
+		name       string
+		data       FileBackupJobResourceModel
+		objects    []models.FileBackupJobObjectModel
+		archive    *models.UnstructuredBackupArchiveModel
+		backupCopy *models.FileBackupJobCopyModel
+	}{
+		{
+			name:       "share with exclusion masks",
+			data:       FileBackupJobResourceModel{Objects: []FileBackupObject{finance}},
+			objects:    []models.FileBackupJobObjectModel{{FileServerID: "fs-1", Path: `\\fs01\finance`, ExclusionMask: []string{"*.tmp", "~$*"}}},
+			archive:    &models.UnstructuredBackupArchiveModel{IsEnabled: false},
+			backupCopy: &models.FileBackupJobCopyModel{},
+		},
+		{
+			name: "whole NAS archived for seven years",
+			data: FileBackupJobResourceModel{
+				Objects: []FileBackupObject{{
+					ServerID:       types.StringValue("nas-1"),
+					Path:           types.StringNull(),
+					InclusionMasks: types.ListNull(types.StringType),
+					ExclusionMasks: types.ListNull(types.StringType),
+				}},
+				Archive: &UnstructuredArchive{
+					RepositoryID:      types.StringValue("repo-archive"),
+					RetentionType:     types.StringValue("Years"),
+					RetentionQuantity: types.Int64Value(7),
+					InclusionMasks:    stringList("*.pdf"),
+					ExclusionMasks:    types.ListNull(types.StringType),
+				},
+			},
+			objects: []models.FileBackupJobObjectModel{{FileServerID: "nas-1"}},
+			archive: &models.UnstructuredBackupArchiveModel{
+				IsEnabled:           true,
+				ArchiveRepositoryID: "repo-archive",
+				RetentionPolicy:     &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionYears, Quantity: 7},
+				InclusionMask:       []string{"*.pdf"},
+			},
+			backupCopy: &models.FileBackupJobCopyModel{},
+		},
+		{
+			name: "secondary copy",
+			data: FileBackupJobResourceModel{
+				Objects:    []FileBackupObject{fileShare("fs-1", `\\fs01\hr`)},
+				BackupCopy: &FileBackupCopy{RepositoryID: types.StringValue("repo-dr")},
+			},
+			objects:    []models.FileBackupJobObjectModel{{FileServerID: "fs-1", Path: `\\fs01\hr`}},
+			archive:    &models.UnstructuredBackupArchiveModel{IsEnabled: false},
+			backupCopy: &models.FileBackupJobCopyModel{IsEnabled: true, RepositoryID: "repo-dr"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.data.RepositoryID = types.StringValue("repo-1")
+			tt.data.RetentionType = types.StringValue("Days")
+			tt.data.RetentionQuantity = types.Int64Value(30)
+			m := buildFileBackupJobModel(&tt.data, false)
+			assert.Equal(t, models.JobTypeFileBackup, m.Type)
+			assert.Equal(t, tt.objects, m.Objects)
+			assert.Equal(t, &models.UnstructuredBackupStorageModel{
+				BackupRepositoryID: "repo-1",
+				RetentionPolicy:    &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionDays, Quantity: 30},
+			}, m.Storage)
+			assert.Equal(t, tt.archive, m.Archive)
+			assert.Equal(t, tt.backupCopy, m.BackupCopy)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FileBackupJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestFileBackupJob_ValidateConfig(t *testing.T) {
+	withMasks := func(include, exclude types.List) []FileBackupObject {
+		o := fileShare("fs-1", `\\fs01\finance`)
+		o.InclusionMasks, o.ExclusionMasks = include, exclude
+		return []FileBackupObject{o}
+	}
+	archive := func(repositoryID types.String, quantity int64) *UnstructuredArchive {
+		return &UnstructuredArchive{
+			RepositoryID:      repositoryID,
+			RetentionQuantity: types.Int64Value(quantity),
+			InclusionMasks:    types.ListNull(types.StringType),
+			ExclusionMasks:    types.ListNull(types.StringType),
+		}
+	}
+	unknownPath := fileShare("fs-1", "")
+	unknownPath.Path = types.StringUnknown()
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "two shares on one server",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`), fileShare("fs-1", `\\fs01\hr`)}},
+		},
+		{
+			name:    "no objects",
+			attrs:   map[string]interface{}{"objects": []FileBackupObject{}},
+			wantErr: "at least one share or path",
+		},
+		{
+			name:    "share listed twice",
+			attrs:   map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`), fileShare("fs-1", `\\fs01\finance`)}},
+			wantErr: `objects[1]: server fs-1 path "\\\\fs01\\finance" is listed more than once`,
+		},
+		{
+			name:    "blank mask",
+			attrs:   map[string]interface{}{"objects": withMasks(types.ListNull(types.StringType), stringList("*.tmp", " "))},
+			wantErr: "objects[0].exclusion_masks must not contain empty entries",
+		},
+		{
+			name:    "mask included and excluded",
+			attrs:   map[string]interface{}{"objects": withMasks(stringList("*.tmp"), stringList("*.tmp"))},
+			wantErr: `"*.tmp" is both included and excluded`,
+		},
+		{
+			name:    "retention in weeks",
+			attrs:   map[string]interface{}{"objects": withMasks(types.ListNull(types.StringType), types.ListNull(types.StringType)), "retention_type": "Weeks"},
+			wantErr: `retention_type "Weeks" is not supported`,
+		},
+		{
+			name: "archive to the backup repository",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)},
+				"repository_id": "repo-1", "archive": archive(types.StringValue("repo-1"), 7)},
+			wantErr: "archive.repository_id must differ from repository_id",
+		},
+		{
+			name: "archive kept for no years",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)},
+				"repository_id": "repo-1", "archive": archive(types.StringValue("repo-archive"), 0)},
+			wantErr: "archive.retention_quantity must be at least 1",
+		},
+		{
+			name: "copy to the backup repository",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)},
+				"repository_id": "repo-1", "backup_copy": &FileBackupCopy{RepositoryID: types.StringValue("repo-1")}},
+			wantErr: "backup_copy.repository_id must differ from repository_id",
+		},
+		{
+			name:  "paths from another resource",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{unknownPath, unknownPath}},
+		},
+		{
+			name: "mask from a variable",
+			attrs: map[string]interface{}{"objects": withMasks(
+				types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*.tmp"), types.StringUnknown()}),
+			)},
+		},
+		{
+			name: "repositories from data sources",
+			attrs: map[string]interface{}{"objects": []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)},
+				"repository_id": types.StringUnknown(), "archive": archive(types.StringUnknown(), 7)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &FileBackupJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FileBackupJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestFileBackupJob_ManagedPaths checks that archive settings, masks and the
+// secondary copy removed from HCL are cleared on the job, while advanced
+// settings the resource does not manage survive the update.
+func TestFileBackupJob_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"archive": map[string]interface{}{
+			"isEnabled":           true,
+			"archiveRepositoryId": "repo-archive",
+			"retentionPolicy":     map[string]interface{}{"type": "Years", "quantity": float64(7)},
+			"exclusionMask":       []interface{}{"*.iso"},
+		},
+		"backupCopy":       map[string]interface{}{"isEnabled": true, "repositoryId": "repo-dr"},
+		"advancedSettings": map[string]interface{}{"fileVersions": "All"},
+	}
+	tests := []struct {
+		name    string
+		archive *UnstructuredArchive
+		section string
+		want    interface{}
+	}{
+		{
+			name:    "archive removed",
+			section: "archive",
+			want:    map[string]interface{}{"isEnabled": false},
+		},
+		{
+			name: "archive exclusion mask removed",
+			archive: &UnstructuredArchive{
+				RepositoryID:      types.StringValue("repo-archive"),
+				RetentionType:     types.StringValue("Years"),
+				RetentionQuantity: types.Int64Value(7),
+				InclusionMasks:    types.ListNull(types.StringType),
+				ExclusionMasks:    types.ListNull(types.StringType),
+			},
+			section: "archive",
+			want: map[string]interface{}{
+				"isEnabled":           true,
+				"archiveRepositoryId": "repo-archive",
+				"retentionPolicy":     map[string]interface{}{"type": "Years", "quantity": float64(7)},
+			},
+		},
+		{
+			name:    "backup copy removed",
+			section: "backupCopy",
+			want:    map[string]interface{}{"isEnabled": false},
+		},
+		{
+			name:    "advanced settings kept",
+			section: "advancedSettings",
+			want:    map[string]interface{}{"fileVersions": "All"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := FileBackupJobResourceModel{
+				Objects:      []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)},
+				RepositoryID: types.StringValue("repo-1"),
+				Archive:      tt.archive,
+			}
+			merged, err := mergeManagedPayload(current, buildFileBackupJobModel(&data, false), fileBackupJobManagedPaths...)
+			require.NoError(t, err)
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// FileBackupJob — syncFileBackupJobFromAPI
+// ---------------------------------------------------------------------------
+
+func TestFileBackupJob_SyncFromAPI(t *testing.T) {
+	t.Run("copy disabled and masks cleared in the console", func(t *testing.T) {
+		finance := fileShare("fs-1", `\\fs01\finance`)
+		finance.ExclusionMasks = stringList("*.tmp")
+		data := FileBackupJobResourceModel{
+			Objects:    []FileBackupObject{finance},
+			BackupCopy: &FileBackupCopy{RepositoryID: types.StringValue("repo-dr")},
+		}
+		syncFileBackupJobFromAPI(&data, &models.FileBackupJobModel{
+			JobModel:   models.JobModel{ID: "fb-1", Name: "Finance-Files", Type: models.JobTypeFileBackup},
+			Objects:    []models.FileBackupJobObjectModel{{FileServerID: "fs-1", Path: `\\fs01\finance`}},
+			BackupCopy: &models.FileBackupJobCopyModel{IsEnabled: false, RepositoryID: "repo-dr"},
+		})
+		assert.Nil(t, data.BackupCopy)
+		assert.Nil(t, data.Archive)
+		assert.Equal(t, []FileBackupObject{fileShare("fs-1", `\\fs01\finance`)}, data.Objects)
+	})
+
+	t.Run("archive without a retention policy", func(t *testing.T) {
+		var data FileBackupJobResourceModel
+		syncFileBackupJobFromAPI(&data, &models.FileBackupJobModel{
+			JobModel: models.JobModel{ID: "fb-1", Type: models.JobTypeFileBackup},
+			Archive:  &models.UnstructuredBackupArchiveModel{IsEnabled: true, ArchiveRepositoryID: "repo-archive"},
+		})
+		require.NotNil(t, data.Archive)
+		assert.Equal(t, "Years", data.Archive.RetentionType.ValueString())
+		assert.Equal(t, int64(3), data.Archive.RetentionQuantity.ValueInt64())
+		assert.True(t, data.Archive.InclusionMasks.IsNull())
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &GeneralOptions{}
 	_ resource.ResourceWithConfigure   = &GeneralOptions{}
 	_ resource.ResourceWithImportState = &GeneralOptions{}
+	_ resource.ResourceWithIdentity    = &GeneralOptions{}
 )
 
 // generalOptionsID is the fixed singleton ID used in Terraform state.
@@ -68,6 +68,10 @@ func (r *GeneralOptions) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_general_options"
 }
 
+func (r *GeneralOptions) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *GeneralOptions) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateString := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
 	useStateBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
@@ -207,6 +211,7 @@ func (r *GeneralOptions) Create(ctx context.Context, req resource.CreateRequest,
 
 	data.ID = types.StringValue(generalOptionsID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Read fetches the current server state and syncs it to Terraform state.
@@ -228,6 +233,7 @@ func (r *GeneralOptions) Read(ctx context.Context, req resource.ReadRequest, res
 	syncGeneralOptionsFromPayload(payload, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Update applies plan changes via GET → merge → PUT.
@@ -253,6 +259,7 @@ func (r *GeneralOptions) Update(ctx context.Context, req resource.UpdateRequest,
 
 	data.ID = types.StringValue(generalOptionsID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Delete removes the resource from Terraform state only. The server-side
@@ -264,7 +271,7 @@ func (r *GeneralOptions) Delete(_ context.Context, _ resource.DeleteRequest, _ *
 
 // ImportState supports `terraform import veeam_general_options.name general-options`.
 func (r *GeneralOptions) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &GlobalVMExclusion{}
 	_ resource.ResourceWithConfigure   = &GlobalVMExclusion{}
 	_ resource.ResourceWithImportState = &GlobalVMExclusion{}
+	_ resource.ResourceWithIdentity    = &GlobalVMExclusion{}
 )
 
 // GlobalVMExclusion implements the veeam_global_vm_exclusion resource.
@@ -49,6 +49,10 @@ func (r *GlobalVMExclusion) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_global_vm_exclusion"
 }
 
+func (r *GlobalVMExclusion) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *GlobalVMExclusion) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a global VM exclusion entry in Veeam Backup & Replication " +
@@ -143,6 +147,7 @@ func (r *GlobalVMExclusion) Create(ctx context.Context, req resource.CreateReque
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *GlobalVMExclusion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -164,6 +169,7 @@ func (r *GlobalVMExclusion) Read(ctx context.Context, req resource.ReadRequest,
 
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Update is a pass-through. Because all mutable fields carry RequiresReplace,
@@ -175,6 +181,7 @@ func (r *GlobalVMExclusion) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *GlobalVMExclusion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -195,7 +202,7 @@ func (r *GlobalVMExclusion) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *GlobalVMExclusion) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+// ---------------------------------------------------------------------------
+// Resource identity (Terraform 1.12+)
+//
+// Every resource exposes an identity holding the value of its "id" attribute
+// (the Veeam UUID, or the fixed ID of singleton resources). Where VBR has a
+// natural key (job name, server host name, credential user name, ...) it is
+// stored alongside, so `import { identity = { ... } }` blocks and list queries
+// can address objects without looking up UUIDs first.
+// ---------------------------------------------------------------------------
+
+// resourceIdentity describes the identity schema of a resource.
+type resourceIdentity struct {
+	// key is the identity attribute holding the natural key; empty for
+	// resources identified by ID only.
+	key string
+	// keyDescription documents the natural key attribute.
+	keyDescription string
+	// lookup, when set, allows importing by the natural key alone.
+	lookup *naturalKeyImport
+}
+
+// idOnlyIdentity is the identity of resources without a natural key,
+// including the singleton settings resources.
+var idOnlyIdentity = resourceIdentity{}
+
+// schema returns the identity schema. "id" is required for import unless the
+// natural key can be resolved through a list endpoint.
+func (ri resourceIdentity) schema() identityschema.Schema {
+	idAttr := identityschema.StringAttribute{
+		Description: "Resource identifier as stored in the `id` attribute.",
+	}
+	if ri.key != "" && ri.lookup != nil {
+		idAttr.OptionalForImport = true
+	} else {
+		idAttr.RequiredForImport = true
+	}
+
+	attrs := map[string]identityschema.Attribute{"id": idAttr}
+	if ri.key != "" {
+		attrs[ri.key] = identityschema.StringAttribute{
+			Description:       ri.keyDescription,
+			OptionalForImport: true,
+		}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
+
+// set writes the identity after Create, Read, Update or ImportState. It is a
+// no-op when Terraform does not support identities (identity is nil).
+func (ri resourceIdentity) set(ctx context.Context, identity *tfsdk.ResourceIdentity, id, key types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+	// Identity data must be known; computed values that are still unknown
+	// (e.g. a name the API did not echo back) are stored as null.
+	if id.IsUnknown() {
+		id = types.StringNull()
+	}
+	if key.IsUnknown() {
+		key = types.StringNull()
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+	if ri.key != "" {
+		diags.Append(identity.SetAttribute(ctx, path.Root(ri.key), key)...)
+	}
+	return diags
+}
+
+// importState is the shared ImportState implementation. It accepts a plain
+// ID, a "<key>:<value>" import ID when a lookup is configured, or an identity
+// import block with either "id" or the natural key set.
+func (ri resourceIdentity) importState(ctx context.Context, c client.APIClient, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID := req.ID
+	key := types.StringNull()
+
+	if importID == "" && req.Identity != nil {
+		var id types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		if ri.key != "" {
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(ri.key), &key)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case id.ValueString() != "":
+			importID = id.ValueString()
+		case ri.lookup != nil && key.ValueString() != "":
+			importID = ri.key + ":" + key.ValueString()
+		default:
+			resp.Diagnostics.AddError("Missing import identity",
+				fmt.Sprintf("The import identity must set the %s attribute.", ri.importAttributes()))
+			return
+		}
+	}
+
+	id := importID
+	if ri.lookup != nil {
+		resolved, err := resolveImportID(ctx, c, *ri.lookup, importID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to import %s", ri.lookup.kind), err.Error())
+			return
+		}
+		id = resolved
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(ri.set(ctx, resp.Identity, types.StringValue(id), key)...)
+}
+
+func (ri resourceIdentity) importAttributes() string {
+	if ri.key != "" && ri.lookup != nil {
+		return "id or " + ri.key
+	}
+	return "id"
+}
@@ -0,0 +1,271 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// nullIdentity returns an empty identity for r, as the framework pre-populates
+// it before calling Create, Read or ImportState.
+func nullIdentity(t *testing.T, r resource.Resource) *tfsdk.ResourceIdentity {
+	t.Helper()
+	ir, ok := r.(resource.ResourceWithIdentity)
+	require.True(t, ok, "%T does not implement ResourceWithIdentity", r)
+
+	var resp resource.IdentitySchemaResponse
+	ir.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	return &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// identityWith returns an identity for r with the given attribute values set.
+func identityWith(t *testing.T, r resource.Resource, values map[string]string) *tfsdk.ResourceIdentity {
+	t.Helper()
+	identity := nullIdentity(t, r)
+	objType := identity.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for k, attrType := range objType.AttributeTypes {
+		if v, ok := values[k]; ok {
+			vals[k] = tftypes.NewValue(attrType, v)
+		} else {
+			vals[k] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	identity.Raw = tftypes.NewValue(objType, vals)
+	return identity
+}
+
+func identityString(t *testing.T, identity *tfsdk.ResourceIdentity, attr string) types.String {
+	t.Helper()
+	var v types.String
+	require.False(t, identity.GetAttribute(context.Background(), path.Root(attr), &v).HasError())
+	return v
+}
+
+func TestResourceIdentity_AllResourcesImplement(t *testing.T) {
+	constructors := []func() resource.Resource{
+		NewADDomain, NewAgentBackupPolicy, NewApplicationGroup, NewBackupCopyJob, NewBackupJob, NewCloudCredential,
+		NewConfigurationBackup, NewCredential, NewEmailSettings, NewEncryptionPassword, NewEntraIDAuditLogBackupJob,
+		NewEntraIDTenant, NewEntraIDTenantBackupJob, NewEventForwarding, NewFailoverPlan, NewFileBackupJob,
+		NewGeneralOptions, NewGlobalVMExclusion, NewJobRun, NewKMSServer, NewManagedServer, NewMountServer,
+		NewNotificationSettings, NewObjectStorageBackupJob, NewProtectionGroup, NewProxy, NewRecoveryToken,
+		NewReplicationJob, NewRepository, NewScaleOutRepository, NewSecurityAnalyzerSchedule, NewSecuritySettings,
+		NewSecurityUser, NewStorageLatency, NewSureBackupJob, NewTrafficRules, NewUnstructuredDataServer, NewVirtualLab,
+		NewVSphereServer,
+	}
+
+	for _, newResource := range constructors {
+		r := newResource()
+		ir, ok := r.(resource.ResourceWithIdentity)
+		require.True(t, ok, "%T does not implement ResourceWithIdentity", r)
+
+		var resp resource.IdentitySchemaResponse
+		ir.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), "%T", r)
+		assert.False(t, resp.IdentitySchema.ValidateImplementation(context.Background()).HasError(), "%T", r)
+		assert.Contains(t, resp.IdentitySchema.Attributes, "id", "%T", r)
+	}
+}
+
+func TestResourceIdentity_Schema(t *testing.T) {
+	idOnly := idOnlyIdentity.schema()
+	require.Len(t, idOnly.Attributes, 1)
+	assert.True(t, idOnly.Attributes["id"].IsRequiredForImport())
+
+	withLookup := backupJobIdentity.schema()
+	require.Len(t, withLookup.Attributes, 2)
+	assert.True(t, withLookup.Attributes["id"].IsOptionalForImport())
+	assert.True(t, withLookup.Attributes["name"].IsOptionalForImport())
+
+	withoutLookup := proxyIdentity.schema()
+	assert.True(t, withoutLookup.Attributes["id"].IsRequiredForImport())
+	assert.IsType(t, identityschema.StringAttribute{}, withoutLookup.Attributes["name"])
+}
+
+func TestResourceIdentity_SetNilIdentity(t *testing.T) {
+	diags := backupJobIdentity.set(context.Background(), nil, types.StringValue("job-1"), types.StringValue("Job"))
+	assert.False(t, diags.HasError())
+}
+
+func TestResourceIdentity_SetUnknownKeyStoredAsNull(t *testing.T) {
+	r := &Proxy{}
+	identity := nullIdentity(t, r)
+	diags := proxyIdentity.set(context.Background(), identity, types.StringValue("proxy-1"), types.StringUnknown())
+	require.False(t, diags.HasError())
+	assert.Equal(t, "proxy-1", identityString(t, identity, "id").ValueString())
+	assert.True(t, identityString(t, identity, "name").IsNull())
+}
+
+func TestCredential_Create_SetsIdentity(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &Credential{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, client.PathCredentials, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(3).(*models.CredentialsModel)
+			result.ID = "cred-1"
+			result.Username = `CORP\svc`
+			result.Type = "Standard"
+		}).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), CredentialModel{
+		Username: types.StringValue(`CORP\svc`),
+		Password: types.StringValue("secret"),
+		Type:     types.StringValue("Standard"),
+	}).HasError())
+
+	resp := &resource.CreateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)},
+		Identity: nullIdentity(t, r),
+	}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, "cred-1", identityString(t, resp.Identity, "id").ValueString())
+	assert.Equal(t, `CORP\svc`, identityString(t, resp.Identity, "username").ValueString())
+}
+
+func TestCredential_Read_SetsIdentity(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &Credential{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/credentials/cred-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*models.CredentialsModel)
+			result.ID = "cred-1"
+			result.Username = "renamed"
+			result.Type = "Standard"
+		}).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), CredentialModel{
+		ID:       types.StringValue("cred-1"),
+		Username: types.StringValue("original"),
+		Type:     types.StringValue("Standard"),
+	}).HasError())
+
+	resp := &resource.ReadResponse{State: state, Identity: identityWith(t, r, map[string]string{"id": "cred-1", "username": "original"})}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, "cred-1", identityString(t, resp.Identity, "id").ValueString())
+	assert.Equal(t, "renamed", identityString(t, resp.Identity, "username").ValueString())
+}
+
+func TestEventForwarding_Read_SetsSingletonIdentity(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &EventForwarding{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, client.PathEventForwarding, mock.Anything).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), EventForwardingModel{ID: types.StringValue(eventForwardingID)}).HasError())
+
+	resp := &resource.ReadResponse{State: state, Identity: nullIdentity(t, r)}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, eventForwardingID, identityString(t, resp.Identity, "id").ValueString())
+}
+
+func importWithIdentity(t *testing.T, r resourceWithImportState, id string, identity *tfsdk.ResourceIdentity) *resource.ImportStateResponse {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    nullObjectForResourceSchema(schemaResp.Schema.Type().TerraformType(context.Background())),
+		},
+		Identity: nullIdentity(t, r),
+	}
+	if identity != nil {
+		resp.Identity = identity
+	}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: id, Identity: identity}, resp)
+	return resp
+}
+
+func TestImportState_ByID_SetsIdentity(t *testing.T) {
+	r := &Proxy{}
+	resp := importWithIdentity(t, r, "proxy-1", nil)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m ProxyModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "proxy-1", m.ID.ValueString())
+	assert.Equal(t, "proxy-1", identityString(t, resp.Identity, "id").ValueString())
+}
+
+func TestImportState_ByIdentityID(t *testing.T) {
+	r := &TrafficRules{}
+	resp := importWithIdentity(t, r, "", identityWith(t, r, map[string]string{"id": "traffic-rules"}))
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m TrafficRulesModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "traffic-rules", m.ID.ValueString())
+}
+
+func TestImportState_ByIdentityNaturalKey(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathJobs,
+		map[string]interface{}{"id": "job-1", "name": "Daily-SQL", "type": "VSphereBackup"},
+	)
+
+	r := &BackupJob{client: mockClient}
+	resp := importWithIdentity(t, r, "", identityWith(t, r, map[string]string{"name": "Daily-SQL"}))
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m BackupJobModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "job-1", m.ID.ValueString())
+	assert.Equal(t, "job-1", identityString(t, resp.Identity, "id").ValueString())
+	assert.Equal(t, "Daily-SQL", identityString(t, resp.Identity, "name").ValueString())
+}
+
+func TestImportState_ByNaturalKeyID_SetsIdentity(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathRepositories,
+		map[string]interface{}{"id": "repo-1", "name": "Repo01", "type": "WinLocal"},
+	)
+
+	r := &Repository{client: mockClient}
+	resp := importWithIdentity(t, r, "name:Repo01", nil)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, "repo-1", identityString(t, resp.Identity, "id").ValueString())
+}
+
+func TestImportState_IdentityMissingAttributes(t *testing.T) {
+	r := &BackupJob{}
+	resp := importWithIdentity(t, r, "", identityWith(t, r, map[string]string{}))
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "id or name")
+}
@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+// ---------------------------------------------------------------------------
+// Natural-key import
+//
+// Besides the Veeam UUID, selected resources accept "<key>:<value>" import IDs
+// (e.g. "name:Daily-SQL", "host:vcsa01.corp", "username:DOMAIN\svc"). The value
+// is resolved through the resource's list endpoint; exactly one entry must
+// match, otherwise the import fails with the list of candidates.
+// ---------------------------------------------------------------------------
+
+// naturalKeyImport describes how a resource resolves natural-key import IDs.
+type naturalKeyImport struct {
+	// kind is the human-readable resource kind used in diagnostics.
+	kind string
+	// listEndpoint is the collection endpoint returning {"data": [...]}.
+	listEndpoint string
+	// keys maps the import ID prefix to the list entry field it matches.
+	keys map[string]string
+	// entryType optionally restricts matches to entries of this "type".
+	entryType string
+}
+
+// resolveImportID returns the UUID for importID. IDs without a recognised
+// "<key>:" prefix are returned as-is.
+func resolveImportID(ctx context.Context, c client.APIClient, lookup naturalKeyImport, importID string) (string, error) {
+	prefix, value, found := strings.Cut(importID, ":")
+	if !found {
+		return importID, nil
+	}
+	field, ok := lookup.keys[prefix]
+	if !ok {
+		return importID, nil
+	}
+	if value == "" {
+		return "", fmt.Errorf("import ID %q has an empty %s value", importID, prefix)
+	}
+	if c == nil {
+		return "", fmt.Errorf("provider is not configured; cannot resolve import ID %q", importID)
+	}
+
+	matches, err := naturalKeyMatches(ctx, c, lookup, field, value)
+	if err != nil {
+		return "", err
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s with %s %q was found", lookup.kind, prefix, value)
+	case 1:
+		return getStringValue(matches[0], "id"), nil
+	}
+	return "", fmt.Errorf("%s %q matches %d %ss; import by UUID instead. Candidates:\n  - %s",
+		prefix, value, len(matches), lookup.kind, naturalKeyCandidates(matches, field))
+}
+
+// naturalKeyMatches returns the list entries whose field equals value
+// (case-insensitive). Entries without an ID are skipped.
+func naturalKeyMatches(ctx context.Context, c client.APIClient, lookup naturalKeyImport, field, value string) ([]map[string]interface{}, error) {
+	var payload map[string]interface{}
+	if err := c.GetJSON(ctx, lookup.listEndpoint, &payload); err != nil {
+		return nil, fmt.Errorf("failed to list %ss: %w", lookup.kind, err)
+	}
+	rawData, ok := payload["data"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s list response shape: missing data array", lookup.kind)
+	}
+
+	var matches []map[string]interface{}
+	for _, item := range rawData {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if lookup.entryType != "" && !strings.EqualFold(getStringValue(entry, "type"), lookup.entryType) {
+			continue
+		}
+		if strings.EqualFold(getStringValue(entry, field), value) && getStringValue(entry, "id") != "" {
+			matches = append(matches, entry)
+		}
+	}
+	return matches, nil
+}
+
+// naturalKeyCandidates formats ambiguous matches for an error message, one
+// per line, sorted.
+func naturalKeyCandidates(matches []map[string]interface{}, field string) string {
+	candidates := make([]string, 0, len(matches))
+	for _, m := range matches {
+		candidate := fmt.Sprintf("%s (id: %s", getStringValue(m, field), getStringValue(m, "id"))
+		if t := getStringValue(m, "type"); t != "" {
+			candidate += ", type: " + t
+		}
+		candidates = append(candidates, candidate+")")
+	}
+	sort.Strings(candidates)
+	return strings.Join(candidates, "\n  - ")
+}
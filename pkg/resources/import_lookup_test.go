@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+func mockListResponse(m *MockVeeamClient, endpoint string, entries ...map[string]interface{}) {
+	data := make([]interface{}, 0, len(entries))
+	for _, e := range entries {
+		data = append(data, e)
+	}
+	m.On("GetJSON", mock.Anything, endpoint, mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{"data": data}
+		}).Return(nil)
+}
+
+func TestResolveImportID_UUIDPassthrough(t *testing.T) {
+	id, err := resolveImportID(context.Background(), nil, backupJobImport, "0f8e7d6c-1111-2222-3333-444455556666")
+	require.NoError(t, err)
+	assert.Equal(t, "0f8e7d6c-1111-2222-3333-444455556666", id)
+}
+
+func TestResolveImportID_UnknownPrefixPassthrough(t *testing.T) {
+	id, err := resolveImportID(context.Background(), nil, backupJobImport, "host:vcsa01")
+	require.NoError(t, err)
+	assert.Equal(t, "host:vcsa01", id)
+}
+
+func TestResolveImportID_EmptyValue(t *testing.T) {
+	_, err := resolveImportID(context.Background(), nil, backupJobImport, "name:")
+	assert.Error(t, err)
+}
+
+func TestResolveImportID_ListError(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockClient.On("GetJSON", mock.Anything, client.PathJobs, mock.Anything).Return(errors.New("boom"))
+
+	_, err := resolveImportID(context.Background(), mockClient, backupJobImport, "name:Daily-SQL")
+	assert.ErrorContains(t, err, "boom")
+}
+
+func TestImportState_BackupJob_ByName(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathJobs,
+		map[string]interface{}{"id": "job-1", "name": "Daily-SQL", "type": "VSphereBackup"},
+		map[string]interface{}{"id": "job-2", "name": "Weekly", "type": "VSphereBackup"},
+	)
+
+	r := &BackupJob{client: mockClient}
+	resp := importStateWithID(t, r, "name:daily-sql")
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m BackupJobModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "job-1", m.ID.ValueString())
+}
+
+func TestImportState_BackupJob_ByName_NotFound(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathJobs,
+		map[string]interface{}{"id": "job-2", "name": "Weekly", "type": "VSphereBackup"},
+	)
+
+	r := &BackupJob{client: mockClient}
+	resp := importStateWithID(t, r, "name:Daily-SQL")
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), `no backup job with name "Daily-SQL"`)
+}
+
+func TestImportState_Credential_Ambiguous(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathCredentials,
+		map[string]interface{}{"id": "cred-1", "username": `CORP\svc`, "type": "Standard"},
+		map[string]interface{}{"id": "cred-2", "username": `CORP\svc`, "type": "Linux"},
+		map[string]interface{}{"id": "cred-3", "username": `CORP\other`, "type": "Standard"},
+	)
+
+	r := &Credential{client: mockClient}
+	resp := importStateWithID(t, r, `username:CORP\svc`)
+	require.True(t, resp.Diagnostics.HasError())
+	detail := resp.Diagnostics[0].Detail()
+	assert.Contains(t, detail, "matches 2 credentials")
+	assert.Contains(t, detail, "cred-1")
+	assert.Contains(t, detail, "cred-2")
+	assert.NotContains(t, detail, "cred-3")
+}
+
+func TestImportState_Repository_ByName(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathRepositories,
+		map[string]interface{}{"id": "repo-1", "name": "Repo01", "type": "WinLocal"},
+	)
+
+	r := &Repository{client: mockClient}
+	resp := importStateWithID(t, r, "name:Repo01")
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m RepositoryModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "repo-1", m.ID.ValueString())
+}
+
+func TestImportState_ManagedServer_ByHost(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathManagedServers,
+		map[string]interface{}{"id": "srv-1", "name": "linux01.corp", "type": "LinuxHost"},
+	)
+
+	r := &ManagedServer{client: mockClient}
+	resp := importStateWithID(t, r, "host:linux01.corp")
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m ManagedServerModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "srv-1", m.ID.ValueString())
+}
+
+func TestImportState_VSphereServer_ByHost_FiltersType(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathManagedServers,
+		map[string]interface{}{"id": "srv-win", "name": "vcsa01.corp", "type": "WindowsHost"},
+		map[string]interface{}{"id": "srv-vc", "name": "vcsa01.corp", "type": "ViHost"},
+	)
+
+	r := &VSphereServer{client: mockClient}
+	resp := importStateWithID(t, r, "host:vcsa01.corp")
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+
+	var m VSphereServerModel
+	require.False(t, resp.State.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "srv-vc", m.ID.ValueString())
+}
@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// ---------------------------------------------------------------------------
+// Job resources
+//
+// The dedicated job resources (veeam_backup_copy_job, veeam_replication_job,
+// veeam_file_backup_job, ...) each manage jobs of one family through the
+// polymorphic /api/v1/jobs endpoint that veeam_backup_job also uses, and
+// reuse its schedule and scope blocks. Job names are unique across every job
+// type, so all of them import by name through backupJobImport.
+//
+// They share how a job deleted outside Terraform or a job of another family
+// is handled on read, how a job is deleted and how a failed enable/disable
+// request is reported; the helpers below hold that behaviour so each resource
+// only supplies its models.
+// ---------------------------------------------------------------------------
+
+// jobResource describes a resource that manages jobs of the given types.
+type jobResource struct {
+	// typeName is the Terraform resource type, e.g. "veeam_file_backup_job".
+	typeName string
+	// kind names the job in diagnostics, e.g. "file backup job".
+	kind string
+	// jobTypes lists the job types the resource manages.
+	jobTypes []models.EJobType
+	// hint, when set, follows the error reported for a job of another type.
+	hint string
+}
+
+// read GETs job id into result, whose embedded JobModel is job. It returns
+// false when Read must stop: the job was deleted outside Terraform and has
+// been removed from state, the request failed, or the job has a type this
+// resource does not manage.
+func (j jobResource) read(ctx context.Context, c client.APIClient, resp *resource.ReadResponse,
+	id string, result interface{}, job *models.JobModel) bool {
+	endpoint := fmt.Sprintf(client.PathJobByID, id)
+	if err := c.GetJSON(ctx, endpoint, result); err != nil {
+		if isJobNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return false
+		}
+		resp.Diagnostics.AddError("Failed to read "+j.kind, fmt.Sprintf("GET %s: %s", endpoint, err))
+		return false
+	}
+	if !slices.Contains(j.jobTypes, job.Type) {
+		names := make([]string, len(j.jobTypes))
+		for i, t := range j.jobTypes {
+			names[i] = string(t)
+		}
+		detail := fmt.Sprintf("Job %s is a %s job; %s manages %s jobs only.",
+			id, job.Type, j.typeName, strings.Join(names, ", "))
+		if j.hint != "" {
+			detail += " " + j.hint
+		}
+		resp.Diagnostics.AddError("Unexpected job type", detail)
+		return false
+	}
+	return true
+}
+
+// delete removes job id.
+func (j jobResource) delete(ctx context.Context, c client.APIClient, resp *resource.DeleteResponse, id string) {
+	endpoint := fmt.Sprintf(client.PathJobByID, id)
+	if err := c.DeleteJSON(ctx, endpoint); err != nil {
+		resp.Diagnostics.AddError("Failed to delete "+j.kind,
+			fmt.Sprintf("DELETE %s (job %s): %s", endpoint, id, err))
+	}
+}
+
+// saveState finishes Create or Update; see saveJobState.
+func (j jobResource) saveState(ctx context.Context, c client.APIClient, id string, current, want bool,
+	isDisabled *types.Bool, save func() diag.Diagnostics) diag.Diagnostics {
+	return saveJobState(ctx, c, j.kind, id, current, want, isDisabled, save)
+}
+
+// saveJobState finishes Create or Update of a job resource. It moves the job
+// from its current enabled state to want, sets *isDisabled to the state the
+// job is actually in and then calls save to write the resource state. save
+// runs even when the enable/disable request fails, so the job stays tracked
+// and the next plan retries the change; the failure is reported after it.
+func saveJobState(ctx context.Context, c client.APIClient, kind, id string, current, want bool,
+	isDisabled *types.Bool, save func() diag.Diagnostics) diag.Diagnostics {
+	var err error
+	if want != current {
+		if err = setJobDisabled(ctx, c, id, want); err == nil {
+			current = want
+		}
+	}
+	*isDisabled = types.BoolValue(current)
+
+	diags := save()
+	if err != nil {
+		action := "enable"
+		if want {
+			action = "disable"
+		}
+		diags.AddError(fmt.Sprintf("Failed to %s %s", action, kind),
+			fmt.Sprintf("The %s %s was saved with is_disabled = %t because the %s request failed: %s",
+				kind, id, current, action, err))
+	}
+	return diags
+}
+
+// setJobDisabled toggles a job of any type through the enable/disable endpoints.
+func setJobDisabled(ctx context.Context, c client.APIClient, id string, disabled bool) error {
+	endpoint := fmt.Sprintf(client.PathJobEnable, id)
+	if disabled {
+		endpoint = fmt.Sprintf(client.PathJobDisable, id)
+	}
+	if err := c.PostJSON(ctx, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("POST %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// isJobNotFound reports whether a job GET failed because the job was deleted
+// outside Terraform.
+func isJobNotFound(err error) bool {
+	var apiErr *models.APIError
+	if errors.As(err, &apiErr) && strings.EqualFold(apiErr.ErrorCode, "NotFound") {
+		return true
+	}
+	errText := strings.ToLower(err.Error())
+	return strings.Contains(errText, "http 404") || strings.Contains(errText, "notfound")
+}
@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var testJobResource = jobResource{
+	typeName: "veeam_test_job",
+	kind:     "test job",
+	jobTypes: []models.EJobType{models.JobTypeBackupCopy, models.JobTypeVSphereReplica},
+	hint:     "Use veeam_backup_job for backup jobs.",
+}
+
+// jobState returns a state holding only the ID of job id.
+func jobState(id string) tfsdk.State {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(objType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, id)}),
+	}
+}
+
+func TestJobResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("managed type", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(2).(*models.BackupCopyJobModel) = models.BackupCopyJobModel{
+					JobModel: models.JobModel{ID: "job-1", Type: models.JobTypeVSphereReplica},
+				}
+			}).Return(nil)
+		var result models.BackupCopyJobModel
+		resp := &resource.ReadResponse{State: jobState("job-1")}
+		require.True(t, testJobResource.read(ctx, mockClient, resp, "job-1", &result, &result.JobModel))
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "job-1", result.ID)
+	})
+
+	notFound := map[string]error{
+		"API error code":   fmt.Errorf("API request failed: %w", &models.APIError{ErrorCode: "NotFound", Message: "not found"}),
+		"HTTP status text": errors.New("API request failed (HTTP 404): job not found"),
+	}
+	for name, err := range notFound {
+		t.Run("removed outside Terraform/"+name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).Return(err)
+			var result models.BackupCopyJobModel
+			resp := &resource.ReadResponse{State: jobState("job-1")}
+			assert.False(t, testJobResource.read(ctx, mockClient, resp, "job-1", &result, &result.JobModel))
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+
+	t.Run("request fails", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+			Return(errors.New("HTTP 500: internal error"))
+		var result models.BackupCopyJobModel
+		resp := &resource.ReadResponse{State: jobState("job-1")}
+		assert.False(t, testJobResource.read(ctx, mockClient, resp, "job-1", &result, &result.JobModel))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Failed to read test job", resp.Diagnostics.Errors()[0].Summary())
+		assert.False(t, resp.State.Raw.IsNull(), "a failed read must keep the job in state")
+	})
+
+	t.Run("other job type", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(2).(*models.BackupCopyJobModel) = models.BackupCopyJobModel{
+					JobModel: models.JobModel{ID: "job-1", Type: models.JobTypeVSphereBackup},
+				}
+			}).Return(nil)
+		var result models.BackupCopyJobModel
+		resp := &resource.ReadResponse{State: jobState("job-1")}
+		assert.False(t, testJobResource.read(ctx, mockClient, resp, "job-1", &result, &result.JobModel))
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Job job-1 is a VSphereBackup job; veeam_test_job manages BackupCopy, VSphereReplica jobs only. "+
+			"Use veeam_backup_job for backup jobs.", resp.Diagnostics.Errors()[0].Detail())
+	})
+}
+
+// TestJobResources_ReadOtherJobType checks that each job resource refuses a
+// job of another family, e.g. after importing the wrong name, and points to
+// the resource that manages it.
+func TestJobResources_ReadOtherJobType(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   resource.Resource
+		apiType    string
+		wantDetail string
+	}{
+		{
+			name:       "backup copy job",
+			resource:   NewBackupCopyJob(),
+			apiType:    "VSphereBackup",
+			wantDetail: "Job job-9 is a VSphereBackup job; veeam_backup_copy_job manages BackupCopy jobs only. Use veeam_backup_job for backup jobs.",
+		},
+		{
+			name:       "replication job",
+			resource:   NewReplicationJob(),
+			apiType:    "VSphereBackup",
+			wantDetail: "Job job-9 is a VSphereBackup job; veeam_replication_job manages VSphereReplica jobs only.",
+		},
+		{
+			name:       "file backup job",
+			resource:   NewFileBackupJob(),
+			apiType:    "VSphereBackup",
+			wantDetail: "Job job-9 is a VSphereBackup job; veeam_file_backup_job manages FileBackup jobs only.",
+		},
+		{
+			name:       "object storage backup job",
+			resource:   NewObjectStorageBackupJob(),
+			apiType:    "FileBackup",
+			wantDetail: "Job job-9 is a FileBackup job; veeam_object_storage_backup_job manages ObjectStorageBackup jobs only.",
+		},
+		{
+			name:       "Entra ID tenant backup job",
+			resource:   NewEntraIDTenantBackupJob(),
+			apiType:    "EntraIDAuditLogBackup",
+			wantDetail: "Job job-9 is a EntraIDAuditLogBackup job; veeam_entra_id_tenant_backup_job manages EntraIDTenantBackup jobs only.",
+		},
+		{
+			name:       "Entra ID audit log backup job",
+			resource:   NewEntraIDAuditLogBackupJob(),
+			apiType:    "EntraIDTenantBackup",
+			wantDetail: "Job job-9 is a EntraIDTenantBackup job; veeam_entra_id_audit_log_backup_job manages EntraIDAuditLogBackup jobs only.",
+		},
+		{
+			name:     "agent backup policy",
+			resource: NewAgentBackupPolicy(),
+			apiType:  "WindowsAgentBackup",
+			wantDetail: "Job job-9 is a WindowsAgentBackup job; veeam_agent_backup_policy manages WindowsAgentBackupServerPolicy, " +
+				"LinuxAgentBackupServerPolicy, WindowsAgentBackupWorkstationPolicy, LinuxAgentBackupWorkstationPolicy jobs only.",
+		},
+		{
+			name:       "SureBackup job",
+			resource:   NewSureBackupJob(),
+			apiType:    "VSphereBackup",
+			wantDetail: "Job job-9 is a VSphereBackup job; veeam_surebackup_job manages SureBackup jobs only.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-9", mock.Anything).
+				Run(func(args mock.Arguments) {
+					body := fmt.Sprintf(`{"id": "job-9", "name": "Nightly", "type": %q}`, tt.apiType)
+					require.NoError(t, json.Unmarshal([]byte(body), args.Get(2)))
+				}).Return(nil)
+			tt.resource.(resource.ResourceWithConfigure).Configure(context.Background(),
+				resource.ConfigureRequest{ProviderData: client.APIClient(mockClient)}, &resource.ConfigureResponse{})
+
+			state := buildNullResourceState(tt.resource)
+			require.False(t, state.SetAttribute(context.Background(), path.Root("id"), "job-9").HasError())
+			resp := &resource.ReadResponse{State: state}
+			tt.resource.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, "Unexpected job type", resp.Diagnostics.Errors()[0].Summary())
+			assert.Equal(t, tt.wantDetail, resp.Diagnostics.Errors()[0].Detail())
+		})
+	}
+}
+
+func TestJobResource_Delete(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockClient.On("DeleteJSON", mock.Anything, "/api/v1/jobs/job-1").Return(errors.New("HTTP 409: job is running"))
+	resp := &resource.DeleteResponse{}
+	testJobResource.delete(context.Background(), mockClient, resp, "job-1")
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed to delete test job", resp.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "(job job-1): HTTP 409")
+}
+
+func TestJobResource_SaveState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unchanged", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		isDisabled := types.BoolUnknown()
+		saved := false
+		diags := testJobResource.saveState(ctx, mockClient, "job-1", true, true, &isDisabled,
+			func() diag.Diagnostics { saved = true; return nil })
+		assert.False(t, diags.HasError())
+		assert.True(t, saved)
+		assert.Equal(t, types.BoolValue(true), isDisabled)
+		mockClient.AssertNotCalled(t, "PostJSON", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/disable", nil, nil).Return(nil)
+		isDisabled := types.BoolUnknown()
+		diags := testJobResource.saveState(ctx, mockClient, "job-1", false, true, &isDisabled,
+			func() diag.Diagnostics { return nil })
+		assert.False(t, diags.HasError())
+		assert.Equal(t, types.BoolValue(true), isDisabled)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("enable fails after saving", func(t *testing.T) {
+		mockClient := new(MockVeeamClient)
+		mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/enable", nil, nil).
+			Return(errors.New("HTTP 403: forbidden"))
+		isDisabled := types.BoolUnknown()
+		var savedAs types.Bool
+		diags := testJobResource.saveState(ctx, mockClient, "job-1", true, false, &isDisabled,
+			func() diag.Diagnostics { savedAs = isDisabled; return nil })
+		require.True(t, diags.HasError())
+		assert.Equal(t, types.BoolValue(true), savedAs, "state must record the job as still disabled")
+		assert.Equal(t, "Failed to enable test job", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), "was saved with is_disabled = true")
+	})
+}
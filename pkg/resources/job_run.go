@@ -0,0 +1,328 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// Compile-time interface checks.
+var (
+	_ resource.Resource                = &JobRun{}
+	_ resource.ResourceWithConfigure   = &JobRun{}
+	_ resource.ResourceWithImportState = &JobRun{}
+	_ resource.ResourceWithIdentity    = &JobRun{}
+)
+
+// jobRunPollInterval is the time between session polls while a job runs.
+const jobRunPollInterval = 15 * time.Second
+
+// JobRun implements the veeam_job_run resource. Creating it starts the job
+// and waits for the session; it is replaced whenever job_id, active_full or
+// triggers change, which starts a new run.
+type JobRun struct {
+	client client.APIClient
+}
+
+// JobRunModel is the Terraform state model for veeam_job_run.
+type JobRunModel struct {
+	ID             types.String `tfsdk:"id"`
+	JobID          types.String `tfsdk:"job_id"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	ActiveFull     types.Bool   `tfsdk:"active_full"`
+	FailOnWarning  types.Bool   `tfsdk:"fail_on_warning"`
+	TimeoutMinutes types.Int64  `tfsdk:"timeout_minutes"`
+	LastSessionID  types.String `tfsdk:"last_session_id"`
+	LastRunResult  types.String `tfsdk:"last_run_result"`
+}
+
+// NewJobRun returns a new veeam_job_run resource instance.
+func NewJobRun() resource.Resource {
+	return &JobRun{}
+}
+
+func (r *JobRun) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_run"
+}
+
+func (r *JobRun) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
+func (r *JobRun) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Starts a job (`POST /api/v1/jobs/{id}/start`) and waits for the " +
+			"resulting session to finish. A new run is started whenever `job_id`, " +
+			"`active_full` or `triggers` change. Destroying the resource only removes " +
+			"it from state; the session history is kept on the server.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the session started by this run.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the job to start, e.g. `veeam_backup_job.example.id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				MarkdownDescription: "Arbitrary values that start a new run when they change, " +
+					"for example a hash of the job settings.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"active_full": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If `true`, the run creates an active full backup.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_warning": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "If `true`, a run that ends with `Warning` fails the apply.",
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(60),
+				MarkdownDescription: "How long to wait for the session to finish. Defaults to `60`.",
+			},
+			"last_session_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the session started by this run.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_run_result": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Result of the session: `Success`, `Warning` or `Failed`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *JobRun) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected client.APIClient from provider, got unexpected type.",
+		)
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *JobRun) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JobRunModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := data.JobID.ValueString()
+	startEndpoint := fmt.Sprintf(client.PathJobStart, jobID)
+	spec := &models.JobStartSpec{PerformActiveFull: data.ActiveFull.ValueBool()}
+
+	var started models.FullSessionModel
+	if err := r.client.PostJSON(ctx, startEndpoint, spec, &started); err != nil {
+		resp.Diagnostics.AddError("Failed to start job",
+			fmt.Sprintf("POST %s: %s", startEndpoint, err))
+		return
+	}
+
+	sessionID := started.ID
+	if sessionID == "" {
+		id, err := r.findLatestSession(ctx, jobID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to find job session",
+				fmt.Sprintf("Job %s was started but its session could not be found: %s", jobID, err))
+			return
+		}
+		sessionID = id
+	}
+	data.ID = types.StringValue(sessionID)
+	data.LastSessionID = types.StringValue(sessionID)
+
+	timeout := time.Duration(data.TimeoutMinutes.ValueInt64()) * time.Minute
+	waitErr := r.client.WaitForTaskWithOptions(ctx, sessionID, jobRunPollInterval, timeout)
+
+	// Read the final result even when waiting failed, so a failed run is
+	// recorded (and tainted) rather than lost. The job has run either way:
+	// when the session cannot be read, the run is saved without a result and
+	// the next refresh fills it in.
+	session, err := r.readSession(ctx, sessionID)
+	if err != nil {
+		data.LastRunResult = types.StringNull()
+	} else {
+		data.LastRunResult = types.StringValue(sessionResult(session))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
+
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddError("Failed to read job session",
+			fmt.Sprintf("Job %s session %s: %s", jobID, sessionID, err))
+	case waitErr != nil:
+		resp.Diagnostics.AddError("Job run did not succeed",
+			fmt.Sprintf("Job %s session %s: %s", jobID, sessionID, waitErr))
+	case data.LastRunResult.ValueString() == string(models.SessionResultWarning_) && data.FailOnWarning.ValueBool():
+		resp.Diagnostics.AddError("Job run finished with warnings",
+			fmt.Sprintf("Job %s session %s ended with Warning and fail_on_warning is set.", jobID, sessionID))
+	case data.LastRunResult.ValueString() == string(models.SessionResultWarning_):
+		resp.Diagnostics.AddWarning("Job run finished with warnings",
+			fmt.Sprintf("Job %s session %s ended with Warning.", jobID, sessionID))
+	}
+}
+
+func (r *JobRun) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JobRunModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sessions are pruned by the server's history retention; a run whose
+	// session is gone keeps its recorded result.
+	session, err := r.readSession(ctx, data.ID.ValueString())
+	if err != nil {
+		if data.JobID.IsNull() {
+			resp.Diagnostics.AddError("Failed to read job session",
+				fmt.Sprintf("Session %s: %s", data.ID.ValueString(), err))
+			return
+		}
+		tflog.Warn(ctx, "Job run session could not be read, keeping recorded result", map[string]interface{}{
+			"session_id": data.ID.ValueString(),
+			"error":      err.Error(),
+		})
+	} else {
+		data.JobID = types.StringValue(session.JobID)
+		data.LastSessionID = types.StringValue(session.ID)
+		data.LastRunResult = types.StringValue(sessionResult(session))
+	}
+
+	if data.ActiveFull.IsNull() {
+		data.ActiveFull = types.BoolValue(false)
+	}
+	if data.FailOnWarning.IsNull() {
+		data.FailOnWarning = types.BoolValue(false)
+	}
+	if data.TimeoutMinutes.IsNull() {
+		data.TimeoutMinutes = types.Int64Value(60)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
+}
+
+// Update only changes fail_on_warning / timeout_minutes; every other change
+// replaces the resource and starts a new run.
+func (r *JobRun) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JobRunModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state JobRunModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = state.ID
+	data.LastSessionID = state.LastSessionID
+	data.LastRunResult = state.LastRunResult
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
+}
+
+// Delete removes the run from state only; sessions cannot be deleted.
+func (r *JobRun) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *JobRun) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// findLatestSession returns the newest session of the job. It is used when
+// the start response does not carry the session ID.
+func (r *JobRun) findLatestSession(ctx context.Context, jobID string) (string, error) {
+	query := url.Values{}
+	query.Set("jobIdFilter", jobID)
+	query.Set("orderColumn", "CreationTime")
+	query.Set("orderAsc", "false")
+	query.Set("limit", "1")
+	endpoint := client.PathSessions + "?" + query.Encode()
+
+	var result models.SessionsResult
+	if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
+		return "", fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	if len(result.Data) == 0 || result.Data[0].ID == "" {
+		return "", fmt.Errorf("no session found for job %s", jobID)
+	}
+	return result.Data[0].ID, nil
+}
+
+func (r *JobRun) readSession(ctx context.Context, sessionID string) (*models.FullSessionModel, error) {
+	endpoint := fmt.Sprintf(client.PathSessionByID, sessionID)
+	var session models.FullSessionModel
+	if err := r.client.GetJSON(ctx, endpoint, &session); err != nil {
+		return nil, fmt.Errorf("GET %s: %w", endpoint, err)
+	}
+	return &session, nil
+}
+
+// sessionResult returns the session result, or None while it has none.
+func sessionResult(session *models.FullSessionModel) string {
+	if session.Result == nil || session.Result.Result == "" {
+		return string(models.SessionResultNone_)
+	}
+	return string(session.Result.Result)
+}
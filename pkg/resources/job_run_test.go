@@ -0,0 +1,190 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func TestJobRun_Metadata(t *testing.T) {
+	r := NewJobRun()
+	var resp resource.MetadataResponse
+	r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "veeam"}, &resp)
+	assert.Equal(t, "veeam_job_run", resp.TypeName)
+}
+
+// createJobRun runs Create for a plan with the given fail_on_warning value.
+func createJobRun(t *testing.T, r *JobRun, failOnWarning bool) (*resource.CreateResponse, JobRunModel) {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), JobRunModel{
+		ID:             types.StringUnknown(),
+		JobID:          types.StringValue("job-1"),
+		Triggers:       types.MapValueMust(types.StringType, map[string]attr.Value{"config": types.StringValue("abc")}),
+		ActiveFull:     types.BoolValue(true),
+		FailOnWarning:  types.BoolValue(failOnWarning),
+		TimeoutMinutes: types.Int64Value(90),
+		LastSessionID:  types.StringUnknown(),
+		LastRunResult:  types.StringUnknown(),
+	}).HasError())
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	var state JobRunModel
+	if !resp.State.Raw.IsNull() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return resp, state
+}
+
+func mockSessionResult(m *MockVeeamClient, id string, result models.ESessionResult) {
+	m.On("GetJSON", mock.Anything, "/api/v1/sessions/"+id, mock.Anything).Run(func(args mock.Arguments) {
+		s := args.Get(2).(*models.FullSessionModel)
+		*s = models.FullSessionModel{ID: id, JobID: "job-1", State: models.SessionStateStopped_, Result: &models.SessionResult{Result: result}}
+	}).Return(nil)
+}
+
+func TestJobRun_Create_WaitsForSession(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", &models.JobStartSpec{PerformActiveFull: true}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(*models.FullSessionModel).ID = "sess-1"
+		}).Return(nil)
+	mockClient.On("WaitForTaskWithOptions", mock.Anything, "sess-1", jobRunPollInterval, 90*time.Minute).Return(nil)
+	mockSessionResult(mockClient, "sess-1", models.SessionResultSuccess_)
+
+	resp, state := createJobRun(t, r, false)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, "sess-1", state.ID.ValueString())
+	assert.Equal(t, "sess-1", state.LastSessionID.ValueString())
+	assert.Equal(t, "Success", state.LastRunResult.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestJobRun_Create_FindsSessionByJob(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetJSON", mock.Anything,
+		"/api/v1/sessions?jobIdFilter=job-1&limit=1&orderAsc=false&orderColumn=CreationTime", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*models.SessionsResult).Data = []models.FullSessionModel{{ID: "sess-2"}}
+		}).Return(nil)
+	mockClient.On("WaitForTaskWithOptions", mock.Anything, "sess-2", mock.Anything, mock.Anything).Return(nil)
+	mockSessionResult(mockClient, "sess-2", models.SessionResultWarning_)
+
+	resp, state := createJobRun(t, r, false)
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Equal(t, "sess-2", state.LastSessionID.ValueString())
+	assert.Equal(t, "Warning", state.LastRunResult.ValueString())
+	mockClient.AssertExpectations(t)
+}
+
+func TestJobRun_Create_FailOnWarning(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(*models.FullSessionModel).ID = "sess-3"
+		}).Return(nil)
+	mockClient.On("WaitForTaskWithOptions", mock.Anything, "sess-3", mock.Anything, mock.Anything).Return(nil)
+	mockSessionResult(mockClient, "sess-3", models.SessionResultWarning_)
+
+	resp, state := createJobRun(t, r, true)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "warnings")
+	// The run is still recorded so Terraform taints it.
+	assert.Equal(t, "Warning", state.LastRunResult.ValueString())
+}
+
+func TestJobRun_Create_Failed(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(*models.FullSessionModel).ID = "sess-4"
+		}).Return(nil)
+	mockClient.On("WaitForTaskWithOptions", mock.Anything, "sess-4", mock.Anything, mock.Anything).
+		Return(errors.New("async task sess-4 failed"))
+	mockSessionResult(mockClient, "sess-4", models.SessionResultFailed_)
+
+	resp, state := createJobRun(t, r, false)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed", state.LastRunResult.ValueString())
+}
+
+// TestJobRun_Create_SessionUnreadable verifies that a run whose session
+// cannot be read after it finished is still saved, so the next apply does not
+// start the job again.
+func TestJobRun_Create_SessionUnreadable(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(*models.FullSessionModel).ID = "sess-5"
+		}).Return(nil)
+	mockClient.On("WaitForTaskWithOptions", mock.Anything, "sess-5", mock.Anything, mock.Anything).Return(nil)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/sessions/sess-5", mock.Anything).
+		Return(errors.New("HTTP 503: service unavailable"))
+
+	resp, state := createJobRun(t, r, false)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed to read job session", resp.Diagnostics.Errors()[0].Summary())
+	assert.Equal(t, "sess-5", state.ID.ValueString())
+	assert.Equal(t, "sess-5", state.LastSessionID.ValueString())
+	assert.True(t, state.LastRunResult.IsNull())
+}
+
+func TestJobRun_Create_StartError(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+
+	mockClient.On("PostJSON", mock.Anything, "/api/v1/jobs/job-1/start", mock.Anything, mock.Anything).
+		Return(errors.New("job is already running"))
+
+	resp, state := createJobRun(t, r, false)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.True(t, state.ID.IsNull())
+}
+
+func TestJobRun_ImportReadsSession(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &JobRun{client: mockClient}
+	mockSessionResult(mockClient, "sess-5", models.SessionResultSuccess_)
+
+	importResp := importStateWithID(t, r, "sess-5")
+	require.False(t, importResp.Diagnostics.HasError())
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(context.Background(), resource.ReadRequest{State: importResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "unexpected errors: %v", readResp.Diagnostics)
+
+	var state JobRunModel
+	require.False(t, readResp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "job-1", state.JobID.ValueString())
+	assert.Equal(t, "Success", state.LastRunResult.ValueString())
+	assert.Equal(t, int64(60), state.TimeoutMinutes.ValueInt64())
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &KMSServer{}
 	_ resource.ResourceWithConfigure   = &KMSServer{}
 	_ resource.ResourceWithImportState = &KMSServer{}
+	_ resource.ResourceWithIdentity    = &KMSServer{}
 )
 
 // KMSServer implements the veeam_kms_server resource.
@@ -40,6 +40,17 @@ type KMSServerModel struct {
 
 func (r *KMSServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kms_server"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// kmsServerIdentity keys KMS servers by UUID and name.
+var kmsServerIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "KMS server name.",
+}
+
+func (r *KMSServer) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = kmsServerIdentity.schema()
 }
 
 func (r *KMSServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -129,6 +140,7 @@ func (r *KMSServer) Create(ctx context.Context, req resource.CreateRequest, resp
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(kmsServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *KMSServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -150,6 +162,7 @@ func (r *KMSServer) Read(ctx context.Context, req resource.ReadRequest, resp *re
 
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(kmsServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *KMSServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -171,6 +184,7 @@ func (r *KMSServer) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(kmsServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *KMSServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -191,7 +205,7 @@ func (r *KMSServer) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *KMSServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	kmsServerIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewKMSServer returns a new veeam_kms_server resource instance.
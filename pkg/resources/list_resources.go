@@ -0,0 +1,365 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// ---------------------------------------------------------------------------
+// List resources (Terraform 1.14+ `terraform query`)
+//
+// Each list resource enumerates the collection endpoint of a managed resource
+// and returns one result per entry the resource can manage, carrying the
+// resource identity. When Terraform asks for full resource data (e.g.
+// `terraform query -generate-config-out`), the entry is read back through the
+// resource's own Read, so generated configuration matches what a plan sees.
+// ---------------------------------------------------------------------------
+
+// Compile-time interface checks.
+var (
+	_ list.ListResource              = &listResource{}
+	_ list.ListResourceWithConfigure = &listResource{}
+)
+
+// listResourceSpec describes how a resource type is enumerated.
+type listResourceSpec struct {
+	// typeSuffix is appended to the provider type name, e.g. "backup_job".
+	typeSuffix string
+	// kind is the human-readable resource kind used in diagnostics.
+	kind string
+	// listEndpoint is the collection endpoint returning {"data": [...]}.
+	listEndpoint string
+	// keyField is the list entry field holding the natural key.
+	keyField string
+	// identity is the resource identity; its key names the filter attribute.
+	identity resourceIdentity
+	// types lists the entry types the resource can manage; other entries
+	// (e.g. replication jobs, hardened repositories) are skipped.
+	types []string
+	// newResource returns the managed resource used to read full data.
+	newResource func() resource.Resource
+}
+
+// listResource implements a veeam_* list resource.
+type listResource struct {
+	spec   listResourceSpec
+	client client.APIClient
+}
+
+// listResourceFilterModel holds the list block filters. The natural key
+// attribute is named after the identity key ("name", "host", "username").
+type listResourceFilterModel struct {
+	Key         types.String
+	KeyContains types.String
+	Type        types.String
+}
+
+func (r *listResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.typeSuffix
+}
+
+func (r *listResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	key := r.spec.identity.key
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists %ss registered in Veeam Backup & Replication. "+
+			"All filters are optional and combined with AND.", r.spec.kind),
+		Attributes: map[string]listschema.Attribute{
+			key: listschema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Only return %ss whose %s matches this value (case-insensitive).", r.spec.kind, key),
+				Optional:            true,
+			},
+			key + "_contains": listschema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Only return %ss whose %s contains this value (case-insensitive).", r.spec.kind, key),
+				Optional:            true,
+			},
+			"type": listschema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Only return %ss of this type. Supported values: `%s`.",
+					r.spec.kind, strings.Join(r.spec.types, "`, `")),
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *listResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data",
+			"Expected client.APIClient from provider, got unexpected type.",
+		)
+		return
+	}
+	r.client = c
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	filter, diags := r.readFilter(ctx, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		diags.AddError("Provider not configured",
+			fmt.Sprintf("Cannot list %ss before the provider is configured.", r.spec.kind))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var payload map[string]interface{}
+	if err := r.client.GetJSON(ctx, r.spec.listEndpoint, &payload); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to list %ss", r.spec.kind), fmt.Sprintf("API error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	rawData, ok := payload["data"].([]interface{})
+	if !ok {
+		diags.AddError(fmt.Sprintf("Failed to list %ss", r.spec.kind),
+			fmt.Sprintf("Unexpected %s list response shape: missing data array.", r.spec.kind))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	entries := make([]map[string]interface{}, 0, len(rawData))
+	for _, item := range rawData {
+		entry, ok := item.(map[string]interface{})
+		if !ok || getStringValue(entry, "id") == "" {
+			continue
+		}
+		if r.matches(entry, filter) {
+			entries = append(entries, entry)
+		}
+	}
+	// Stable ordering keeps generated configuration diff-friendly.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return strings.ToLower(getStringValue(entries[i], r.spec.keyField)) <
+			strings.ToLower(getStringValue(entries[j], r.spec.keyField))
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, entry := range entries {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(r.result(ctx, req, entry)) {
+				return
+			}
+		}
+	}
+}
+
+// readFilter extracts the filter attributes. The key attribute name varies
+// per resource, so values are read by path rather than through a struct.
+func (r *listResource) readFilter(ctx context.Context, config tfsdk.Config) (listResourceFilterModel, diag.Diagnostics) {
+	var filter listResourceFilterModel
+	var diags diag.Diagnostics
+	key := r.spec.identity.key
+	diags.Append(config.GetAttribute(ctx, path.Root(key), &filter.Key)...)
+	diags.Append(config.GetAttribute(ctx, path.Root(key+"_contains"), &filter.KeyContains)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("type"), &filter.Type)...)
+	return filter, diags
+}
+
+func (r *listResource) matches(entry map[string]interface{}, filter listResourceFilterModel) bool {
+	entryType := getStringValue(entry, "type")
+	if !containsFold(r.spec.types, entryType) {
+		return false
+	}
+	if v := filter.Type.ValueString(); v != "" && !strings.EqualFold(entryType, v) {
+		return false
+	}
+	key := getStringValue(entry, r.spec.keyField)
+	if v := filter.Key.ValueString(); v != "" && !strings.EqualFold(key, v) {
+		return false
+	}
+	if v := filter.KeyContains.ValueString(); v != "" && !strings.Contains(strings.ToLower(key), strings.ToLower(v)) {
+		return false
+	}
+	return true
+}
+
+// result builds the list result for one entry: identity always, resource
+// data only when Terraform requested it.
+func (r *listResource) result(ctx context.Context, req list.ListRequest, entry map[string]interface{}) list.ListResult {
+	result := req.NewListResult(ctx)
+	id := getStringValue(entry, "id")
+	key := getStringValue(entry, r.spec.keyField)
+
+	keyValue := types.StringValue(key)
+	result.DisplayName = key
+	if key == "" {
+		keyValue = types.StringNull()
+		result.DisplayName = id
+	}
+	result.Diagnostics.Append(r.spec.identity.set(ctx, result.Identity, types.StringValue(id), keyValue)...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	res := r.spec.newResource()
+	if rc, ok := res.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		rc.Configure(ctx, resource.ConfigureRequest{ProviderData: r.client}, configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+	}
+
+	readReq := resource.ReadRequest{
+		State: tfsdk.State{
+			Schema: req.ResourceSchema,
+			Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	result.Diagnostics.Append(readReq.State.SetAttribute(ctx, path.Root("id"), id)...)
+	// Some reads (e.g. backup jobs) choose the API model by the type held in
+	// state, so seed it from the list entry as well.
+	if entryType := getStringValue(entry, "type"); entryType != "" {
+		if _, ok := req.ResourceSchema.GetAttributes()["type"]; ok {
+			result.Diagnostics.Append(readReq.State.SetAttribute(ctx, path.Root("type"), entryType)...)
+		}
+	}
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	readResp := &resource.ReadResponse{
+		State:    tfsdk.State{Schema: req.ResourceSchema, Raw: readReq.State.Raw.Copy()},
+		Identity: result.Identity,
+	}
+	res.Read(ctx, readReq, readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+	return result
+}
+
+func containsFold(values []string, v string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ---------------------------------------------------------------------------
+// Registered list resources
+// ---------------------------------------------------------------------------
+
+var backupJobListSpec = listResourceSpec{
+	typeSuffix:   "backup_job",
+	kind:         "backup job",
+	listEndpoint: client.PathJobs,
+	keyField:     "name",
+	identity:     backupJobIdentity,
+	types: []string{
+		string(models.JobTypeVSphereBackup),
+		string(models.JobTypeHyperVBackup),
+		string(models.JobTypeCloudDirectorBackup),
+		string(models.JobTypeWindowsAgentBackup),
+		string(models.JobTypeLinuxAgentBackup),
+	},
+	newResource: NewBackupJob,
+}
+
+// NewBackupJobListResource returns the veeam_backup_job list resource.
+func NewBackupJobListResource() list.ListResource {
+	return &listResource{spec: backupJobListSpec}
+}
+
+var repositoryListSpec = listResourceSpec{
+	typeSuffix:   "repository",
+	kind:         "repository",
+	listEndpoint: client.PathRepositories,
+	keyField:     "name",
+	identity:     repositoryIdentity,
+	types:        []string{"WinLocal", "LinuxLocal", "Nfs", "Smb"},
+	newResource:  NewRepository,
+}
+
+// NewRepositoryListResource returns the veeam_repository list resource.
+func NewRepositoryListResource() list.ListResource {
+	return &listResource{spec: repositoryListSpec}
+}
+
+var proxyListSpec = listResourceSpec{
+	typeSuffix:   "proxy",
+	kind:         "proxy",
+	listEndpoint: client.PathProxies,
+	keyField:     "name",
+	identity:     proxyIdentity,
+	types:        []string{"ViProxy", "HvProxy", "GeneralPurposeProxy"},
+	newResource:  NewProxy,
+}
+
+// NewProxyListResource returns the veeam_proxy list resource.
+func NewProxyListResource() list.ListResource {
+	return &listResource{spec: proxyListSpec}
+}
+
+var managedServerListSpec = listResourceSpec{
+	typeSuffix:   "managed_server",
+	kind:         "managed server",
+	listEndpoint: client.PathManagedServers,
+	keyField:     "name",
+	identity:     managedServerIdentity,
+	types: []string{
+		string(models.ManagedServerTypeViHost),
+		string(models.ManagedServerTypeWindowsHost),
+		string(models.ManagedServerTypeLinuxHost),
+		string(models.ManagedServerTypeCloudDirector),
+	},
+	newResource: NewManagedServer,
+}
+
+// NewManagedServerListResource returns the veeam_managed_server list resource.
+func NewManagedServerListResource() list.ListResource {
+	return &listResource{spec: managedServerListSpec}
+}
+
+var credentialListSpec = listResourceSpec{
+	typeSuffix:   "credential",
+	kind:         "credential",
+	listEndpoint: client.PathCredentials,
+	keyField:     "username",
+	identity:     credentialIdentity,
+	types:        []string{"Standard", "Linux"},
+	newResource:  NewCredential,
+}
+
+// NewCredentialListResource returns the veeam_credential list resource.
+func NewCredentialListResource() list.ListResource {
+	return &listResource{spec: credentialListSpec}
+}
+
+var protectionGroupListSpec = listResourceSpec{
+	typeSuffix:   "protection_group",
+	kind:         "protection group",
+	listEndpoint: client.PathProtectionGroups,
+	keyField:     "name",
+	identity:     protectionGroupIdentity,
+	types:        []string{"IndividualComputers", "CloudMachines", "ADObjects", "CSVFile"},
+	newResource:  NewProtectionGroup,
+}
+
+// NewProtectionGroupListResource returns the veeam_protection_group list resource.
+func NewProtectionGroupListResource() list.ListResource {
+	return &listResource{spec: protectionGroupListSpec}
+}
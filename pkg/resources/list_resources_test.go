@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// runList executes a list query against lr with the given filter values and
+// collects the streamed results.
+func runList(t *testing.T, lr list.ListResource, r resource.Resource, filters map[string]string, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for k, attrType := range objType.AttributeTypes {
+		if v, ok := filters[k]; ok {
+			vals[k] = tftypes.NewValue(attrType, v)
+		} else {
+			vals[k] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: nullIdentity(t, r).Schema,
+	}
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestListResources_Metadata(t *testing.T) {
+	cases := map[string]func() list.ListResource{
+		"veeam_backup_job":       NewBackupJobListResource,
+		"veeam_credential":       NewCredentialListResource,
+		"veeam_managed_server":   NewManagedServerListResource,
+		"veeam_protection_group": NewProtectionGroupListResource,
+		"veeam_proxy":            NewProxyListResource,
+		"veeam_repository":       NewRepositoryListResource,
+	}
+	for want, newList := range cases {
+		var resp resource.MetadataResponse
+		newList().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "veeam"}, &resp)
+		assert.Equal(t, want, resp.TypeName)
+
+		var schemaResp list.ListResourceSchemaResponse
+		newList().ListResourceConfigSchema(context.Background(), list.ListResourceSchemaRequest{}, &schemaResp)
+		assert.False(t, schemaResp.Schema.ValidateImplementation(context.Background()).HasError(), want)
+	}
+}
+
+func TestBackupJobList_FiltersUnsupportedTypesAndSorts(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathJobs,
+		map[string]interface{}{"id": "job-2", "name": "Weekly", "type": "VSphereBackup"},
+		map[string]interface{}{"id": "job-3", "name": "Replica", "type": "VSphereReplica"},
+		map[string]interface{}{"id": "job-1", "name": "daily-sql", "type": "WindowsAgentBackup"},
+	)
+
+	lr := &listResource{spec: backupJobListSpec, client: mockClient}
+	results := runList(t, lr, NewBackupJob(), nil, false, 0)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "daily-sql", results[0].DisplayName)
+	assert.Equal(t, "job-1", identityString(t, results[0].Identity, "id").ValueString())
+	assert.Equal(t, "daily-sql", identityString(t, results[0].Identity, "name").ValueString())
+	assert.Equal(t, "Weekly", results[1].DisplayName)
+	assert.True(t, results[0].Resource.Raw.IsNull(), "resource data must not be read unless requested")
+}
+
+func TestBackupJobList_NameAndTypeFilters(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathJobs,
+		map[string]interface{}{"id": "job-1", "name": "SQL-Daily", "type": "VSphereBackup"},
+		map[string]interface{}{"id": "job-2", "name": "SQL-Weekly", "type": "HyperVBackup"},
+		map[string]interface{}{"id": "job-3", "name": "Files", "type": "VSphereBackup"},
+	)
+	lr := &listResource{spec: backupJobListSpec, client: mockClient}
+
+	results := runList(t, lr, NewBackupJob(), map[string]string{"name_contains": "sql"}, false, 0)
+	assert.Len(t, results, 2)
+
+	results = runList(t, lr, NewBackupJob(), map[string]string{"name_contains": "sql", "type": "vspherebackup"}, false, 0)
+	require.Len(t, results, 1)
+	assert.Equal(t, "SQL-Daily", results[0].DisplayName)
+
+	results = runList(t, lr, NewBackupJob(), map[string]string{"name": "files"}, false, 0)
+	require.Len(t, results, 1)
+	assert.Equal(t, "job-3", identityString(t, results[0].Identity, "id").ValueString())
+}
+
+func TestCredentialList_LimitAndUsernameKey(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathCredentials,
+		map[string]interface{}{"id": "cred-1", "username": `CORP\a`, "type": "Standard"},
+		map[string]interface{}{"id": "cred-2", "username": `CORP\b`, "type": "Standard"},
+		map[string]interface{}{"id": "cred-3", "username": "root", "type": "Linux"},
+	)
+	lr := &listResource{spec: credentialListSpec, client: mockClient}
+
+	results := runList(t, lr, NewCredential(), nil, false, 2)
+	require.Len(t, results, 2)
+	assert.Equal(t, `CORP\a`, identityString(t, results[0].Identity, "username").ValueString())
+}
+
+func TestCredentialList_IncludeResource(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockListResponse(mockClient, client.PathCredentials,
+		map[string]interface{}{"id": "cred-1", "username": "root", "type": "Linux"},
+	)
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/credentials/cred-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*models.CredentialsModel)
+			result.ID = "cred-1"
+			result.Username = "root"
+			result.Type = "Linux"
+			result.Description = "Linux root"
+		}).Return(nil)
+
+	lr := &listResource{spec: credentialListSpec, client: mockClient}
+	results := runList(t, lr, NewCredential(), nil, true, 0)
+
+	require.Len(t, results, 1)
+	require.False(t, results[0].Diagnostics.HasError(), "unexpected errors: %v", results[0].Diagnostics)
+	var m CredentialModel
+	require.False(t, results[0].Resource.Get(context.Background(), &m).HasError())
+	assert.Equal(t, "cred-1", m.ID.ValueString())
+	assert.Equal(t, "root", m.Username.ValueString())
+	assert.Equal(t, "Linux root", m.Description.ValueString())
+}
+
+func TestManagedServerList_ListError(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	mockClient.On("GetJSON", mock.Anything, client.PathManagedServers, mock.Anything).Return(errors.New("boom"))
+
+	lr := &listResource{spec: managedServerListSpec, client: mockClient}
+	results := runList(t, lr, NewManagedServer(), nil, false, 0)
+
+	require.Len(t, results, 1)
+	require.True(t, results[0].Diagnostics.HasError())
+	assert.Contains(t, results[0].Diagnostics[0].Detail(), "boom")
+}
+
+func TestListResource_NotConfigured(t *testing.T) {
+	lr := NewProxyListResource()
+	results := runList(t, lr, NewProxy(), nil, false, 0)
+
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Diagnostics.HasError())
+}
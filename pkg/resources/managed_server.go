@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,9 +20,11 @@ import (
 
 // Compile-time interface checks.
 var (
-	_ resource.Resource                = &ManagedServer{}
-	_ resource.ResourceWithConfigure   = &ManagedServer{}
-	_ resource.ResourceWithImportState = &ManagedServer{}
+	_ resource.Resource                   = &ManagedServer{}
+	_ resource.ResourceWithConfigure      = &ManagedServer{}
+	_ resource.ResourceWithImportState    = &ManagedServer{}
+	_ resource.ResourceWithIdentity       = &ManagedServer{}
+	_ resource.ResourceWithValidateConfig = &ManagedServer{}
 )
 
 // ManagedServer implements the veeam_managed_server resource.
@@ -42,15 +43,37 @@ type ManagedServerModel struct {
 	CertificateThumbprint types.String `tfsdk:"certificate_thumbprint"`
 	SSHFingerprint        types.String `tfsdk:"ssh_fingerprint"`
 	Status                types.String `tfsdk:"status"`
+	// VCenters lists the vCenter Servers attached to Cloud Director. CloudDirectorHost only.
+	VCenters []ManagedServerVCenter `tfsdk:"vcenters"`
+}
+
+// ManagedServerVCenter is a vCenter Server registered with a Cloud Director host.
+type ManagedServerVCenter struct {
+	Name                  types.String `tfsdk:"name"`
+	CredentialsID         types.String `tfsdk:"credentials_id"`
+	CertificateThumbprint types.String `tfsdk:"certificate_thumbprint"`
 }
 
 func (r *ManagedServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_managed_server"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// managedServerIdentity pairs the server UUID with the host name it was
+// registered under.
+var managedServerIdentity = resourceIdentity{
+	key:            "host",
+	keyDescription: "Server host name as registered in Veeam.",
+	lookup:         &managedServerImport,
+}
+
+func (r *ManagedServer) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = managedServerIdentity.schema()
 }
 
 func (r *ManagedServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Veeam managed server (ViHost, WindowsHost, LinuxHost).",
+		MarkdownDescription: "Manages a Veeam managed server (ViHost, WindowsHost, LinuxHost, CloudDirectorHost).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Server identifier (assigned by the server).",
@@ -69,7 +92,7 @@ func (r *ManagedServer) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Server type: `ViHost`, `WindowsHost`, or `LinuxHost`.",
+				MarkdownDescription: "Server type: `ViHost`, `WindowsHost`, `LinuxHost`, or `CloudDirectorHost`.",
 				Required:            true,
 			},
 			"credentials_id": schema.StringAttribute{
@@ -77,11 +100,11 @@ func (r *ManagedServer) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:            true,
 			},
 			"port": schema.Int64Attribute{
-				MarkdownDescription: "Connection port (e.g. 443 for ViHost).",
+				MarkdownDescription: "Connection port (e.g. 443 for ViHost and CloudDirectorHost).",
 				Optional:            true,
 			},
 			"certificate_thumbprint": schema.StringAttribute{
-				MarkdownDescription: "TLS certificate thumbprint (ViHost only).",
+				MarkdownDescription: "TLS certificate thumbprint (ViHost and CloudDirectorHost only).",
 				Optional:            true,
 			},
 			"ssh_fingerprint": schema.StringAttribute{
@@ -92,10 +115,46 @@ func (r *ManagedServer) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "Server availability status (read-only).",
 				Computed:            true,
 			},
+			"vcenters": schema.ListNestedAttribute{
+				MarkdownDescription: "vCenter Servers attached to Cloud Director, registered " +
+					"together with it (CloudDirectorHost only). vCenter Servers already " +
+					"registered in Veeam may be omitted.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "FQDN or IP address of the vCenter Server, " +
+								"as configured in Cloud Director.",
+							Required: true,
+						},
+						"credentials_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the saved credential used to connect to the vCenter Server.",
+							Required:            true,
+						},
+						"certificate_thumbprint": schema.StringAttribute{
+							MarkdownDescription: "TLS certificate thumbprint of the vCenter Server.",
+							Optional:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig reports vcenters on a server type other than
+// CloudDirectorHost during plan rather than when the registration is sent.
+func (r *ManagedServer) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ManagedServerModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// vcenters built from an unknown collection cannot be decoded yet.
+		return
+	}
+	if err := validateManagedServer(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid managed server configuration", err.Error())
+	}
+}
+
 func (r *ManagedServer) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -122,6 +181,11 @@ func (r *ManagedServer) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if err := validateManagedServer(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid managed server configuration", err.Error())
+		return
+	}
+
 	// Use a create-only copy so we can resolve/adjust payload fields without
 	// changing configured attribute values in Terraform state.
 	createData := data
@@ -190,6 +254,7 @@ func (r *ManagedServer) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Info(ctx, "Created managed server", map[string]interface{}{"id": data.ID.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(managedServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ManagedServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -211,6 +276,7 @@ func (r *ManagedServer) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.syncFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(managedServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ManagedServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -220,6 +286,11 @@ func (r *ManagedServer) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if err := validateManagedServer(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid managed server configuration", err.Error())
+		return
+	}
+
 	payload := r.buildSpec(&data)
 
 	endpoint := fmt.Sprintf(client.PathManagedServerByID, data.ID.ValueString())
@@ -232,6 +303,7 @@ func (r *ManagedServer) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(managedServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ManagedServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -259,8 +331,15 @@ func (r *ManagedServer) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// managedServerImport resolves "host:<server name>" import IDs in addition to UUIDs.
+var managedServerImport = naturalKeyImport{
+	kind:         "managed server",
+	listEndpoint: client.PathManagedServers,
+	keys:         map[string]string{"host": "name"},
+}
+
 func (r *ManagedServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	managedServerIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewManagedServer returns a new veeam_managed_server resource instance.
@@ -295,6 +374,24 @@ func (r *ManagedServer) buildSpec(data *ManagedServerModel) interface{} {
 		}
 		return &spec
 
+	case models.ManagedServerTypeCloudDirector:
+		spec := models.CloudDirectorHostSpec{
+			ManagedServerSpec:     base,
+			CredentialsID:         data.CredentialsID.ValueString(),
+			CertificateThumbprint: data.CertificateThumbprint.ValueString(),
+		}
+		if !data.Port.IsNull() && !data.Port.IsUnknown() {
+			spec.Port = int(data.Port.ValueInt64())
+		}
+		for _, vc := range data.VCenters {
+			spec.VCenterServers = append(spec.VCenterServers, models.CloudDirectorViHostSpec{
+				Name:                  vc.Name.ValueString(),
+				CredentialsID:         vc.CredentialsID.ValueString(),
+				CertificateThumbprint: vc.CertificateThumbprint.ValueString(),
+			})
+		}
+		return &spec
+
 	case models.ManagedServerTypeLinuxHost:
 		spec := models.LinuxHostSpec{
 			ManagedServerSpec:      base,
@@ -314,6 +411,28 @@ func (r *ManagedServer) buildSpec(data *ManagedServerModel) interface{} {
 	}
 }
 
+// validateManagedServer rejects settings that do not apply to the server type.
+func validateManagedServer(data *ManagedServerModel) error {
+	if len(data.VCenters) == 0 {
+		return nil
+	}
+	if isConfigured(data.Type) && models.EManagedServerType(data.Type.ValueString()) != models.ManagedServerTypeCloudDirector {
+		return fmt.Errorf("vcenters is only supported for %s servers", models.ManagedServerTypeCloudDirector)
+	}
+	seen := make(map[string]bool, len(data.VCenters))
+	for i, vc := range data.VCenters {
+		if !isConfigured(vc.Name) {
+			continue
+		}
+		key := strings.ToLower(vc.Name.ValueString())
+		if seen[key] {
+			return fmt.Errorf("vcenters[%d]: vCenter Server %q is listed more than once", i, vc.Name.ValueString())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 func (r *ManagedServer) syncFromAPI(data *ManagedServerModel, api *models.ManagedServerModel) {
 	if api.Name != "" {
 		data.Name = types.StringValue(api.Name)
@@ -440,7 +559,8 @@ func isAsyncManagedServerCreateResult(result map[string]interface{}) bool {
 
 	return !strings.EqualFold(resultType, string(models.ManagedServerTypeViHost)) &&
 		!strings.EqualFold(resultType, string(models.ManagedServerTypeWindowsHost)) &&
-		!strings.EqualFold(resultType, string(models.ManagedServerTypeLinuxHost))
+		!strings.EqualFold(resultType, string(models.ManagedServerTypeLinuxHost)) &&
+		!strings.EqualFold(resultType, string(models.ManagedServerTypeCloudDirector))
 }
 
 func (r *ManagedServer) findManagedServerID(ctx context.Context, data *ManagedServerModel) (string, error) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/patrikcze/terraform-provider-veeam/internal/models"
 )
@@ -99,3 +100,90 @@ func TestIsManagedServerNotFound(t *testing.T) {
 		assert.False(t, isManagedServerNotFound(err))
 	})
 }
+
+func TestManagedServerBuildSpecCloudDirectorHost(t *testing.T) {
+	resource := &ManagedServer{}
+	data := &ManagedServerModel{
+		Name:          types.StringValue("vcd.sp.local"),
+		Type:          types.StringValue("CloudDirectorHost"),
+		CredentialsID: types.StringValue("cred-vcd"),
+		Port:          types.Int64Value(443),
+		VCenters: []ManagedServerVCenter{
+			{Name: types.StringValue("vc01.sp.local"), CredentialsID: types.StringValue("cred-vc"), CertificateThumbprint: types.StringNull()},
+			{Name: types.StringValue("vc02.sp.local"), CredentialsID: types.StringValue("cred-vc"), CertificateThumbprint: types.StringValue("AB:CD")},
+		},
+	}
+	assert.NoError(t, validateManagedServer(data))
+
+	spec := resource.buildSpec(data)
+	vcd, ok := spec.(*models.CloudDirectorHostSpec)
+	assert.True(t, ok, "expected *CloudDirectorHostSpec")
+	assert.Equal(t, models.ManagedServerTypeCloudDirector, vcd.Type)
+	assert.Equal(t, "cred-vcd", vcd.CredentialsID)
+	assert.Equal(t, 443, vcd.Port)
+	assert.Equal(t, []models.CloudDirectorViHostSpec{
+		{Name: "vc01.sp.local", CredentialsID: "cred-vc"},
+		{Name: "vc02.sp.local", CredentialsID: "cred-vc", CertificateThumbprint: "AB:CD"},
+	}, vcd.VCenterServers)
+	assert.False(t, isAsyncManagedServerCreateResult(map[string]interface{}{"id": "server-1", "type": "CloudDirectorHost"}))
+}
+
+func TestManagedServer_ValidateConfig(t *testing.T) {
+	vc := func(name types.String) ManagedServerVCenter {
+		return ManagedServerVCenter{Name: name, CredentialsID: types.StringValue("cred-vc")}
+	}
+	tests := []struct {
+		name       string
+		serverType interface{}
+		vcenters   []ManagedServerVCenter
+		wantErr    string
+	}{
+		{
+			name:       "Cloud Director with two vCenter Servers",
+			serverType: "CloudDirectorHost",
+			vcenters:   []ManagedServerVCenter{vc(types.StringValue("vc01.sp.local")), vc(types.StringValue("vc02.sp.local"))},
+		},
+		{
+			name:       "Cloud Director with registered vCenter Servers only",
+			serverType: "CloudDirectorHost",
+		},
+		{
+			name:       "vCenter Servers on a vCenter Server",
+			serverType: "ViHost",
+			vcenters:   []ManagedServerVCenter{vc(types.StringValue("vc01.sp.local"))},
+			wantErr:    "vcenters is only supported for CloudDirectorHost servers",
+		},
+		{
+			name:       "same vCenter Server in another case",
+			serverType: "CloudDirectorHost",
+			vcenters:   []ManagedServerVCenter{vc(types.StringValue("vc01.sp.local")), vc(types.StringValue("VC01.sp.local"))},
+			wantErr:    `vcenters[1]: vCenter Server "VC01.sp.local" is listed more than once`,
+		},
+		{
+			name:       "server type from a variable",
+			serverType: types.StringUnknown(),
+			vcenters:   []ManagedServerVCenter{vc(types.StringValue("vc01.sp.local"))},
+		},
+		{
+			name:       "vCenter Server names from another resource",
+			serverType: "CloudDirectorHost",
+			vcenters:   []ManagedServerVCenter{vc(types.StringUnknown()), vc(types.StringUnknown())},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &ManagedServer{}, map[string]interface{}{
+				"name":           "vcd01.sp.local",
+				"type":           tt.serverType,
+				"credentials_id": "cred-vcd",
+				"vcenters":       tt.vcenters,
+			})
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -19,6 +18,7 @@ var (
 	_ resource.Resource                = &NotificationSettings{}
 	_ resource.ResourceWithConfigure   = &NotificationSettings{}
 	_ resource.ResourceWithImportState = &NotificationSettings{}
+	_ resource.ResourceWithIdentity    = &NotificationSettings{}
 )
 
 // NotificationSettings manages the Veeam global notification settings singleton.
@@ -51,6 +51,10 @@ func (r *NotificationSettings) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_notification_settings"
 }
 
+func (r *NotificationSettings) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *NotificationSettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateForUnknownBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
 
@@ -163,6 +167,7 @@ func (r *NotificationSettings) Create(ctx context.Context, req resource.CreateRe
 
 	data.ID = types.StringValue("notification-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *NotificationSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -181,6 +186,7 @@ func (r *NotificationSettings) Read(ctx context.Context, req resource.ReadReques
 	syncNotificationSettingsFromAPI(&data, raw)
 	data.ID = types.StringValue("notification-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *NotificationSettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -197,6 +203,7 @@ func (r *NotificationSettings) Update(ctx context.Context, req resource.UpdateRe
 
 	data.ID = types.StringValue("notification-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *NotificationSettings) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
@@ -204,7 +211,7 @@ func (r *NotificationSettings) Delete(_ context.Context, _ resource.DeleteReques
 }
 
 func (r *NotificationSettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // putNotificationSettings GETs the current server config, merges plan fields, and PUTs the result.
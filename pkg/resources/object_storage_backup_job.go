@@ -0,0 +1,459 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &ObjectStorageBackupJob{}
+	_ resource.ResourceWithConfigure      = &ObjectStorageBackupJob{}
+	_ resource.ResourceWithImportState    = &ObjectStorageBackupJob{}
+	_ resource.ResourceWithIdentity       = &ObjectStorageBackupJob{}
+	_ resource.ResourceWithValidateConfig = &ObjectStorageBackupJob{}
+)
+
+// ObjectStorageBackupJob implements the veeam_object_storage_backup_job resource.
+type ObjectStorageBackupJob struct {
+	client client.APIClient
+}
+
+// ObjectStorageBackupJobResourceModel is the Terraform state model for
+// veeam_object_storage_backup_job.
+type ObjectStorageBackupJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	// Objects lists the protected sources, buckets and prefixes.
+	Objects []ObjectStorageBackupObject `tfsdk:"objects"`
+
+	RepositoryID      types.String `tfsdk:"repository_id"`
+	RetentionType     types.String `tfsdk:"retention_type"`
+	RetentionQuantity types.Int64  `tfsdk:"retention_quantity"`
+
+	// Archive is the same block as veeam_file_backup_job.archive.
+	Archive *UnstructuredArchive `tfsdk:"archive"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+// ObjectStorageBackupObject maps to ObjectStorageBackupJobObjectModel.
+type ObjectStorageBackupObject struct {
+	ServerID          types.String `tfsdk:"server_id"`
+	CloudCredentialID types.String `tfsdk:"cloud_credential_id"`
+	Bucket            types.String `tfsdk:"bucket"`
+	IncludePrefixes   types.List   `tfsdk:"include_prefixes"`
+	ExcludePrefixes   types.List   `tfsdk:"exclude_prefixes"`
+	ExcludedBuckets   types.List   `tfsdk:"excluded_buckets"`
+}
+
+func (r *ObjectStorageBackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_backup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// objectStorageBackupJobIdentity keys object storage backup jobs by UUID and
+// name.
+var objectStorageBackupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Object storage backup job name.",
+	lookup:         &backupJobImport,
+}
+
+var objectStorageBackupJobResource = jobResource{
+	typeName: "veeam_object_storage_backup_job",
+	kind:     "object storage backup job",
+	jobTypes: []models.EJobType{models.JobTypeObjectStorageBackup},
+}
+
+func (r *ObjectStorageBackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectStorageBackupJobIdentity.schema()
+}
+
+func (r *ObjectStorageBackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the object storage backup job (UUID assigned by Veeam).",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the object storage backup job. Must be unique across all jobs.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+			Required:            true,
+		},
+		"is_disabled": schema.BoolAttribute{
+			MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+				"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"objects": schema.ListNestedAttribute{
+			MarkdownDescription: "Object storage sources, buckets and prefixes to protect. " +
+				"At least one entry is required.",
+			Required: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"server_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the object storage source " +
+							"(`veeam_unstructured_data_server.id`).",
+						Required: true,
+					},
+					"cloud_credential_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the cloud credential used to read the source " +
+							"(`veeam_cloud_credential.id`). When omitted, the credential of the source is used.",
+						Optional: true,
+					},
+					"bucket": schema.StringAttribute{
+						MarkdownDescription: "Bucket or container to protect. When omitted, every bucket " +
+							"of the source is protected.",
+						Optional: true,
+					},
+					"include_prefixes": schema.ListAttribute{
+						MarkdownDescription: "Back up only objects under these prefixes, e.g. `invoices/`. " +
+							"Requires `bucket`.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"exclude_prefixes": schema.ListAttribute{
+						MarkdownDescription: "Skip objects under these prefixes, e.g. `tmp/`. Requires `bucket`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"excluded_buckets": schema.ListAttribute{
+						MarkdownDescription: "Buckets to skip when `bucket` is omitted.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"repository_id": schema.StringAttribute{
+			MarkdownDescription: "UUID of the backup repository that stores the object versions.",
+			Required:            true,
+		},
+		"archive":  unstructuredArchiveAttribute("objects"),
+		"schedule": scheduleAttribute(),
+	}
+	for k, v := range unstructuredRetentionAttributes("Short-term retention of object versions in `repository_id`.",
+		models.UnstructuredRetentionDays, 28) {
+		attrs[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam object storage backup job (`ObjectStorageBackup`) that " +
+			"protects buckets of object storage sources.",
+		Attributes: attrs,
+	}
+}
+
+// ValidateConfig checks the bucket, prefix and retention rules during plan so
+// that a conflicting object selection is reported before the job exists.
+func (r *ObjectStorageBackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ObjectStorageBackupJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// The objects list is not known yet; apply validates it.
+		return
+	}
+	if err := validateObjectStorageBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid object storage backup job configuration", err.Error())
+	}
+}
+
+func (r *ObjectStorageBackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *ObjectStorageBackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ObjectStorageBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateObjectStorageBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid object storage backup job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildObjectStorageBackupJobModel(&data, false)
+	spec := &models.ObjectStorageBackupJobSpec{
+		JobSpec:     models.JobSpec{Name: model.Name, Type: model.Type},
+		Description: model.Description,
+		Objects:     model.Objects,
+		Storage:     model.Storage,
+		Archive:     model.Archive,
+		Schedule:    model.Schedule,
+	}
+
+	var result models.ObjectStorageBackupJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create object storage backup job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create object storage backup job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncObjectStorageBackupJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(objectStorageBackupJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, objectStorageBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *ObjectStorageBackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ObjectStorageBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.ObjectStorageBackupJobModel
+	if !objectStorageBackupJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncObjectStorageBackupJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(objectStorageBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *ObjectStorageBackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ObjectStorageBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateObjectStorageBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid object storage backup job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.ObjectStorageBackupJobModel
+	payload := buildObjectStorageBackupJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, objectStorageBackupJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update object storage backup job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncObjectStorageBackupJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(objectStorageBackupJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, objectStorageBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *ObjectStorageBackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ObjectStorageBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objectStorageBackupJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *ObjectStorageBackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	objectStorageBackupJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewObjectStorageBackupJob returns a new veeam_object_storage_backup_job resource instance.
+func NewObjectStorageBackupJob() resource.Resource {
+	return &ObjectStorageBackupJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// objectStorageBackupJobManagedPaths are cleared on the server when the plan
+// leaves them out, so removing the archive block, its masks or a schedule
+// block takes effect on update.
+var objectStorageBackupJobManagedPaths = []string{
+	"archive.archiveRepositoryId",
+	"archive.retentionPolicy",
+	"archive.inclusionMask",
+	"archive.exclusionMask",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// validateObjectStorageBackupJob checks that each bucket is listed once, that
+// prefixes are only set for a single bucket and excluded_buckets only for a
+// whole source, and that retention, archive and backup window settings are
+// consistent.
+func validateObjectStorageBackupJob(data *ObjectStorageBackupJobResourceModel) error {
+	if len(data.Objects) == 0 {
+		return errors.New("objects must list at least one object storage source")
+	}
+	seen := map[string]bool{}
+	for i, o := range data.Objects {
+		path := fmt.Sprintf("objects[%d]", i)
+		// An unknown bucket may turn out to be either a single bucket or
+		// the whole source, so the bucket-dependent checks wait for apply.
+		if !o.Bucket.IsUnknown() {
+			key := o.ServerID.ValueString() + "|" + o.Bucket.ValueString()
+			if isConfigured(o.ServerID) && seen[key] {
+				return fmt.Errorf("%s: server %s bucket %q is listed more than once",
+					path, o.ServerID.ValueString(), o.Bucket.ValueString())
+			}
+			seen[key] = true
+
+			hasBucket := !o.Bucket.IsNull() && o.Bucket.ValueString() != ""
+			if !hasBucket && (len(listStrings(o.IncludePrefixes)) > 0 || len(listStrings(o.ExcludePrefixes)) > 0) {
+				return fmt.Errorf("%s: include_prefixes and exclude_prefixes require bucket", path)
+			}
+			if hasBucket && len(listStrings(o.ExcludedBuckets)) > 0 {
+				return fmt.Errorf("%s: excluded_buckets can only be set when bucket is omitted", path)
+			}
+		}
+		if err := validatePatternLists(path, "include_prefixes", "exclude_prefixes", o.IncludePrefixes, o.ExcludePrefixes); err != nil {
+			return err
+		}
+		if slices.ContainsFunc(listStrings(o.ExcludedBuckets), func(b string) bool { return strings.TrimSpace(b) == "" }) {
+			return fmt.Errorf("%s.excluded_buckets must not contain empty entries", path)
+		}
+	}
+
+	if err := validateUnstructuredRetention("", data.RetentionType, data.RetentionQuantity); err != nil {
+		return err
+	}
+	if err := validateUnstructuredArchive(data.Archive, data.RepositoryID); err != nil {
+		return err
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// buildObjectStorageBackupJobModel converts the plan into the full job model
+// used for PUT; Create derives the POST spec from it. The archive is always
+// sent so that removing it in HCL disables it on the job.
+func buildObjectStorageBackupJobModel(data *ObjectStorageBackupJobResourceModel, isDisabled bool) *models.ObjectStorageBackupJobModel {
+	m := &models.ObjectStorageBackupJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeObjectStorageBackup,
+			IsDisabled: isDisabled,
+		},
+		Description: data.Description.ValueString(),
+		Objects:     []models.ObjectStorageBackupJobObjectModel{},
+		Storage: &models.UnstructuredBackupStorageModel{
+			BackupRepositoryID: data.RepositoryID.ValueString(),
+			RetentionPolicy:    buildUnstructuredRetention(data.RetentionType, data.RetentionQuantity),
+		},
+		Archive:  buildUnstructuredArchive(data.Archive),
+		Schedule: buildScheduleModel(data.Schedule),
+	}
+
+	for _, o := range data.Objects {
+		m.Objects = append(m.Objects, models.ObjectStorageBackupJobObjectModel{
+			ObjectStorageServerID: o.ServerID.ValueString(),
+			CloudCredentialsID:    o.CloudCredentialID.ValueString(),
+			Bucket:                o.Bucket.ValueString(),
+			InclusionPrefixes:     listStrings(o.IncludePrefixes),
+			ExclusionPrefixes:     listStrings(o.ExcludePrefixes),
+			ExcludedBuckets:       listStrings(o.ExcludedBuckets),
+		})
+	}
+	return m
+}
+
+// syncObjectStorageBackupJobFromAPI refreshes the state from a job response.
+func syncObjectStorageBackupJobFromAPI(data *ObjectStorageBackupJobResourceModel, api *models.ObjectStorageBackupJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+
+	if api.Objects != nil {
+		data.Objects = nil
+		for _, o := range api.Objects {
+			data.Objects = append(data.Objects, ObjectStorageBackupObject{
+				ServerID:          types.StringValue(o.ObjectStorageServerID),
+				CloudCredentialID: stringOrNull(o.CloudCredentialsID),
+				Bucket:            stringOrNull(o.Bucket),
+				IncludePrefixes:   stringListOrNull(o.InclusionPrefixes),
+				ExcludePrefixes:   stringListOrNull(o.ExclusionPrefixes),
+				ExcludedBuckets:   stringListOrNull(o.ExcludedBuckets),
+			})
+		}
+	}
+
+	if s := api.Storage; s != nil {
+		data.RepositoryID = types.StringValue(s.BackupRepositoryID)
+		if rp := s.RetentionPolicy; rp != nil {
+			data.RetentionType = types.StringValue(string(rp.Type))
+			data.RetentionQuantity = types.Int64Value(int64(rp.Quantity))
+		}
+	}
+
+	data.Archive = syncUnstructuredArchiveFromAPI(api.Archive)
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// bucketObject protects bucket on serverID; an empty bucket selects every
+// bucket of the source.
+func bucketObject(serverID, bucket string) ObjectStorageBackupObject {
+	o := ObjectStorageBackupObject{
+		ServerID:          types.StringValue(serverID),
+		CloudCredentialID: types.StringNull(),
+		Bucket:            types.StringNull(),
+		IncludePrefixes:   types.ListNull(types.StringType),
+		ExcludePrefixes:   types.ListNull(types.StringType),
+		ExcludedBuckets:   types.ListNull(types.StringType),
+	}
+	if bucket != "" {
+		o.Bucket = types.StringValue(bucket)
+	}
+	return o
+}
+
+// ---------------------------------------------------------------------------
+// ObjectStorageBackupJob — buildObjectStorageBackupJobModel
+// ---------------------------------------------------------------------------
+
+func TestObjectStorageBackupJob_BuildModel(t *testing.T) {
+	billing := bucketObject("s3-1", "billing-prod")
+	billing.CloudCredentialID = types.StringValue("cc-1")
+	billing.IncludePrefixes = stringList("invoices/", "statements/")
+	billing.ExcludePrefixes = stringList("invoices/tmp/")
+	wholeSource := bucketObject("s3-2", "")
+	wholeSource.ExcludedBuckets = stringList("scratch")
+
+	tests := []struct {
+		name    string
+		objects []ObjectStorageBackupObject
+		want    []models.ObjectStorageBackupJobObjectModel
+	}{
+		{
+			name:    "one bucket narrowed by prefixes",
+			objects: []ObjectStorageBackupObject{billing},
+			want: []models.ObjectStorageBackupJobObjectModel{{
+				ObjectStorageServerID: "s3-1", CloudCredentialsID: "cc-1", Bucket: "billing-prod",
+				InclusionPrefixes: []string{"invoices/", "statements/"}, ExclusionPrefixes: []string{"invoices/tmp/"},
+			}},
+		},
+		{
+			name:    "whole source without one bucket",
+			objects: []ObjectStorageBackupObject{wholeSource},
+			want:    []models.ObjectStorageBackupJobObjectModel{{ObjectStorageServerID: "s3-2", ExcludedBuckets: []string{"scratch"}}},
+		},
+		{
+			name:    "no objects",
+			objects: nil,
+			want:    []models.ObjectStorageBackupJobObjectModel{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := buildObjectStorageBackupJobModel(&ObjectStorageBackupJobResourceModel{
+				Objects:           tt.objects,
+				RepositoryID:      types.StringValue("repo-1"),
+				RetentionType:     types.StringValue("Months"),
+				RetentionQuantity: types.Int64Value(3),
+			}, false)
+			assert.Equal(t, models.JobTypeObjectStorageBackup, m.Type)
+			assert.Equal(t, tt.want, m.Objects)
+			assert.Equal(t, &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionMonths, Quantity: 3}, m.Storage.RetentionPolicy)
+			assert.Equal(t, &models.UnstructuredBackupArchiveModel{IsEnabled: false}, m.Archive)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ObjectStorageBackupJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestObjectStorageBackupJob_ValidateConfig(t *testing.T) {
+	withLists := func(o ObjectStorageBackupObject, include, exclude, excludedBuckets types.List) ObjectStorageBackupObject {
+		if !include.IsNull() {
+			o.IncludePrefixes = include
+		}
+		if !exclude.IsNull() {
+			o.ExcludePrefixes = exclude
+		}
+		if !excludedBuckets.IsNull() {
+			o.ExcludedBuckets = excludedBuckets
+		}
+		return o
+	}
+	none := types.ListNull(types.StringType)
+	unknownBucket := bucketObject("s3-1", "")
+	unknownBucket.Bucket = types.StringUnknown()
+
+	tests := []struct {
+		name    string
+		objects []ObjectStorageBackupObject
+		wantErr string
+	}{
+		{
+			name:    "bucket and whole source on different servers",
+			objects: []ObjectStorageBackupObject{bucketObject("s3-1", "billing-prod"), bucketObject("s3-2", "")},
+		},
+		{
+			name:    "no objects",
+			objects: []ObjectStorageBackupObject{},
+			wantErr: "at least one object storage source",
+		},
+		{
+			name:    "bucket listed twice",
+			objects: []ObjectStorageBackupObject{bucketObject("s3-1", "billing-prod"), bucketObject("s3-1", "billing-prod")},
+			wantErr: `objects[1]: server s3-1 bucket "billing-prod" is listed more than once`,
+		},
+		{
+			name:    "whole source listed twice",
+			objects: []ObjectStorageBackupObject{bucketObject("s3-1", ""), bucketObject("s3-1", "")},
+			wantErr: `server s3-1 bucket "" is listed more than once`,
+		},
+		{
+			name:    "prefixes without a bucket",
+			objects: []ObjectStorageBackupObject{withLists(bucketObject("s3-1", ""), stringList("invoices/"), none, none)},
+			wantErr: "objects[0]: include_prefixes and exclude_prefixes require bucket",
+		},
+		{
+			name:    "excluded buckets with a bucket",
+			objects: []ObjectStorageBackupObject{withLists(bucketObject("s3-1", "billing-prod"), none, none, stringList("scratch"))},
+			wantErr: "objects[0]: excluded_buckets can only be set when bucket is omitted",
+		},
+		{
+			name:    "prefix included and excluded",
+			objects: []ObjectStorageBackupObject{withLists(bucketObject("s3-1", "billing-prod"), stringList("tmp/"), stringList("tmp/"), none)},
+			wantErr: `objects[0]: "tmp/" is both included and excluded`,
+		},
+		{
+			name:    "blank excluded bucket",
+			objects: []ObjectStorageBackupObject{withLists(bucketObject("s3-1", ""), none, none, stringList(""))},
+			wantErr: "objects[0].excluded_buckets must not contain empty entries",
+		},
+		{
+			name:    "bucket from another resource with prefixes",
+			objects: []ObjectStorageBackupObject{withLists(unknownBucket, stringList("invoices/"), none, none)},
+		},
+		{
+			name:    "buckets from another resource",
+			objects: []ObjectStorageBackupObject{unknownBucket, unknownBucket},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &ObjectStorageBackupJob{}, map[string]interface{}{"objects": tt.objects})
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ObjectStorageBackupJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestObjectStorageBackupJob_ManagedPaths checks that a removed archive is
+// disabled without its repository, that a narrowed source replaces the stored
+// object list, and that advanced settings survive the update.
+func TestObjectStorageBackupJob_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"objects": []interface{}{
+			map[string]interface{}{"objectStorageServerId": "s3-2", "excludedBuckets": []interface{}{"scratch"}},
+		},
+		"archive": map[string]interface{}{
+			"isEnabled":           true,
+			"archiveRepositoryId": "repo-glacier",
+			"retentionPolicy":     map[string]interface{}{"type": "Years", "quantity": float64(10)},
+		},
+		"advancedSettings": map[string]interface{}{"objectVersions": "All"},
+	}
+	merged, err := mergeManagedPayload(current, buildObjectStorageBackupJobModel(&ObjectStorageBackupJobResourceModel{
+		Objects:      []ObjectStorageBackupObject{bucketObject("s3-2", "logs")},
+		RepositoryID: types.StringValue("repo-1"),
+	}, false), objectStorageBackupJobManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+	}{
+		{"objects", []interface{}{map[string]interface{}{"objectStorageServerId": "s3-2", "bucket": "logs"}}},
+		{"archive", map[string]interface{}{"isEnabled": false}},
+		{"advancedSettings", map[string]interface{}{"objectVersions": "All"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ObjectStorageBackupJob — syncObjectStorageBackupJobFromAPI
+// ---------------------------------------------------------------------------
+
+func TestObjectStorageBackupJob_SyncFromAPI(t *testing.T) {
+	data := ObjectStorageBackupJobResourceModel{
+		Objects: []ObjectStorageBackupObject{bucketObject("s3-2", "")},
+		Archive: &UnstructuredArchive{RepositoryID: types.StringValue("repo-glacier")},
+	}
+	syncObjectStorageBackupJobFromAPI(&data, &models.ObjectStorageBackupJobModel{
+		JobModel: models.JobModel{ID: "osb-1", Name: "App-Buckets", Type: models.JobTypeObjectStorageBackup},
+		Objects: []models.ObjectStorageBackupJobObjectModel{
+			{ObjectStorageServerID: "s3-1", CloudCredentialsID: "cc-1", Bucket: "billing-prod", InclusionPrefixes: []string{"invoices/"}},
+			{ObjectStorageServerID: "s3-2", ExcludedBuckets: []string{"scratch"}},
+		},
+		Storage: &models.UnstructuredBackupStorageModel{
+			BackupRepositoryID: "repo-1",
+			RetentionPolicy:    &models.UnstructuredRetentionPolicyModel{Type: models.UnstructuredRetentionMonths, Quantity: 3},
+		},
+		Archive: &models.UnstructuredBackupArchiveModel{IsEnabled: false, ArchiveRepositoryID: "repo-glacier"},
+	})
+
+	billing := bucketObject("s3-1", "billing-prod")
+	billing.CloudCredentialID = types.StringValue("cc-1")
+	billing.IncludePrefixes = stringList("invoices/")
+	wholeSource := bucketObject("s3-2", "")
+	wholeSource.ExcludedBuckets = stringList("scratch")
+	assert.Equal(t, []ObjectStorageBackupObject{billing, wholeSource}, data.Objects)
+	assert.Equal(t, "Months", data.RetentionType.ValueString())
+	assert.Nil(t, data.Archive, "a disabled archive reads back as no archive block")
+}
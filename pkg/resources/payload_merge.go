@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+)
+
+// ---------------------------------------------------------------------------
+// Full-model PUT helpers
+//
+// Several V13 endpoints (jobs, repositories, proxies, protection groups) use
+// PUT with the complete object model. The provider only models a subset of
+// those fields, so sending the Terraform-built payload as-is resets every
+// field the provider does not know about (advanced storage settings, console
+// only options, fields added in newer builds, ...).
+//
+// The helpers below implement the "GET current → deep-merge managed fields →
+// PUT" cycle that singleton resources such as veeam_event_forwarding already
+// use, generalised to any typed payload.
+// ---------------------------------------------------------------------------
+
+// putMergedPayload reads the current object from endpoint, overlays desired
+// onto it with mergeManagedPayload and sends the merged document back via PUT.
+// The PUT response is decoded into result.
+func putMergedPayload(ctx context.Context, c client.APIClient, endpoint string, desired interface{}, result interface{}, managedPaths ...string) error {
+	var current map[string]interface{}
+	if err := c.GetJSON(ctx, endpoint, &current); err != nil {
+		return fmt.Errorf("reading current object before update: %w", err)
+	}
+
+	merged, err := mergeManagedPayload(current, desired, managedPaths...)
+	if err != nil {
+		return err
+	}
+
+	return c.PutJSON(ctx, endpoint, merged, result)
+}
+
+// mergeManagedPayload deep-merges desired (any JSON-serialisable value) into
+// current and returns the merged document:
+//
+//   - objects are merged key by key, recursively;
+//   - scalars and arrays from desired replace the current value wholesale;
+//   - keys only present in current are preserved.
+//
+// managedPaths are dot-separated paths (e.g. "storage.gfsPolicy") that the
+// provider owns outright. When the top-level section of a managed path is
+// present in desired but the path itself is not, the path is removed from the
+// merged document so that clearing an optional block in HCL still takes
+// effect instead of silently keeping the server value. A top-level managed
+// path (e.g. "volumes") is removed whenever desired leaves it out.
+//
+// A typed desired value is encoded with encoding/json, so a managed scalar
+// tagged omitempty never reaches the merge and false or 0 keeps the server
+// value. Managed bool and count fields must therefore not use omitempty.
+//
+// current is modified in place; a nil current is treated as an empty object.
+func mergeManagedPayload(current map[string]interface{}, desired interface{}, managedPaths ...string) (map[string]interface{}, error) {
+	desiredMap, err := toJSONMap(desired)
+	if err != nil {
+		return nil, fmt.Errorf("encoding update payload: %w", err)
+	}
+
+	if current == nil {
+		current = map[string]interface{}{}
+	}
+
+	for _, p := range managedPaths {
+		keys := strings.Split(p, ".")
+		if _, ok := desiredMap[keys[0]]; !ok && len(keys) > 1 {
+			continue
+		}
+		if _, ok := lookupJSONPath(desiredMap, keys); ok {
+			continue
+		}
+		deleteJSONPath(current, keys)
+	}
+
+	deepMergeJSON(current, desiredMap)
+	return current, nil
+}
+
+// toJSONMap round-trips v through encoding/json so typed request models and
+// plain maps can be merged uniformly.
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// fromJSONMap is the inverse of toJSONMap: it decodes a value taken from a
+// generic JSON document into a typed model. It reports false when raw is
+// missing or does not match the model.
+func fromJSONMap(raw interface{}, out interface{}) bool {
+	if raw == nil {
+		return false
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, out) == nil
+}
+
+// deepMergeJSON copies src into dst, recursing into objects present on both sides.
+func deepMergeJSON(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcObj, srcIsObj := v.(map[string]interface{})
+		dstObj, dstIsObj := dst[k].(map[string]interface{})
+		if srcIsObj && dstIsObj {
+			deepMergeJSON(dstObj, srcObj)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func lookupJSONPath(m map[string]interface{}, keys []string) (interface{}, bool) {
+	var cur interface{} = m
+	for _, k := range keys {
+		obj, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cur, ok = obj[k]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func deleteJSONPath(m map[string]interface{}, keys []string) {
+	parent, ok := lookupJSONPath(m, keys[:len(keys)-1])
+	if !ok {
+		return
+	}
+	if obj, ok := parent.(map[string]interface{}); ok {
+		delete(obj, keys[len(keys)-1])
+	}
+}
@@ -0,0 +1,521 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// ---------------------------------------------------------------------------
+// mergeManagedPayload
+// ---------------------------------------------------------------------------
+
+func TestMergeManagedPayload_PreservesUnknownFields(t *testing.T) {
+	current := map[string]interface{}{
+		"name":         "old",
+		"unknownField": "keep-me",
+		"storage": map[string]interface{}{
+			"backupRepositoryId": "repo-old",
+			"advancedSettings": map[string]interface{}{
+				"backupModeType": "Incremental",
+			},
+		},
+	}
+	desired := map[string]interface{}{
+		"name": "new",
+		"storage": map[string]interface{}{
+			"backupRepositoryId": "repo-new",
+		},
+	}
+
+	merged, err := mergeManagedPayload(current, desired)
+	require.NoError(t, err)
+
+	assert.Equal(t, "new", merged["name"])
+	assert.Equal(t, "keep-me", merged["unknownField"])
+	storage := merged["storage"].(map[string]interface{})
+	assert.Equal(t, "repo-new", storage["backupRepositoryId"])
+	assert.Equal(t, "Incremental", storage["advancedSettings"].(map[string]interface{})["backupModeType"])
+}
+
+func TestMergeManagedPayload_ArraysReplaced(t *testing.T) {
+	current := map[string]interface{}{
+		"includes": []interface{}{"a", "b", "c"},
+	}
+	desired := map[string]interface{}{
+		"includes": []string{"x"},
+	}
+
+	merged, err := mergeManagedPayload(current, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"x"}, merged["includes"])
+}
+
+func TestMergeManagedPayload_ManagedPathRemovedWhenCleared(t *testing.T) {
+	current := map[string]interface{}{
+		"schedule": map[string]interface{}{
+			"runAutomatically": true,
+			"daily":            map[string]interface{}{"isEnabled": true},
+			"backupWindow":     map[string]interface{}{"isEnabled": true},
+		},
+	}
+	desired := map[string]interface{}{
+		"schedule": map[string]interface{}{"runAutomatically": false},
+	}
+
+	merged, err := mergeManagedPayload(current, desired, "schedule.daily")
+	require.NoError(t, err)
+
+	schedule := merged["schedule"].(map[string]interface{})
+	assert.NotContains(t, schedule, "daily")
+	assert.Contains(t, schedule, "backupWindow", "unmanaged sibling must survive")
+	assert.Equal(t, false, schedule["runAutomatically"])
+}
+
+func TestMergeManagedPayload_ManagedPathKeptWhenSectionAbsent(t *testing.T) {
+	current := map[string]interface{}{
+		"schedule": map[string]interface{}{
+			"daily": map[string]interface{}{"isEnabled": true},
+		},
+	}
+
+	merged, err := mergeManagedPayload(current, map[string]interface{}{"name": "x"}, "schedule.daily")
+	require.NoError(t, err)
+	assert.Contains(t, merged["schedule"].(map[string]interface{}), "daily")
+}
+
+func TestMergeManagedPayload_TopLevelManagedPathRemovedWhenCleared(t *testing.T) {
+	current := map[string]interface{}{
+		"volumes": map[string]interface{}{"allVolumes": false, "volumeNames": []interface{}{"C:"}},
+		"files":   map[string]interface{}{"includedFolders": []interface{}{`C:\Users`}},
+	}
+	desired := map[string]interface{}{
+		"files": map[string]interface{}{"includedFolders": []string{`D:\Data`}},
+	}
+
+	merged, err := mergeManagedPayload(current, desired, "volumes", "files")
+	require.NoError(t, err)
+	assert.NotContains(t, merged, "volumes")
+	assert.Equal(t, map[string]interface{}{"includedFolders": []interface{}{`D:\Data`}}, merged["files"])
+}
+
+func TestMergeManagedPayload_NilCurrent(t *testing.T) {
+	merged, err := mergeManagedPayload(nil, map[string]interface{}{"name": "x"})
+	require.NoError(t, err)
+	assert.Equal(t, "x", merged["name"])
+}
+
+func TestMergeManagedPayload_EncodeError(t *testing.T) {
+	_, err := mergeManagedPayload(nil, map[string]interface{}{"bad": make(chan int)})
+	assert.Error(t, err)
+}
+
+// ---------------------------------------------------------------------------
+// Round-trip: unknown server-side fields survive Update
+// ---------------------------------------------------------------------------
+
+func TestBackupJob_Update_VSphere_PreservesUnmanagedFields(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{
+				"id":             "job-1",
+				"name":           "Old Name",
+				"type":           "VSphereBackup",
+				"isHighPriority": true,
+				"unknownField":   "keep-me",
+				"storage": map[string]interface{}{
+					"backupRepositoryId": "repo-old",
+					"advancedSettings": map[string]interface{}{
+						"storageData": map[string]interface{}{"compressionLevel": "Extreme"},
+					},
+				},
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/jobs/job-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+			result := args.Get(3).(*models.BackupJobModel)
+			result.ID = "job-1"
+			result.Name = "New Name"
+			result.Type = models.JobTypeVSphereBackup
+		}).Return(nil)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	planData := BackupJobModel{
+		ID:             types.StringValue("job-1"),
+		Name:           types.StringValue("New Name"),
+		Description:    types.StringValue("desc"),
+		Type:           types.StringValue("VSphereBackup"),
+		IsHighPriority: types.BoolValue(false),
+		IsDisabled:     types.BoolValue(false),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{
+				Platform: types.StringValue("VSphere"),
+				Type:     types.StringValue("VirtualMachine"),
+				HostName: types.StringValue("vcsa01"),
+				Name:     types.StringValue("vm-1"),
+				ObjectID: types.StringValue("vm-101"),
+			}},
+			ExcludeTemplates: types.BoolValue(false),
+		},
+		Storage: &JobStorageSettings{
+			RepositoryID:    types.StringValue("repo-new"),
+			ProxyAutoSelect: types.BoolValue(true),
+			ProxyIDs:        types.ListNull(types.StringType),
+		},
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), planData).HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), planData).HasError())
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	require.NotNil(t, sent)
+	assert.Equal(t, "keep-me", sent["unknownField"])
+	assert.Equal(t, "New Name", sent["name"])
+	assert.Equal(t, false, sent["isHighPriority"])
+	storage := sent["storage"].(map[string]interface{})
+	assert.Equal(t, "repo-new", storage["backupRepositoryId"])
+	assert.Equal(t, "Extreme",
+		storage["advancedSettings"].(map[string]interface{})["storageData"].(map[string]interface{})["compressionLevel"])
+	mockClient.AssertExpectations(t)
+}
+
+func TestBackupJob_Update_AgentLinux_PreservesUnmanagedFields(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/jobs/job-2", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]any)
+			*result = map[string]any{
+				"id":           "job-2",
+				"type":         "LinuxAgentBackup",
+				"unknownField": "keep-me",
+				"storage":      map[string]any{"advancedSettings": map[string]any{"backupModeType": "Full"}},
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/jobs/job-2", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+		}).Return(errors.New("stop after capture"))
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	planData := BackupJobModel{
+		ID:              types.StringValue("job-2"),
+		Name:            types.StringValue("Linux Backup"),
+		Type:            types.StringValue("LinuxAgentBackup"),
+		AgentBackupMode: types.StringValue("EntireComputer"),
+		AgentComputers: []AgentComputerEntry{
+			{ID: types.StringValue("c1"), Name: types.StringValue("srv"), Type: types.StringValue("Computer"), ProtectionGroupID: types.StringValue("")},
+		},
+		Storage: &JobStorageSettings{RepositoryID: types.StringValue("repo-1"), ProxyIDs: types.ListNull(types.StringType)},
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), planData).HasError())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), planData).HasError())
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	require.NotNil(t, sent)
+	assert.Equal(t, "keep-me", sent["unknownField"])
+	storage := sent["storage"].(map[string]interface{})
+	assert.Equal(t, "repo-1", storage["backupRepositoryId"])
+	assert.Equal(t, "Full", storage["advancedSettings"].(map[string]interface{})["backupModeType"])
+}
+
+func TestBackupJob_Update_GetCurrentError(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &BackupJob{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+		Return(errors.New("not reachable"))
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	tfType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	planData := BackupJobModel{
+		ID:          types.StringValue("job-1"),
+		Name:        types.StringValue("Job"),
+		Description: types.StringValue("desc"),
+		Type:        types.StringValue("VSphereBackup"),
+		VirtualMachines: &VMBackupScope{
+			Includes: []VMIncludeEntry{{Name: types.StringValue("vm-1")}},
+		},
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, plan.Set(context.Background(), planData).HasError())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullObjectForResourceSchema(tfType)}
+	require.False(t, state.Set(context.Background(), planData).HasError())
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	mockClient.AssertNotCalled(t, "PutJSON", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRepository_Update_PreservesUnmanagedFields(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &Repository{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/backupInfrastructure/repositories/repo-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{
+				"id":   "repo-1",
+				"type": "WinLocal",
+				"repository": map[string]interface{}{
+					"path":                 "D:\\Old",
+					"advancedSettings":     map[string]interface{}{"alignDataBlocks": true},
+					"perVmBackupFiles":     true,
+					"maxTaskCount":         float64(4),
+					"taskLimitEnabled":     true,
+					"readWriteLimitEnable": false,
+				},
+				"unknownField": "keep-me",
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/backupInfrastructure/repositories/repo-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+		}).Return(errors.New("stop after capture"))
+
+	plan := buildNullResourcePlan(r)
+	planTyp := plan.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for k, attrType := range planTyp.AttributeTypes {
+		switch k {
+		case "id":
+			vals[k] = tftypes.NewValue(attrType, "repo-1")
+		case "name":
+			vals[k] = tftypes.NewValue(attrType, "Repo01")
+		case "type":
+			vals[k] = tftypes.NewValue(attrType, "WinLocal")
+		case "path":
+			vals[k] = tftypes.NewValue(attrType, "D:\\New")
+		default:
+			vals[k] = nullValueForResourceType(attrType)
+		}
+	}
+	plan.Raw = tftypes.NewValue(planTyp, vals)
+	state := buildNullResourceState(r)
+	state.Raw = plan.Raw
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	require.NotNil(t, sent)
+	assert.Equal(t, "keep-me", sent["unknownField"])
+	repo := sent["repository"].(map[string]interface{})
+	assert.Equal(t, "D:\\New", repo["path"])
+	assert.Equal(t, true, repo["perVmBackupFiles"])
+	assert.Equal(t, true, repo["advancedSettings"].(map[string]interface{})["alignDataBlocks"])
+}
+
+// TestRepository_Update_SendsFalseAndZero verifies that turning a limit off
+// reaches the server: false and 0 must replace the current values instead of
+// being dropped from the payload and merged away.
+func TestRepository_Update_SendsFalseAndZero(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &Repository{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/backupInfrastructure/repositories/repo-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*map[string]interface{}) = map[string]interface{}{
+				"id":   "repo-1",
+				"type": "WinLocal",
+				"repository": map[string]interface{}{
+					"path":                  "D:\\Backups",
+					"maxTaskCount":          float64(4),
+					"taskLimitEnabled":      true,
+					"readWriteRate":         float64(200),
+					"readWriteLimitEnabled": true,
+				},
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/backupInfrastructure/repositories/repo-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+		}).Return(errors.New("stop after capture"))
+
+	plan := buildNullResourcePlan(r)
+	planTyp := plan.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for k, attrType := range planTyp.AttributeTypes {
+		switch k {
+		case "id":
+			vals[k] = tftypes.NewValue(attrType, "repo-1")
+		case "name":
+			vals[k] = tftypes.NewValue(attrType, "Repo01")
+		case "type":
+			vals[k] = tftypes.NewValue(attrType, "WinLocal")
+		case "path":
+			vals[k] = tftypes.NewValue(attrType, "D:\\Backups")
+		case "max_task_count", "read_write_rate":
+			vals[k] = tftypes.NewValue(attrType, 0)
+		case "task_limit_enabled", "read_write_limit_enabled":
+			vals[k] = tftypes.NewValue(attrType, false)
+		default:
+			vals[k] = nullValueForResourceType(attrType)
+		}
+	}
+	plan.Raw = tftypes.NewValue(planTyp, vals)
+	state := buildNullResourceState(r)
+	state.Raw = plan.Raw
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, resp)
+
+	require.NotNil(t, sent)
+	repo := sent["repository"].(map[string]interface{})
+	assert.Equal(t, false, repo["taskLimitEnabled"])
+	assert.EqualValues(t, 0, repo["maxTaskCount"])
+	assert.Equal(t, false, repo["readWriteLimitEnabled"])
+	assert.EqualValues(t, 0, repo["readWriteRate"])
+}
+
+func TestProxy_Update_PreservesUnmanagedFields(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &Proxy{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/backupInfrastructure/proxies/proxy-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{
+				"id":   "proxy-1",
+				"type": "ViProxy",
+				"server": map[string]interface{}{
+					"hostId":                "host-1",
+					"connectedDatastores":   map[string]interface{}{"autoSelectEnabled": false},
+					"hostToProxyEncryption": true,
+				},
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/backupInfrastructure/proxies/proxy-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+			result := args.Get(3).(*map[string]interface{})
+			*result = map[string]interface{}{"id": "proxy-1", "type": "ViProxy"}
+		}).Return(nil)
+
+	plan := buildNullResourcePlan(r)
+	planTyp := plan.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for k, attrType := range planTyp.AttributeTypes {
+		switch k {
+		case "id":
+			vals[k] = tftypes.NewValue(attrType, "proxy-1")
+		case "type":
+			vals[k] = tftypes.NewValue(attrType, "ViProxy")
+		case "host_id":
+			vals[k] = tftypes.NewValue(attrType, "host-1")
+		case "host_to_proxy_encryption":
+			// Unset in config; UseStateForUnknown carries the prior value.
+			vals[k] = tftypes.NewValue(attrType, true)
+		default:
+			vals[k] = nullValueForResourceType(attrType)
+		}
+	}
+	plan.Raw = tftypes.NewValue(planTyp, vals)
+	state := buildNullResourceState(r)
+	state.Raw = plan.Raw
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "unexpected errors: %v", resp.Diagnostics)
+	require.NotNil(t, sent)
+	server := sent["server"].(map[string]interface{})
+	assert.Equal(t, true, server["hostToProxyEncryption"])
+	assert.Equal(t, false, server["connectedDatastores"].(map[string]interface{})["autoSelectEnabled"])
+}
+
+func TestProtectionGroup_Update_PreservesUnmanagedFields(t *testing.T) {
+	mockClient := new(MockVeeamClient)
+	r := &ProtectionGroup{client: mockClient}
+
+	mockClient.On("GetJSON", mock.Anything, "/api/v1/agents/protectionGroups/pg-1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			result := args.Get(2).(*map[string]interface{})
+			*result = map[string]interface{}{
+				"id":           "pg-1",
+				"type":         "IndividualComputers",
+				"unknownField": "keep-me",
+				"options": map[string]interface{}{
+					"distributionServerId": "dist-1",
+				},
+			}
+		}).Return(nil)
+
+	var sent map[string]interface{}
+	mockClient.On("PutJSON", mock.Anything, "/api/v1/agents/protectionGroups/pg-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent = args.Get(2).(map[string]interface{})
+		}).Return(errors.New("stop after capture"))
+
+	planData := ProtectionGroupModel{
+		ID:   types.StringValue("pg-1"),
+		Name: types.StringValue("Test PG"),
+		Type: types.StringValue("IndividualComputers"),
+		Computers: []ProtectionGroupComputerModel{{
+			HostName:       types.StringValue("srv01"),
+			ConnectionType: types.StringValue("PermanentCredentials"),
+			CredentialsID:  types.StringValue("cred-1"),
+		}},
+	}
+	plan := buildProtectionGroupPlanWithType(r, "IndividualComputers")
+	require.False(t, plan.Set(context.Background(), planData).HasError())
+	state := buildProtectionGroupStateWithID(r, "IndividualComputers", "pg-1")
+
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), req, resp)
+
+	require.NotNil(t, sent, "diagnostics: %v", resp.Diagnostics)
+	assert.Equal(t, "keep-me", sent["unknownField"])
+	assert.Equal(t, "Test PG", sent["name"])
+	assert.Equal(t, "dist-1", sent["options"].(map[string]interface{})["distributionServerId"])
+}
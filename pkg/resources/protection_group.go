@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,6 +23,7 @@ var (
 	_ resource.Resource                = &ProtectionGroup{}
 	_ resource.ResourceWithConfigure   = &ProtectionGroup{}
 	_ resource.ResourceWithImportState = &ProtectionGroup{}
+	_ resource.ResourceWithIdentity    = &ProtectionGroup{}
 )
 
 // ProtectionGroup implements the veeam_protection_group resource.
@@ -100,6 +100,17 @@ type ProtectionGroupModel struct {
 
 func (r *ProtectionGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_protection_group"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// protectionGroupIdentity keys protection groups by UUID and name.
+var protectionGroupIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Protection group name.",
+}
+
+func (r *ProtectionGroup) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = protectionGroupIdentity.schema()
 }
 
 func (r *ProtectionGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -345,6 +356,7 @@ func (r *ProtectionGroup) Create(ctx context.Context, req resource.CreateRequest
 
 	normalizeUnknownStateFields(&data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(protectionGroupIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ProtectionGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -368,6 +380,7 @@ func (r *ProtectionGroup) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	normalizeUnknownStateFields(&data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(protectionGroupIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ProtectionGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -396,7 +409,7 @@ func (r *ProtectionGroup) Update(ctx context.Context, req resource.UpdateRequest
 
 	endpoint := fmt.Sprintf(client.PathProtectionGroupByID, plan.ID.ValueString())
 	var updateResult map[string]interface{}
-	if err := r.client.PutJSON(ctx, endpoint, payload, &updateResult); err != nil {
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &updateResult); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update protection group",
 			fmt.Sprintf("API error for group %s: %s", plan.ID.ValueString(), err),
@@ -450,6 +463,7 @@ func (r *ProtectionGroup) Update(ctx context.Context, req resource.UpdateRequest
 
 	normalizeUnknownStateFields(&plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(protectionGroupIdentity.set(ctx, resp.Identity, plan.ID, plan.Name)...)
 }
 
 func (r *ProtectionGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -478,7 +492,7 @@ func (r *ProtectionGroup) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ProtectionGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	protectionGroupIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewProtectionGroup returns a new veeam_protection_group resource instance.
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &Proxy{}
 	_ resource.ResourceWithConfigure   = &Proxy{}
 	_ resource.ResourceWithImportState = &Proxy{}
+	_ resource.ResourceWithIdentity    = &Proxy{}
 )
 
 // Proxy implements the veeam_proxy resource.
@@ -42,6 +43,17 @@ type ProxyModel struct {
 
 func (r *Proxy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_proxy"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// proxyIdentity keys proxies by UUID and the proxy name reported by VBR.
+var proxyIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Backup proxy name.",
+}
+
+func (r *Proxy) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = proxyIdentity.schema()
 }
 
 func (r *Proxy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -81,11 +93,17 @@ func (r *Proxy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resour
 				MarkdownDescription: "Failover to network transport if primary mode fails (`ViProxy` only).",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"host_to_proxy_encryption": schema.BoolAttribute{
 				MarkdownDescription: "Encrypt data between host and proxy (`ViProxy` only).",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"max_task_count": schema.Int64Attribute{
 				MarkdownDescription: "Maximum concurrent tasks.",
@@ -176,6 +194,7 @@ func (r *Proxy) Create(ctx context.Context, req resource.CreateRequest, resp *re
 		}
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(proxyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Proxy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -197,6 +216,7 @@ func (r *Proxy) Read(ctx context.Context, req resource.ReadRequest, resp *resour
 
 	r.syncFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(proxyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Proxy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -210,7 +230,7 @@ func (r *Proxy) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 
 	endpoint := fmt.Sprintf(client.PathProxyByID, data.ID.ValueString())
 	var result map[string]interface{}
-	if err := r.client.PutJSON(ctx, endpoint, payload, &result); err != nil {
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update proxy",
 			fmt.Sprintf("API error for proxy %s: %s", data.ID.ValueString(), err),
@@ -232,6 +252,7 @@ func (r *Proxy) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(proxyIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Proxy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -252,7 +273,7 @@ func (r *Proxy) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
 }
 
 func (r *Proxy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	proxyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewProxy returns a new veeam_proxy resource instance.
@@ -304,10 +325,10 @@ func (r *Proxy) buildSpec(data *ProxyModel) interface{} {
 		if !data.TransportMode.IsNull() {
 			server.TransportMode = models.EBackupProxyTransportMode(data.TransportMode.ValueString())
 		}
-		if !data.FailoverToNetwork.IsNull() {
+		if !data.FailoverToNetwork.IsNull() && !data.FailoverToNetwork.IsUnknown() {
 			server.FailoverToNetwork = data.FailoverToNetwork.ValueBool()
 		}
-		if !data.HostToProxyEncryption.IsNull() {
+		if !data.HostToProxyEncryption.IsNull() && !data.HostToProxyEncryption.IsUnknown() {
 			server.HostToProxyEncryption = data.HostToProxyEncryption.ValueBool()
 		}
 		if !data.MaxTaskCount.IsNull() && !data.MaxTaskCount.IsUnknown() {
@@ -342,6 +363,8 @@ func (r *Proxy) syncFromAPI(data *ProxyModel, api *models.ViProxyModel) {
 		if string(api.Server.TransportMode) != "" {
 			data.TransportMode = types.StringValue(string(api.Server.TransportMode))
 		}
+		data.FailoverToNetwork = types.BoolValue(api.Server.FailoverToNetwork)
+		data.HostToProxyEncryption = types.BoolValue(api.Server.HostToProxyEncryption)
 	}
 }
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &RecoveryToken{}
 	_ resource.ResourceWithConfigure   = &RecoveryToken{}
 	_ resource.ResourceWithImportState = &RecoveryToken{}
+	_ resource.ResourceWithIdentity    = &RecoveryToken{}
 )
 
 // RecoveryToken implements the veeam_recovery_token resource.
@@ -43,6 +43,17 @@ func NewRecoveryToken() resource.Resource {
 
 func (r *RecoveryToken) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_recovery_token"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// recoveryTokenIdentity keys recovery tokens by UUID and name.
+var recoveryTokenIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Recovery token name.",
+}
+
+func (r *RecoveryToken) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = recoveryTokenIdentity.schema()
 }
 
 func (r *RecoveryToken) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -136,6 +147,7 @@ func (r *RecoveryToken) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(recoveryTokenIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *RecoveryToken) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -159,6 +171,7 @@ func (r *RecoveryToken) Read(ctx context.Context, req resource.ReadRequest, resp
 	// does not return it after the initial creation response.
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(recoveryTokenIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *RecoveryToken) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -188,6 +201,7 @@ func (r *RecoveryToken) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(recoveryTokenIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *RecoveryToken) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -208,7 +222,7 @@ func (r *RecoveryToken) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *RecoveryToken) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	recoveryTokenIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
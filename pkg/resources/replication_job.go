@@ -0,0 +1,741 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &ReplicationJob{}
+	_ resource.ResourceWithConfigure      = &ReplicationJob{}
+	_ resource.ResourceWithImportState    = &ReplicationJob{}
+	_ resource.ResourceWithIdentity       = &ReplicationJob{}
+	_ resource.ResourceWithValidateConfig = &ReplicationJob{}
+)
+
+// ReplicationJob implements the veeam_replication_job resource.
+type ReplicationJob struct {
+	client client.APIClient
+}
+
+// ReplicationJobResourceModel is the Terraform state model for veeam_replication_job.
+type ReplicationJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	// VirtualMachines selects the source VMs.
+	VirtualMachines *ReplicationVirtualMachines `tfsdk:"virtual_machines"`
+	// Destination places the replicas.
+	Destination *ReplicationDestination `tfsdk:"destination"`
+
+	ReplicaSuffix       types.String `tfsdk:"replica_suffix"`
+	RestorePointsToKeep types.Int64  `tfsdk:"restore_points_to_keep"`
+
+	NetworkMappings []ReplicationNetworkMapping `tfsdk:"network_mappings"`
+	ReIPRules       []ReplicationReIPRule       `tfsdk:"re_ip_rules"`
+	Seeding         *ReplicationSeeding         `tfsdk:"seeding"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+// ReplicationVirtualMachines maps to ReplicaJobVirtualMachinesModel.
+type ReplicationVirtualMachines struct {
+	Includes []VMIncludeEntry `tfsdk:"includes"`
+	Excludes *VMExclusions    `tfsdk:"excludes"`
+}
+
+// ReplicationDestination maps to ReplicaJobDestinationModel.
+type ReplicationDestination struct {
+	// Host is an ESXi host or cluster.
+	Host         *VMIncludeEntry `tfsdk:"host"`
+	ResourcePool *VMIncludeEntry `tfsdk:"resource_pool"`
+	Folder       *VMIncludeEntry `tfsdk:"folder"`
+	Datastore    *VMIncludeEntry `tfsdk:"datastore"`
+}
+
+// ReplicationNetworkMapping maps to ReplicaJobNetworkMappingModel.
+type ReplicationNetworkMapping struct {
+	SourceNetwork *ReplicationNetwork `tfsdk:"source_network"`
+	TargetNetwork *ReplicationNetwork `tfsdk:"target_network"`
+}
+
+// ReplicationNetwork identifies a vSphere network (port group).
+type ReplicationNetwork struct {
+	HostName types.String `tfsdk:"host_name"`
+	Name     types.String `tfsdk:"name"`
+	ObjectID types.String `tfsdk:"object_id"`
+}
+
+// ReplicationReIPRule maps to ReplicaJobReIPRuleModel.
+type ReplicationReIPRule struct {
+	SourceIPAddress      types.String `tfsdk:"source_ip_address"`
+	SourceSubnetMask     types.String `tfsdk:"source_subnet_mask"`
+	TargetIPAddress      types.String `tfsdk:"target_ip_address"`
+	TargetSubnetMask     types.String `tfsdk:"target_subnet_mask"`
+	TargetDefaultGateway types.String `tfsdk:"target_default_gateway"`
+	TargetDNSAddresses   types.List   `tfsdk:"target_dns_addresses"`
+	Description          types.String `tfsdk:"description"`
+}
+
+// ReplicationSeeding maps to ReplicaJobInitialSeedingModel.
+type ReplicationSeeding struct {
+	RepositoryID types.String `tfsdk:"repository_id"`
+}
+
+func (r *ReplicationJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// replicationJobIdentity keys replication jobs by UUID and name.
+var replicationJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Replication job name.",
+	lookup:         &backupJobImport,
+}
+
+var replicationJobResource = jobResource{
+	typeName: "veeam_replication_job",
+	kind:     "replication job",
+	jobTypes: []models.EJobType{models.JobTypeVSphereReplica},
+}
+
+func (r *ReplicationJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = replicationJobIdentity.schema()
+}
+
+func (r *ReplicationJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	vsphereObject := func(description string, required bool) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Required:            required,
+			Optional:            !required,
+			Attributes:          vmObjectAttributes(),
+		}
+	}
+	network := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Required:            true,
+			Attributes: map[string]schema.Attribute{
+				"host_name": schema.StringAttribute{
+					MarkdownDescription: "vCenter Server or ESXi host that owns the network.",
+					Required:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Network (port group) name.",
+					Required:            true,
+				},
+				"object_id": schema.StringAttribute{
+					MarkdownDescription: "vSphere MoRef ID of the network (e.g. `network-21`, `dvportgroup-44`).",
+					Optional:            true,
+					Computed:            true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam vSphere replication job (`VSphereReplica`) that keeps " +
+			"replicas of source VMs on a target host or cluster for failover.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the replication job (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the replication job. Must be unique across all jobs.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+				Required:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+					"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_machines": schema.SingleNestedAttribute{
+				MarkdownDescription: "Source VMs to replicate.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"includes": schema.ListNestedAttribute{
+						MarkdownDescription: "vSphere objects to replicate. At least one entry is required.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: vmObjectAttributes(),
+						},
+					},
+					"excludes": vmExclusionsAttribute(),
+				},
+			},
+			"destination": schema.SingleNestedAttribute{
+				MarkdownDescription: "Where the replicas are created.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": vsphereObject("Target ESXi host (`Host`) or cluster (`Cluster`).", true),
+					"resource_pool": vsphereObject("Target resource pool. When omitted, replicas are "+
+						"placed in the root resource pool of `host`.", false),
+					"folder": vsphereObject("Target VM folder. When omitted, replicas are placed in "+
+						"the default folder.", false),
+					"datastore": vsphereObject("Target datastore (`Datastore`) or datastore cluster "+
+						"(`DatastoreCluster`) for the replica files.", true),
+				},
+			},
+			"replica_suffix": schema.StringAttribute{
+				MarkdownDescription: "Suffix appended to the source VM name to form the replica name. " +
+					"Defaults to `_replica`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("_replica"),
+			},
+			"restore_points_to_keep": schema.Int64Attribute{
+				MarkdownDescription: "Number of replica restore points kept on the target. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(7),
+			},
+			"network_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "Connects replicas to a different network than their source VMs. " +
+					"Networks not listed are kept.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_network": network("Network of the source VMs."),
+						"target_network": network("Network the replicas are connected to."),
+					},
+				},
+			},
+			"re_ip_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Address translation applied to Windows replicas on failover. " +
+					"Addresses may use `*` as a wildcard octet (e.g. `172.16.1.*`).",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source_ip_address": schema.StringAttribute{
+							MarkdownDescription: "Source address or range.",
+							Required:            true,
+						},
+						"source_subnet_mask": schema.StringAttribute{
+							MarkdownDescription: "Source subnet mask.",
+							Required:            true,
+						},
+						"target_ip_address": schema.StringAttribute{
+							MarkdownDescription: "Target address or range.",
+							Required:            true,
+						},
+						"target_subnet_mask": schema.StringAttribute{
+							MarkdownDescription: "Target subnet mask.",
+							Required:            true,
+						},
+						"target_default_gateway": schema.StringAttribute{
+							MarkdownDescription: "Default gateway of the replica.",
+							Optional:            true,
+						},
+						"target_dns_addresses": schema.ListAttribute{
+							MarkdownDescription: "DNS servers of the replica.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Rule description.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"seeding": schema.SingleNestedAttribute{
+				MarkdownDescription: "Creates the first replicas from existing backups instead of " +
+					"copying the source VMs over the network.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"repository_id": schema.StringAttribute{
+						MarkdownDescription: "UUID of the repository that holds the seed backups.",
+						Required:            true,
+					},
+				},
+			},
+			"schedule": scheduleAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks the source and destination object types, the
+// restore point count and the backup window before the job is created.
+func (r *ReplicationJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ReplicationJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// A block that is unknown until apply has nothing to check yet.
+		return
+	}
+	if err := validateReplicationJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid replication job configuration", err.Error())
+	}
+}
+
+func (r *ReplicationJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *ReplicationJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReplicationJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateReplicationJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid replication job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildReplicationJobModel(&data, false)
+	spec := &models.ReplicaJobSpec{
+		JobSpec:         models.JobSpec{Name: model.Name, Type: model.Type},
+		Description:     model.Description,
+		VirtualMachines: model.VirtualMachines,
+		Destination:     model.Destination,
+		Network:         model.Network,
+		ReIP:            model.ReIP,
+		JobSettings:     model.JobSettings,
+		Seeding:         model.Seeding,
+		Schedule:        model.Schedule,
+	}
+
+	var result models.ReplicaJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create replication job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create replication job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncReplicationJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(replicationJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, replicationJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *ReplicationJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReplicationJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.ReplicaJobModel
+	if !replicationJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncReplicationJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(replicationJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *ReplicationJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ReplicationJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateReplicationJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid replication job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.ReplicaJobModel
+	payload := buildReplicationJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, replicationJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update replication job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncReplicationJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(replicationJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, replicationJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *ReplicationJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReplicationJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replicationJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *ReplicationJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	replicationJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewReplicationJob returns a new veeam_replication_job resource instance.
+func NewReplicationJob() resource.Resource {
+	return &ReplicationJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// replicationJobManagedPaths are cleared on the server when the plan leaves
+// them out, so removing excludes, a destination pool or folder, re-IP rules,
+// seeding or a schedule block takes effect on update.
+var replicationJobManagedPaths = []string{
+	"virtualMachines.excludes",
+	"destination.resourcePool",
+	"destination.folder",
+	"reIp.rules",
+	"seeding.initialSeeding.repositoryId",
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// replicationDestinationTypes lists the object types accepted per
+// destination field; an empty type lets the API infer it.
+var replicationDestinationTypes = map[string][]string{
+	"host":          {string(models.VmwareTypeHost), string(models.VmwareTypeCluster)},
+	"resource_pool": {string(models.VmwareTypeResourcePool)},
+	"folder":        {string(models.VmwareTypeFolder)},
+	"datastore":     {string(models.VmwareTypeDatastore), string(models.VmwareTypeDatastoreCluster)},
+}
+
+// validateReplicationJob checks that every source and destination object is a
+// vSphere object of a type the field accepts, that at least one restore point
+// is kept and that the backup window is well formed.
+func validateReplicationJob(data *ReplicationJobResourceModel) error {
+	jobType := string(models.JobTypeVSphereReplica)
+	platform := models.InventoryPlatformVSphere
+
+	if vms := data.VirtualMachines; vms != nil {
+		if len(vms.Includes) == 0 {
+			return errors.New("virtual_machines.includes must list at least one object")
+		}
+		for i, vm := range vms.Includes {
+			if err := checkVMObject(fmt.Sprintf("virtual_machines.includes[%d]", i), jobType, platform, vm.Platform, vm.Type, vm.Name.ValueString()); err != nil {
+				return err
+			}
+		}
+		if ex := vms.Excludes; ex != nil {
+			for i, vm := range ex.VMs {
+				if err := checkVMObject(fmt.Sprintf("virtual_machines.excludes.vms[%d]", i), jobType, platform, vm.Platform, vm.Type, vm.Name.ValueString()); err != nil {
+					return err
+				}
+			}
+			for i, d := range ex.Disks {
+				if err := checkVMObject(fmt.Sprintf("virtual_machines.excludes.disks[%d]", i), jobType, platform, d.Platform, d.Type, d.Name.ValueString()); err != nil {
+					return err
+				}
+			}
+		}
+		if err := validateVMExclusions(&VMBackupScope{Excludes: vms.Excludes}); err != nil {
+			return err
+		}
+	}
+
+	if d := data.Destination; d != nil {
+		for _, f := range []struct {
+			name string
+			obj  *VMIncludeEntry
+		}{{"host", d.Host}, {"resource_pool", d.ResourcePool}, {"folder", d.Folder}, {"datastore", d.Datastore}} {
+			if f.obj == nil {
+				continue
+			}
+			if isConfigured(f.obj.Platform) && models.EInventoryPlatformType(f.obj.Platform.ValueString()) != platform {
+				return fmt.Errorf("destination.%s (%s): platform %q is not supported; replicas require %q",
+					f.name, f.obj.Name.ValueString(), f.obj.Platform.ValueString(), platform)
+			}
+			allowed := replicationDestinationTypes[f.name]
+			if t := f.obj.Type; isConfigured(t) && t.ValueString() != "" &&
+				!slices.Contains(allowed, t.ValueString()) {
+				return fmt.Errorf("destination.%s (%s): unsupported object type %q; expected one of %s",
+					f.name, f.obj.Name.ValueString(), t.ValueString(), strings.Join(allowed, ", "))
+			}
+		}
+	}
+
+	if isConfigured(data.RestorePointsToKeep) && data.RestorePointsToKeep.ValueInt64() < 1 {
+		return errors.New("restore_points_to_keep must be at least 1")
+	}
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// buildReplicationJobModel converts the plan into the full job model used for
+// PUT; Create derives the POST spec from it. Network, re-IP and seeding are
+// always sent so that removing them in HCL clears them on the job.
+func buildReplicationJobModel(data *ReplicationJobResourceModel, isDisabled bool) *models.ReplicaJobModel {
+	m := &models.ReplicaJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeVSphereReplica,
+			IsDisabled: isDisabled,
+		},
+		Description:     data.Description.ValueString(),
+		VirtualMachines: &models.ReplicaJobVirtualMachinesModel{Includes: []models.VmwareObjectSpec{}},
+		Destination:     &models.ReplicaJobDestinationModel{},
+		Network:         &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{}},
+		ReIP:            &models.ReplicaJobReIPModel{},
+		JobSettings: &models.ReplicaJobSettingsModel{
+			ReplicaNameSuffix:   data.ReplicaSuffix.ValueString(),
+			RestorePointsToKeep: int(data.RestorePointsToKeep.ValueInt64()),
+		},
+		Seeding:  &models.ReplicaJobSeedingModel{InitialSeeding: &models.ReplicaJobInitialSeedingModel{}},
+		Schedule: buildScheduleModel(data.Schedule),
+	}
+
+	if vms := data.VirtualMachines; vms != nil {
+		for _, vm := range vms.Includes {
+			m.VirtualMachines.Includes = append(m.VirtualMachines.Includes, buildVmwareObject(vm))
+		}
+		m.VirtualMachines.Excludes = buildVMExclusions(&VMBackupScope{Excludes: vms.Excludes})
+	}
+
+	if d := data.Destination; d != nil {
+		if d.Host != nil {
+			m.Destination.Host = buildVmwareObject(*d.Host)
+		}
+		if d.ResourcePool != nil {
+			pool := buildVmwareObject(*d.ResourcePool)
+			m.Destination.ResourcePool = &pool
+		}
+		if d.Folder != nil {
+			folder := buildVmwareObject(*d.Folder)
+			m.Destination.Folder = &folder
+		}
+		if d.Datastore != nil {
+			m.Destination.Datastore = buildVmwareObject(*d.Datastore)
+		}
+	}
+
+	for _, nm := range data.NetworkMappings {
+		m.Network.Mappings = append(m.Network.Mappings, models.ReplicaJobNetworkMappingModel{
+			SourceNetwork: buildReplicationNetwork(nm.SourceNetwork),
+			TargetNetwork: buildReplicationNetwork(nm.TargetNetwork),
+		})
+	}
+
+	for _, rule := range data.ReIPRules {
+		apiRule := models.ReplicaJobReIPRuleModel{
+			SourceIPAddress:      rule.SourceIPAddress.ValueString(),
+			SourceSubnetMask:     rule.SourceSubnetMask.ValueString(),
+			TargetIPAddress:      rule.TargetIPAddress.ValueString(),
+			TargetSubnetMask:     rule.TargetSubnetMask.ValueString(),
+			TargetDefaultGateway: rule.TargetDefaultGateway.ValueString(),
+			Description:          rule.Description.ValueString(),
+		}
+		if !rule.TargetDNSAddresses.IsNull() && !rule.TargetDNSAddresses.IsUnknown() {
+			rule.TargetDNSAddresses.ElementsAs(context.Background(), &apiRule.TargetDNSAddresses, false)
+		}
+		m.ReIP.Rules = append(m.ReIP.Rules, apiRule)
+	}
+	m.ReIP.IsEnabled = len(m.ReIP.Rules) > 0
+
+	if s := data.Seeding; s != nil {
+		m.Seeding.InitialSeeding = &models.ReplicaJobInitialSeedingModel{
+			IsEnabled:    true,
+			RepositoryID: s.RepositoryID.ValueString(),
+		}
+	}
+	return m
+}
+
+// buildVmwareObject converts an entry into a vSphere object; replicas and
+// their destinations are vSphere only.
+func buildVmwareObject(entry VMIncludeEntry) models.VmwareObjectSpec {
+	o := buildVMObject(entry)
+	return models.VmwareObjectSpec{
+		Platform: o.Platform,
+		HostName: o.HostName,
+		Name:     o.Name,
+		Type:     models.EVmwareInventoryType(o.Type),
+		ObjectID: o.ObjectID,
+	}
+}
+
+// syncVmwareObjectFromAPI is the inverse of buildVmwareObject.
+func syncVmwareObjectFromAPI(o models.VmwareObjectSpec) VMIncludeEntry {
+	return syncVMObjectFromAPI(models.InventoryObjectSpec{
+		Platform: o.Platform,
+		HostName: o.HostName,
+		Name:     o.Name,
+		Type:     string(o.Type),
+		ObjectID: o.ObjectID,
+	})
+}
+
+// buildReplicationNetwork converts a network reference into a vSphere
+// Network object.
+func buildReplicationNetwork(n *ReplicationNetwork) models.VmwareObjectSpec {
+	if n == nil {
+		return models.VmwareObjectSpec{}
+	}
+	return models.VmwareObjectSpec{
+		Platform: string(models.InventoryPlatformVSphere),
+		HostName: n.HostName.ValueString(),
+		Name:     n.Name.ValueString(),
+		Type:     models.VmwareTypeNetwork,
+		ObjectID: n.ObjectID.ValueString(),
+	}
+}
+
+// syncReplicationJobFromAPI refreshes the state from a job response.
+func syncReplicationJobFromAPI(data *ReplicationJobResourceModel, api *models.ReplicaJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+
+	if vms := api.VirtualMachines; vms != nil {
+		scope := &ReplicationVirtualMachines{}
+		for _, vm := range vms.Includes {
+			scope.Includes = append(scope.Includes, syncVmwareObjectFromAPI(vm))
+		}
+		var prior *VMExclusions
+		if data.VirtualMachines != nil {
+			prior = data.VirtualMachines.Excludes
+		}
+		excludes := vms.Excludes
+		if excludes == nil {
+			excludes = &models.BackupJobExclusions{}
+		}
+		scope.Excludes = syncVMExclusionsFromAPI(prior, excludes)
+		data.VirtualMachines = scope
+	}
+
+	if d := api.Destination; d != nil {
+		host := syncVmwareObjectFromAPI(d.Host)
+		datastore := syncVmwareObjectFromAPI(d.Datastore)
+		dest := &ReplicationDestination{Host: &host, Datastore: &datastore}
+		if d.ResourcePool != nil {
+			pool := syncVmwareObjectFromAPI(*d.ResourcePool)
+			dest.ResourcePool = &pool
+		}
+		if d.Folder != nil {
+			folder := syncVmwareObjectFromAPI(*d.Folder)
+			dest.Folder = &folder
+		}
+		data.Destination = dest
+	}
+
+	if js := api.JobSettings; js != nil {
+		data.ReplicaSuffix = types.StringValue(js.ReplicaNameSuffix)
+		data.RestorePointsToKeep = types.Int64Value(int64(js.RestorePointsToKeep))
+	}
+
+	data.NetworkMappings = nil
+	if api.Network != nil {
+		for _, nm := range api.Network.Mappings {
+			data.NetworkMappings = append(data.NetworkMappings, ReplicationNetworkMapping{
+				SourceNetwork: syncReplicationNetworkFromAPI(nm.SourceNetwork),
+				TargetNetwork: syncReplicationNetworkFromAPI(nm.TargetNetwork),
+			})
+		}
+	}
+
+	data.ReIPRules = nil
+	if api.ReIP != nil && api.ReIP.IsEnabled {
+		for _, rule := range api.ReIP.Rules {
+			dns := types.ListNull(types.StringType)
+			if len(rule.TargetDNSAddresses) > 0 {
+				dns, _ = types.ListValueFrom(context.Background(), types.StringType, rule.TargetDNSAddresses)
+			}
+			data.ReIPRules = append(data.ReIPRules, ReplicationReIPRule{
+				SourceIPAddress:      types.StringValue(rule.SourceIPAddress),
+				SourceSubnetMask:     types.StringValue(rule.SourceSubnetMask),
+				TargetIPAddress:      types.StringValue(rule.TargetIPAddress),
+				TargetSubnetMask:     types.StringValue(rule.TargetSubnetMask),
+				TargetDefaultGateway: stringOrNull(rule.TargetDefaultGateway),
+				TargetDNSAddresses:   dns,
+				Description:          stringOrNull(rule.Description),
+			})
+		}
+	}
+
+	data.Seeding = nil
+	if s := api.Seeding; s != nil && s.InitialSeeding != nil && s.InitialSeeding.IsEnabled {
+		data.Seeding = &ReplicationSeeding{RepositoryID: types.StringValue(s.InitialSeeding.RepositoryID)}
+	}
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
+
+func syncReplicationNetworkFromAPI(n models.VmwareObjectSpec) *ReplicationNetwork {
+	return &ReplicationNetwork{
+		HostName: types.StringValue(n.HostName),
+		Name:     types.StringValue(n.Name),
+		ObjectID: types.StringValue(n.ObjectID),
+	}
+}
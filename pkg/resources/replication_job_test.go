@@ -0,0 +1,327 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func vsphereEntry(objectType, name, objectID string) *VMIncludeEntry {
+	return &VMIncludeEntry{
+		Platform: types.StringValue("VSphere"),
+		Type:     types.StringValue(objectType),
+		HostName: types.StringValue("vcsa-dr.corp.local"),
+		Name:     types.StringValue(name),
+		ObjectID: types.StringValue(objectID),
+	}
+}
+
+// replicaDestination places replicas on DR-Cluster and dr-ds01.
+func replicaDestination() *ReplicationDestination {
+	return &ReplicationDestination{
+		Host:      vsphereEntry("Cluster", "DR-Cluster", "domain-c8"),
+		Datastore: vsphereEntry("Datastore", "dr-ds01", "datastore-40"),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ReplicationJob — buildReplicationJobModel
+// ---------------------------------------------------------------------------
+
+func TestReplicationJob_BuildModel(t *testing.T) {
+	vmware := func(objectType models.EVmwareInventoryType, name, objectID string) models.VmwareObjectSpec {
+		return models.VmwareObjectSpec{Platform: "VSphere", HostName: "vcsa-dr.corp.local", Name: name, Type: objectType, ObjectID: objectID}
+	}
+	noSeeding := &models.ReplicaJobSeedingModel{InitialSeeding: &models.ReplicaJobInitialSeedingModel{}}
+	tests := []struct {
+		name        string
+		data        ReplicationJobResourceModel
+		destination *models.ReplicaJobDestinationModel
+		network     *models.ReplicaJobNetworkModel
+		reIP        *models.ReplicaJobReIPModel
+		seeding     *models.ReplicaJobSeedingModel
+	}{
+		{
+			name: "cluster and datastore only",
+			data: ReplicationJobResourceModel{Destination: replicaDestination()},
+			destination: &models.ReplicaJobDestinationModel{
+				Host:      vmware(models.VmwareTypeCluster, "DR-Cluster", "domain-c8"),
+				Datastore: vmware(models.VmwareTypeDatastore, "dr-ds01", "datastore-40"),
+			},
+			network: &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{}},
+			reIP:    &models.ReplicaJobReIPModel{},
+			seeding: noSeeding,
+		},
+		{
+			name: "resource pool and folder",
+			data: ReplicationJobResourceModel{Destination: &ReplicationDestination{
+				Host:         vsphereEntry("Host", "esx-dr01", "host-21"),
+				ResourcePool: vsphereEntry("ResourcePool", "Replicas", "resgroup-12"),
+				Folder:       vsphereEntry("Folder", "Replicas", "group-v30"),
+				Datastore:    vsphereEntry("DatastoreCluster", "dr-pod", "group-p5"),
+			}},
+			destination: &models.ReplicaJobDestinationModel{
+				Host:         vmware(models.VmwareTypeHost, "esx-dr01", "host-21"),
+				ResourcePool: &models.VmwareObjectSpec{Platform: "VSphere", HostName: "vcsa-dr.corp.local", Name: "Replicas", Type: models.VmwareTypeResourcePool, ObjectID: "resgroup-12"},
+				Folder:       &models.VmwareObjectSpec{Platform: "VSphere", HostName: "vcsa-dr.corp.local", Name: "Replicas", Type: models.VmwareTypeFolder, ObjectID: "group-v30"},
+				Datastore:    vmware(models.VmwareTypeDatastoreCluster, "dr-pod", "group-p5"),
+			},
+			network: &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{}},
+			reIP:    &models.ReplicaJobReIPModel{},
+			seeding: noSeeding,
+		},
+		{
+			name: "network mapping with an unresolved source",
+			data: ReplicationJobResourceModel{NetworkMappings: []ReplicationNetworkMapping{{
+				SourceNetwork: &ReplicationNetwork{
+					HostName: types.StringValue("vcsa01.corp.local"),
+					Name:     types.StringValue("VM Network"),
+					ObjectID: types.StringNull(),
+				},
+				TargetNetwork: &ReplicationNetwork{
+					HostName: types.StringValue("vcsa-dr.corp.local"),
+					Name:     types.StringValue("DR Network"),
+					ObjectID: types.StringValue("dvportgroup-44"),
+				},
+			}}},
+			destination: &models.ReplicaJobDestinationModel{},
+			network: &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{{
+				SourceNetwork: models.VmwareObjectSpec{Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "VM Network", Type: models.VmwareTypeNetwork},
+				TargetNetwork: vmware(models.VmwareTypeNetwork, "DR Network", "dvportgroup-44"),
+			}}},
+			reIP:    &models.ReplicaJobReIPModel{},
+			seeding: noSeeding,
+		},
+		{
+			name: "re-IP rule enables re-IP",
+			data: ReplicationJobResourceModel{ReIPRules: []ReplicationReIPRule{{
+				SourceIPAddress:      types.StringValue("10.1.1.*"),
+				SourceSubnetMask:     types.StringValue("255.255.255.0"),
+				TargetIPAddress:      types.StringValue("10.2.1.*"),
+				TargetSubnetMask:     types.StringValue("255.255.255.0"),
+				TargetDefaultGateway: types.StringValue("10.2.1.1"),
+				TargetDNSAddresses:   stringList("10.2.0.10", "10.2.0.11"),
+				Description:          types.StringNull(),
+			}}},
+			destination: &models.ReplicaJobDestinationModel{},
+			network:     &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{}},
+			reIP: &models.ReplicaJobReIPModel{IsEnabled: true, Rules: []models.ReplicaJobReIPRuleModel{{
+				SourceIPAddress: "10.1.1.*", SourceSubnetMask: "255.255.255.0",
+				TargetIPAddress: "10.2.1.*", TargetSubnetMask: "255.255.255.0",
+				TargetDefaultGateway: "10.2.1.1", TargetDNSAddresses: []string{"10.2.0.10", "10.2.0.11"},
+			}}},
+			seeding: noSeeding,
+		},
+		{
+			name:        "seeding from a repository",
+			data:        ReplicationJobResourceModel{Seeding: &ReplicationSeeding{RepositoryID: types.StringValue("repo-dr")}},
+			destination: &models.ReplicaJobDestinationModel{},
+			network:     &models.ReplicaJobNetworkModel{Mappings: []models.ReplicaJobNetworkMappingModel{}},
+			reIP:        &models.ReplicaJobReIPModel{},
+			seeding: &models.ReplicaJobSeedingModel{
+				InitialSeeding: &models.ReplicaJobInitialSeedingModel{IsEnabled: true, RepositoryID: "repo-dr"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := buildReplicationJobModel(&tt.data, false)
+			assert.Equal(t, models.JobTypeVSphereReplica, m.Type)
+			assert.Equal(t, tt.destination, m.Destination)
+			assert.Equal(t, tt.network, m.Network)
+			assert.Equal(t, tt.reIP, m.ReIP)
+			assert.Equal(t, tt.seeding, m.Seeding)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ReplicationJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestReplicationJob_ValidateConfig(t *testing.T) {
+	sql01 := &ReplicationVirtualMachines{Includes: []VMIncludeEntry{*vsphereEntry("VirtualMachine", "sql01", "vm-101")}}
+	withDestination := func(mutate func(*ReplicationDestination)) *ReplicationDestination {
+		d := replicaDestination()
+		mutate(d)
+		return d
+	}
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "vSphere VM to a cluster",
+			attrs: map[string]interface{}{"virtual_machines": sql01, "destination": replicaDestination()},
+		},
+		{
+			name:    "no includes",
+			attrs:   map[string]interface{}{"virtual_machines": &ReplicationVirtualMachines{}, "destination": replicaDestination()},
+			wantErr: "at least one object",
+		},
+		{
+			name: "Hyper-V source",
+			attrs: map[string]interface{}{"virtual_machines": &ReplicationVirtualMachines{Includes: []VMIncludeEntry{{
+				Platform: types.StringValue("HyperV"), Name: types.StringValue("sql01"),
+			}}}, "destination": replicaDestination()},
+			wantErr: "does not match job type VSphereReplica",
+		},
+		{
+			name: "folder as destination host",
+			attrs: map[string]interface{}{"virtual_machines": sql01, "destination": withDestination(func(d *ReplicationDestination) {
+				d.Host = vsphereEntry("Folder", "DR-Cluster", "group-v30")
+			})},
+			wantErr: "destination.host (DR-Cluster): unsupported object type",
+		},
+		{
+			name: "Hyper-V datastore",
+			attrs: map[string]interface{}{"virtual_machines": sql01, "destination": withDestination(func(d *ReplicationDestination) {
+				d.Datastore.Platform = types.StringValue("HyperV")
+			})},
+			wantErr: "replicas require",
+		},
+		{
+			name:    "no restore points",
+			attrs:   map[string]interface{}{"virtual_machines": sql01, "destination": replicaDestination(), "restore_points_to_keep": 0},
+			wantErr: "restore_points_to_keep must be at least 1",
+		},
+		{
+			name: "selected disks without disks",
+			attrs: map[string]interface{}{"destination": replicaDestination(), "virtual_machines": &ReplicationVirtualMachines{
+				Includes: sql01.Includes,
+				Excludes: &VMExclusions{Disks: []VMDiskExclusion{{
+					Platform:       types.StringValue("VSphere"),
+					Name:           types.StringValue("sql01"),
+					DisksToProcess: types.StringValue("SelectedDisks"),
+					Disks:          types.ListNull(types.StringType),
+				}}},
+			}},
+			wantErr: "must list at least one disk",
+		},
+		{
+			name: "datastore type from a data source",
+			attrs: map[string]interface{}{"virtual_machines": sql01, "destination": withDestination(func(d *ReplicationDestination) {
+				d.Datastore.Type = types.StringUnknown()
+			})},
+		},
+		{
+			name:  "restore points from a variable",
+			attrs: map[string]interface{}{"virtual_machines": sql01, "destination": replicaDestination(), "restore_points_to_keep": types.Int64Unknown()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &ReplicationJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ReplicationJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestReplicationJob_ManagedPaths checks that a folder, re-IP rules or
+// seeding removed from HCL are cleared on the job, while data transfer
+// settings the resource does not manage survive the update.
+func TestReplicationJob_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"destination": map[string]interface{}{
+			"folder": map[string]interface{}{"name": "Replicas", "objectId": "group-v30"},
+		},
+		"reIp": map[string]interface{}{
+			"isEnabled": true,
+			"rules":     []interface{}{map[string]interface{}{"sourceIPAddress": "10.1.1.*"}},
+		},
+		"seeding": map[string]interface{}{
+			"initialSeeding": map[string]interface{}{"isEnabled": true, "repositoryId": "repo-dr"},
+		},
+		"dataTransfer": map[string]interface{}{"proxyAutoSelect": true},
+	}
+	merged, err := mergeManagedPayload(current,
+		buildReplicationJobModel(&ReplicationJobResourceModel{Destination: replicaDestination()}, false),
+		replicationJobManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+		present bool
+	}{
+		{section: "destination.folder"},
+		{section: "reIp", want: map[string]interface{}{"isEnabled": false}, present: true},
+		{section: "seeding", want: map[string]interface{}{"initialSeeding": map[string]interface{}{"isEnabled": false}}, present: true},
+		{section: "dataTransfer", want: map[string]interface{}{"proxyAutoSelect": true}, present: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, ok := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.present, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// ReplicationJob — syncReplicationJobFromAPI
+// ---------------------------------------------------------------------------
+
+func TestReplicationJob_SyncFromAPI(t *testing.T) {
+	t.Run("re-IP and seeding switched off in the console", func(t *testing.T) {
+		data := ReplicationJobResourceModel{
+			ReIPRules: []ReplicationReIPRule{{SourceIPAddress: types.StringValue("10.1.1.*")}},
+			Seeding:   &ReplicationSeeding{RepositoryID: types.StringValue("repo-dr")},
+		}
+		syncReplicationJobFromAPI(&data, &models.ReplicaJobModel{
+			JobModel: models.JobModel{ID: "rep-1", Name: "Tier1-Replica", Type: models.JobTypeVSphereReplica},
+			ReIP: &models.ReplicaJobReIPModel{IsEnabled: false, Rules: []models.ReplicaJobReIPRuleModel{{
+				SourceIPAddress: "10.1.1.*", SourceSubnetMask: "255.255.255.0",
+				TargetIPAddress: "10.2.1.*", TargetSubnetMask: "255.255.255.0",
+			}}},
+			Seeding: &models.ReplicaJobSeedingModel{
+				InitialSeeding: &models.ReplicaJobInitialSeedingModel{IsEnabled: false, RepositoryID: "repo-dr"},
+			},
+		})
+		assert.Nil(t, data.ReIPRules)
+		assert.Nil(t, data.Seeding)
+		assert.Nil(t, data.NetworkMappings)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		planned := ReplicationJobResourceModel{
+			VirtualMachines:     &ReplicationVirtualMachines{Includes: []VMIncludeEntry{*vsphereEntry("VirtualMachine", "sql01", "vm-101")}},
+			Destination:         replicaDestination(),
+			ReplicaSuffix:       types.StringValue("_dr"),
+			RestorePointsToKeep: types.Int64Value(14),
+			ReIPRules: []ReplicationReIPRule{{
+				SourceIPAddress:      types.StringValue("10.1.1.*"),
+				SourceSubnetMask:     types.StringValue("255.255.255.0"),
+				TargetIPAddress:      types.StringValue("10.2.1.*"),
+				TargetSubnetMask:     types.StringValue("255.255.255.0"),
+				TargetDefaultGateway: types.StringNull(),
+				TargetDNSAddresses:   types.ListNull(types.StringType),
+				Description:          types.StringNull(),
+			}},
+			Seeding: &ReplicationSeeding{RepositoryID: types.StringValue("repo-dr")},
+		}
+		var data ReplicationJobResourceModel
+		syncReplicationJobFromAPI(&data, buildReplicationJobModel(&planned, false))
+		assert.Equal(t, planned.Destination, data.Destination)
+		assert.Equal(t, planned.VirtualMachines.Includes, data.VirtualMachines.Includes)
+		assert.Equal(t, "_dr", data.ReplicaSuffix.ValueString())
+		assert.Equal(t, int64(14), data.RestorePointsToKeep.ValueInt64())
+		assert.Equal(t, planned.ReIPRules, data.ReIPRules)
+		assert.Equal(t, planned.Seeding, data.Seeding)
+	})
+}
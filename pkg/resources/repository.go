@@ -7,9 +7,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -57,6 +58,7 @@ var (
 	_ resource.Resource                = &Repository{}
 	_ resource.ResourceWithConfigure   = &Repository{}
 	_ resource.ResourceWithImportState = &Repository{}
+	_ resource.ResourceWithIdentity    = &Repository{}
 )
 
 // Repository implements the veeam_repository resource.
@@ -85,6 +87,18 @@ type RepositoryModel struct {
 
 func (r *Repository) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_repository"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// repositoryIdentity pairs the repository UUID with the repository name.
+var repositoryIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Backup repository name.",
+	lookup:         &repositoryImport,
+}
+
+func (r *Repository) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = repositoryIdentity.schema()
 }
 
 func (r *Repository) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -130,21 +144,33 @@ func (r *Repository) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 				MarkdownDescription: "Maximum concurrent tasks.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"task_limit_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Enable the concurrent task limit. Must be `true` when `max_task_count` is set.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"read_write_rate": schema.Int64Attribute{
 				MarkdownDescription: "Maximum read/write rate in MB/s.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"read_write_limit_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Enable the read/write rate limit. Must be `true` when `read_write_rate` is set.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"share_path": schema.StringAttribute{
 				MarkdownDescription: "Network share path (Nfs or Smb types).",
@@ -161,6 +187,9 @@ func (r *Repository) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					"Optional, Computed. Applies to `LinuxLocal` repository type only.",
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -247,6 +276,7 @@ func (r *Repository) Create(ctx context.Context, req resource.CreateRequest, res
 		}
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(repositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Repository) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -275,6 +305,7 @@ func (r *Repository) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	r.syncFromAPIMap(&data, result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(repositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Repository) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -288,7 +319,7 @@ func (r *Repository) Update(ctx context.Context, req resource.UpdateRequest, res
 
 	endpoint := fmt.Sprintf(client.PathRepositoryByID, data.ID.ValueString())
 	var result map[string]interface{}
-	if err := r.client.PutJSON(ctx, endpoint, payload, &result); err != nil {
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, "share.credentialsId"); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to update repository",
 			fmt.Sprintf("API error for repository %s: %s", data.ID.ValueString(), err),
@@ -318,6 +349,7 @@ func (r *Repository) Update(ctx context.Context, req resource.UpdateRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(repositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *Repository) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -337,8 +369,15 @@ func (r *Repository) Delete(ctx context.Context, req resource.DeleteRequest, res
 	}
 }
 
+// repositoryImport resolves "name:<repository name>" import IDs in addition to UUIDs.
+var repositoryImport = naturalKeyImport{
+	kind:         "repository",
+	listEndpoint: client.PathRepositories,
+	keys:         map[string]string{"name": "name"},
+}
+
 func (r *Repository) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	repositoryIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewRepository returns a new veeam_repository resource instance.
@@ -638,8 +638,6 @@ func TestBackupJob_BuildAgentFilesScopeModel_Nil(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestBackupJob_BuildScheduleModel_Monthly(t *testing.T) {
-	r := &BackupJob{}
-
 	s := &JobScheduleSettings{
 		RunAutomatically:  types.BoolValue(true),
 		MonthlyEnabled:    types.BoolValue(true),
@@ -647,7 +645,7 @@ func TestBackupJob_BuildScheduleModel_Monthly(t *testing.T) {
 		MonthlyDayOfMonth: types.Int64Value(15),
 	}
 
-	model := r.buildScheduleModel(s)
+	model := buildScheduleModel(s)
 
 	require.NotNil(t, model)
 	assert.True(t, model.RunAutomatically)
@@ -658,8 +656,6 @@ func TestBackupJob_BuildScheduleModel_Monthly(t *testing.T) {
 }
 
 func TestBackupJob_BuildScheduleModel_Periodically(t *testing.T) {
-	r := &BackupJob{}
-
 	s := &JobScheduleSettings{
 		RunAutomatically:      types.BoolValue(true),
 		PeriodicallyEnabled:   types.BoolValue(true),
@@ -667,7 +663,7 @@ func TestBackupJob_BuildScheduleModel_Periodically(t *testing.T) {
 		PeriodicallyFrequency: types.Int64Value(6),
 	}
 
-	model := r.buildScheduleModel(s)
+	model := buildScheduleModel(s)
 
 	require.NotNil(t, model)
 	require.NotNil(t, model.Periodically)
@@ -677,8 +673,7 @@ func TestBackupJob_BuildScheduleModel_Periodically(t *testing.T) {
 }
 
 func TestBackupJob_BuildScheduleModel_Nil(t *testing.T) {
-	r := &BackupJob{}
-	model := r.buildScheduleModel(nil)
+	model := buildScheduleModel(nil)
 	assert.Nil(t, model)
 }
 
@@ -835,8 +830,6 @@ func TestBackupJob_SyncAgentFromAPI_Linux_Fields(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestBackupJob_SyncScheduleFromAPI_Periodically(t *testing.T) {
-	r := &BackupJob{}
-
 	api := &models.BackupScheduleModel{
 		RunAutomatically: true,
 		Periodically: &models.SchedulePeriodicallyModel{
@@ -846,7 +839,7 @@ func TestBackupJob_SyncScheduleFromAPI_Periodically(t *testing.T) {
 		},
 	}
 
-	result := r.syncScheduleFromAPI(nil, api)
+	result := syncScheduleFromAPI(nil, api)
 
 	require.NotNil(t, result)
 	assert.True(t, result.PeriodicallyEnabled.ValueBool())
@@ -855,8 +848,6 @@ func TestBackupJob_SyncScheduleFromAPI_Periodically(t *testing.T) {
 }
 
 func TestBackupJob_SyncScheduleFromAPI_AfterJob(t *testing.T) {
-	r := &BackupJob{}
-
 	api := &models.BackupScheduleModel{
 		RunAutomatically: true,
 		AfterThisJob: &models.ScheduleAfterThisJobModel{
@@ -865,7 +856,7 @@ func TestBackupJob_SyncScheduleFromAPI_AfterJob(t *testing.T) {
 		},
 	}
 
-	result := r.syncScheduleFromAPI(nil, api)
+	result := syncScheduleFromAPI(nil, api)
 
 	require.NotNil(t, result)
 	assert.True(t, result.AfterJobEnabled.ValueBool())
@@ -873,14 +864,12 @@ func TestBackupJob_SyncScheduleFromAPI_AfterJob(t *testing.T) {
 }
 
 func TestBackupJob_SyncScheduleFromAPI_NoSchedule(t *testing.T) {
-	r := &BackupJob{}
-
 	// When daily/monthly/periodically/afterJob/retry are all nil.
 	api := &models.BackupScheduleModel{
 		RunAutomatically: false,
 	}
 
-	result := r.syncScheduleFromAPI(nil, api)
+	result := syncScheduleFromAPI(nil, api)
 
 	require.NotNil(t, result)
 	assert.False(t, result.RunAutomatically.ValueBool())
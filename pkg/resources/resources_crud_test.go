@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -57,6 +59,34 @@ func buildNullResourcePlan(r resource.Resource) tfsdk.Plan {
 	}
 }
 
+// resourceConfig builds a tfsdk.Config for r with the given top-level
+// attributes set and every other attribute null, as Terraform sends an
+// omitted attribute or block.
+func resourceConfig(t *testing.T, r resource.Resource, attrs map[string]interface{}) tfsdk.Config {
+	t.Helper()
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	null := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		null[name] = tftypes.NewValue(typ, nil)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, null)}
+	for name, v := range attrs {
+		require.False(t, state.SetAttribute(context.Background(), path.Root(name), v).HasError(), "setting %s", name)
+	}
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// validateResourceConfig runs ValidateConfig on a configuration with the
+// given top-level attributes.
+func validateResourceConfig(t *testing.T, r resource.ResourceWithValidateConfig, attrs map[string]interface{}) diag.Diagnostics {
+	t.Helper()
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: resourceConfig(t, r, attrs)}, resp)
+	return resp.Diagnostics
+}
+
 // nullObjectForResourceSchema recursively produces a tftypes.Value with all
 // leaves null, walking the tftypes.Type tree.
 func nullObjectForResourceSchema(typ tftypes.Type) tftypes.Value {
@@ -1629,6 +1659,8 @@ func TestRepository_Update_Error(t *testing.T) {
 	mockClient := new(MockVeeamClient)
 	r := &Repository{client: mockClient}
 
+	mockClient.On("GetJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+		Return(nil)
 	mockClient.On("PutJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.Anything).
 		Return(errors.New("update failed"))
 
@@ -1901,6 +1933,8 @@ func TestProxy_Update_Success(t *testing.T) {
 	mockClient := new(MockVeeamClient)
 	r := &Proxy{client: mockClient}
 
+	mockClient.On("GetJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+		Return(nil)
 	// PUT returns a sync result with type set (not async).
 	mockClient.On("PutJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.Anything).
 		Run(func(args mock.Arguments) {
@@ -1938,6 +1972,8 @@ func TestProxy_Update_Error(t *testing.T) {
 	mockClient := new(MockVeeamClient)
 	r := &Proxy{client: mockClient}
 
+	mockClient.On("GetJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+		Return(nil)
 	mockClient.On("PutJSON", mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.Anything).
 		Return(errors.New("update failed"))
 
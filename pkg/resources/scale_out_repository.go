@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &ScaleOutRepository{}
 	_ resource.ResourceWithConfigure   = &ScaleOutRepository{}
 	_ resource.ResourceWithImportState = &ScaleOutRepository{}
+	_ resource.ResourceWithIdentity    = &ScaleOutRepository{}
 )
 
 // ScaleOutRepository implements the veeam_scale_out_repository resource.
@@ -46,6 +46,17 @@ type SOBRPlacementPolicy struct {
 
 func (r *ScaleOutRepository) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_scale_out_repository"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// scaleOutRepositoryIdentity keys SOBRs by UUID and name.
+var scaleOutRepositoryIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Scale-out backup repository name.",
+}
+
+func (r *ScaleOutRepository) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = scaleOutRepositoryIdentity.schema()
 }
 
 func (r *ScaleOutRepository) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -166,6 +177,7 @@ func (r *ScaleOutRepository) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(scaleOutRepositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ScaleOutRepository) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -189,6 +201,7 @@ func (r *ScaleOutRepository) Read(ctx context.Context, req resource.ReadRequest,
 
 	resp.Diagnostics.Append(r.syncFromAPI(ctx, &data, &result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(scaleOutRepositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ScaleOutRepository) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -232,6 +245,7 @@ func (r *ScaleOutRepository) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(scaleOutRepositoryIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *ScaleOutRepository) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -250,7 +264,7 @@ func (r *ScaleOutRepository) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ScaleOutRepository) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	scaleOutRepositoryIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewScaleOutRepository returns a new veeam_scale_out_repository resource instance.
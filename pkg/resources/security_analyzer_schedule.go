@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -22,6 +21,7 @@ var (
 	_ resource.Resource                = &SecurityAnalyzerSchedule{}
 	_ resource.ResourceWithConfigure   = &SecurityAnalyzerSchedule{}
 	_ resource.ResourceWithImportState = &SecurityAnalyzerSchedule{}
+	_ resource.ResourceWithIdentity    = &SecurityAnalyzerSchedule{}
 )
 
 // securityAnalyzerScheduleID is the fixed singleton ID used in Terraform state.
@@ -54,6 +54,10 @@ func (r *SecurityAnalyzerSchedule) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_security_analyzer_schedule"
 }
 
+func (r *SecurityAnalyzerSchedule) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *SecurityAnalyzerSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages the Veeam security analyzer scan schedule singleton " +
@@ -146,6 +150,7 @@ func (r *SecurityAnalyzerSchedule) Create(ctx context.Context, req resource.Crea
 
 	data.ID = types.StringValue(securityAnalyzerScheduleID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *SecurityAnalyzerSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -164,6 +169,7 @@ func (r *SecurityAnalyzerSchedule) Read(ctx context.Context, req resource.ReadRe
 	syncSecurityAnalyzerScheduleFromAPI(&data, &result)
 	data.ID = types.StringValue(securityAnalyzerScheduleID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Update applies plan changes via GET → merge → PUT.
@@ -181,6 +187,7 @@ func (r *SecurityAnalyzerSchedule) Update(ctx context.Context, req resource.Upda
 
 	data.ID = types.StringValue(securityAnalyzerScheduleID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Delete removes the resource from Terraform state only. The server-side
@@ -190,7 +197,7 @@ func (r *SecurityAnalyzerSchedule) Delete(_ context.Context, _ resource.DeleteRe
 }
 
 func (r *SecurityAnalyzerSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &SecuritySettings{}
 	_ resource.ResourceWithConfigure   = &SecuritySettings{}
 	_ resource.ResourceWithImportState = &SecuritySettings{}
+	_ resource.ResourceWithIdentity    = &SecuritySettings{}
 )
 
 // SecuritySettings manages the Veeam server security settings singleton.
@@ -48,6 +48,10 @@ func (r *SecuritySettings) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_security_settings"
 }
 
+func (r *SecuritySettings) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *SecuritySettings) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateForUnknownBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
 	useStateForUnknownInt64 := []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
@@ -137,6 +141,7 @@ func (r *SecuritySettings) Create(ctx context.Context, req resource.CreateReques
 
 	data.ID = types.StringValue("security-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *SecuritySettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -155,6 +160,7 @@ func (r *SecuritySettings) Read(ctx context.Context, req resource.ReadRequest, r
 	syncSecuritySettingsFromAPI(&data, raw)
 	data.ID = types.StringValue("security-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *SecuritySettings) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -171,6 +177,7 @@ func (r *SecuritySettings) Update(ctx context.Context, req resource.UpdateReques
 
 	data.ID = types.StringValue("security-settings")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *SecuritySettings) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
@@ -178,7 +185,7 @@ func (r *SecuritySettings) Delete(_ context.Context, _ resource.DeleteRequest, _
 }
 
 func (r *SecuritySettings) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // putSecuritySettings GETs the current server config, merges plan fields, and PUTs the result.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &SecurityUser{}
 	_ resource.ResourceWithConfigure   = &SecurityUser{}
 	_ resource.ResourceWithImportState = &SecurityUser{}
+	_ resource.ResourceWithIdentity    = &SecurityUser{}
 )
 
 // SecurityUser implements the veeam_security_user resource.
@@ -39,6 +39,17 @@ type SecurityUserModel struct {
 
 func (r *SecurityUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_security_user"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// securityUserIdentity keys users by UUID and login (immutable in VBR).
+var securityUserIdentity = resourceIdentity{
+	key:            "login",
+	keyDescription: "User or group login.",
+}
+
+func (r *SecurityUser) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = securityUserIdentity.schema()
 }
 
 func (r *SecurityUser) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -145,6 +156,7 @@ func (r *SecurityUser) Create(ctx context.Context, req resource.CreateRequest, r
 	// Step 3: read back to populate computed fields.
 	r.syncModelFromAPI(&data, &userResult)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(securityUserIdentity.set(ctx, resp.Identity, data.ID, data.Login)...)
 }
 
 func (r *SecurityUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,6 +192,7 @@ func (r *SecurityUser) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Role = types.StringValue(roleResult.RoleName)
 	// Password is never returned by the API — keep current state value.
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(securityUserIdentity.set(ctx, resp.Identity, data.ID, data.Login)...)
 }
 
 // Update is intentionally not implemented. The RequiresReplace plan modifiers on
@@ -195,6 +208,7 @@ func (r *SecurityUser) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(securityUserIdentity.set(ctx, resp.Identity, data.ID, data.Login)...)
 }
 
 func (r *SecurityUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -215,7 +229,7 @@ func (r *SecurityUser) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *SecurityUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	securityUserIdentity.importState(ctx, r.client, req, resp)
 }
 
 // NewSecurityUser returns a new veeam_security_user resource instance.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -21,6 +20,7 @@ var (
 	_ resource.Resource                = &StorageLatency{}
 	_ resource.ResourceWithConfigure   = &StorageLatency{}
 	_ resource.ResourceWithImportState = &StorageLatency{}
+	_ resource.ResourceWithIdentity    = &StorageLatency{}
 )
 
 // storageLatencyID is the fixed singleton ID used in Terraform state.
@@ -52,6 +52,10 @@ func (r *StorageLatency) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_storage_latency"
 }
 
+func (r *StorageLatency) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *StorageLatency) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	useStateString := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
 	useStateBool := []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
@@ -142,6 +146,7 @@ func (r *StorageLatency) Create(ctx context.Context, req resource.CreateRequest,
 
 	data.ID = types.StringValue(storageLatencyID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *StorageLatency) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,6 +165,7 @@ func (r *StorageLatency) Read(ctx context.Context, req resource.ReadRequest, res
 	syncStorageLatencyFromPayload(raw, &data)
 	data.ID = types.StringValue(storageLatencyID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Update applies plan changes via GET → merge → PUT.
@@ -177,6 +183,7 @@ func (r *StorageLatency) Update(ctx context.Context, req resource.UpdateRequest,
 
 	data.ID = types.StringValue(storageLatencyID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 // Delete removes the resource from Terraform state only. The server-side
@@ -186,7 +193,7 @@ func (r *StorageLatency) Delete(_ context.Context, _ resource.DeleteRequest, _ *
 }
 
 func (r *StorageLatency) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
@@ -0,0 +1,509 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &SureBackupJob{}
+	_ resource.ResourceWithConfigure      = &SureBackupJob{}
+	_ resource.ResourceWithImportState    = &SureBackupJob{}
+	_ resource.ResourceWithIdentity       = &SureBackupJob{}
+	_ resource.ResourceWithValidateConfig = &SureBackupJob{}
+)
+
+// SureBackupJob implements the veeam_surebackup_job resource.
+type SureBackupJob struct {
+	client client.APIClient
+}
+
+// SureBackupJobResourceModel is the Terraform state model for veeam_surebackup_job.
+type SureBackupJobResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+
+	VirtualLabID                types.String `tfsdk:"virtual_lab_id"`
+	ApplicationGroupID          types.String `tfsdk:"application_group_id"`
+	KeepApplicationGroupRunning types.Bool   `tfsdk:"keep_application_group_running"`
+
+	LinkedJobs   *SureBackupLinkedJobs   `tfsdk:"linked_jobs"`
+	Verification *SureBackupVerification `tfsdk:"verification"`
+
+	// Schedule is the same block as veeam_backup_job.schedule.
+	Schedule *JobScheduleSettings `tfsdk:"schedule"`
+}
+
+// SureBackupLinkedJobs maps to SureBackupJobLinkedJobsModel.
+type SureBackupLinkedJobs struct {
+	JobIDs           types.List  `tfsdk:"job_ids"`
+	Roles            types.List  `tfsdk:"roles"`
+	MaxConcurrentVMs types.Int64 `tfsdk:"max_concurrent_vms"`
+}
+
+// SureBackupVerification maps to SureBackupJobVerificationModel.
+type SureBackupVerification struct {
+	HeartbeatTest       types.Bool `tfsdk:"heartbeat_test"`
+	PingTest            types.Bool `tfsdk:"ping_test"`
+	ScriptTests         types.Bool `tfsdk:"script_tests"`
+	ValidateBackupFiles types.Bool `tfsdk:"validate_backup_files"`
+	MalwareScan         types.Bool `tfsdk:"malware_scan"`
+}
+
+// defaultSureBackupVerification is sent when the verification block is
+// omitted: the VM tests run, the slower file and malware checks do not.
+var defaultSureBackupVerification = models.SureBackupJobVerificationModel{
+	HeartbeatTest: true,
+	PingTest:      true,
+	ScriptTest:    true,
+}
+
+func (r *SureBackupJob) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_surebackup_job"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// sureBackupJobIdentity keys SureBackup jobs by UUID and name.
+var sureBackupJobIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "SureBackup job name.",
+	lookup:         &backupJobImport,
+}
+
+var sureBackupJobResource = jobResource{
+	typeName: "veeam_surebackup_job",
+	kind:     "SureBackup job",
+	jobTypes: []models.EJobType{models.JobTypeSureBackup},
+}
+
+func (r *SureBackupJob) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = sureBackupJobIdentity.schema()
+}
+
+func (r *SureBackupJob) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam SureBackup job (`SureBackup`) that starts VMs from backups in a " +
+			"virtual lab and verifies that they are recoverable.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the SureBackup job (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the SureBackup job. Must be unique across all jobs.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description. Required by the Veeam API.",
+				Required:            true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the job is disabled. Applied through the job " +
+					"enable/disable endpoints. When omitted, the current state is tracked but not changed.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_lab_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the virtual lab the VMs are started in (`veeam_virtual_lab.id`).",
+				Required:            true,
+			},
+			"application_group_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the application group started before the linked jobs are tested " +
+					"(`veeam_application_group.id`). At least one of `application_group_id` and `linked_jobs` " +
+					"is required.",
+				Optional: true,
+			},
+			"keep_application_group_running": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, the application group keeps running in the lab after the job " +
+					"completes. Requires `application_group_id`. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"linked_jobs": schema.SingleNestedAttribute{
+				MarkdownDescription: "Backup jobs whose VMs are tested.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"job_ids": schema.ListAttribute{
+						MarkdownDescription: "UUIDs of the backup jobs (for example `veeam_backup_job.id`).",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+					"roles": schema.ListAttribute{
+						MarkdownDescription: "Roles whose predefined tests are run against every linked VM. " +
+							"Same values as `veeam_application_group` VM roles.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"max_concurrent_vms": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of linked VMs running in the lab at the same time. " +
+							"Defaults to `3`.",
+						Optional: true,
+						Computed: true,
+						Default:  int64default.StaticInt64(3),
+					},
+				},
+			},
+			"verification": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tests run against every VM. When omitted, the heartbeat, ping and script " +
+					"tests run and backup files are neither validated nor scanned for malware.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"heartbeat_test": schema.BoolAttribute{
+						MarkdownDescription: "Wait for the VMware Tools heartbeat. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"ping_test": schema.BoolAttribute{
+						MarkdownDescription: "Check that the VM responds to ping. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"script_tests": schema.BoolAttribute{
+						MarkdownDescription: "Run the role and custom test scripts. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"validate_backup_files": schema.BoolAttribute{
+						MarkdownDescription: "Validate the backup files with a CRC check after the VM tests. " +
+							"Defaults to `false`.",
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"malware_scan": schema.BoolAttribute{
+						MarkdownDescription: "Scan the restore points for malware. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"schedule": scheduleAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks what the job verifies, the linked jobs and the
+// backup window before the job is created.
+func (r *SureBackupJob) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SureBackupJobResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// An unknown linked_jobs object cannot be decoded until apply.
+		return
+	}
+	if err := validateSureBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid SureBackup job configuration", err.Error())
+	}
+}
+
+func (r *SureBackupJob) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *SureBackupJob) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SureBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateSureBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid SureBackup job configuration", err.Error())
+		return
+	}
+
+	wantDisabled := !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() && data.IsDisabled.ValueBool()
+	model := buildSureBackupJobModel(&data, false)
+	spec := &models.SureBackupJobSpec{
+		JobSpec:             models.JobSpec{Name: model.Name, Type: model.Type},
+		Description:         model.Description,
+		VirtualLabID:        model.VirtualLabID,
+		ApplicationGroup:    model.ApplicationGroup,
+		LinkedJobs:          model.LinkedJobs,
+		VerificationOptions: model.VerificationOptions,
+		Schedule:            model.Schedule,
+	}
+
+	var result models.SureBackupJobModel
+	if err := r.client.PostJSON(ctx, client.PathJobs, spec, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create SureBackup job",
+			fmt.Sprintf("POST %s: %s", client.PathJobs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create SureBackup job",
+			fmt.Sprintf("POST %s returned no job ID.", client.PathJobs))
+		return
+	}
+	data.ID = types.StringValue(result.ID)
+	syncSureBackupJobFromAPI(&data, &result)
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(sureBackupJobResource.saveState(ctx, r.client, result.ID, result.IsDisabled, wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, sureBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *SureBackupJob) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SureBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result models.SureBackupJobModel
+	if !sureBackupJobResource.read(ctx, r.client, resp, data.ID.ValueString(), &result, &result.JobModel) {
+		return
+	}
+
+	syncSureBackupJobFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(sureBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *SureBackupJob) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SureBackupJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateSureBackupJob(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid SureBackup job configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	wantDisabled := state.IsDisabled.ValueBool()
+	if !data.IsDisabled.IsNull() && !data.IsDisabled.IsUnknown() {
+		wantDisabled = data.IsDisabled.ValueBool()
+	}
+
+	endpoint := fmt.Sprintf(client.PathJobByID, data.ID.ValueString())
+	var result models.SureBackupJobModel
+	payload := buildSureBackupJobModel(&data, state.IsDisabled.ValueBool())
+	if err := putMergedPayload(ctx, r.client, endpoint, payload, &result, sureBackupJobManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update SureBackup job",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncSureBackupJobFromAPI(&data, &result)
+	}
+	normalizeUnknownScheduleFields(data.Schedule)
+
+	resp.Diagnostics.Append(sureBackupJobResource.saveState(ctx, r.client, data.ID.ValueString(), state.IsDisabled.ValueBool(), wantDisabled,
+		&data.IsDisabled, func() diag.Diagnostics {
+			diags := resp.State.Set(ctx, data)
+			return append(diags, sureBackupJobIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+		})...)
+}
+
+func (r *SureBackupJob) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SureBackupJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sureBackupJobResource.delete(ctx, r.client, resp, data.ID.ValueString())
+}
+
+func (r *SureBackupJob) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sureBackupJobIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewSureBackupJob returns a new veeam_surebackup_job resource instance.
+func NewSureBackupJob() resource.Resource {
+	return &SureBackupJob{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// sureBackupJobManagedPaths are cleared on the server when the plan leaves
+// them out, so removing a schedule block takes effect on update.
+var sureBackupJobManagedPaths = []string{
+	"schedule.daily",
+	"schedule.monthly",
+	"schedule.periodically",
+	"schedule.afterThisJob",
+	"schedule.retry",
+	"schedule.backupWindow",
+}
+
+// validateSureBackupJob checks that the job verifies an application group,
+// linked jobs or both, that keep_application_group_running has a group to
+// keep, that linked jobs are listed once with valid roles and a positive VM
+// limit, and that the backup window is well formed.
+func validateSureBackupJob(data *SureBackupJobResourceModel) error {
+	hasGroup := data.ApplicationGroupID.IsUnknown() ||
+		(!data.ApplicationGroupID.IsNull() && data.ApplicationGroupID.ValueString() != "")
+	if !hasGroup && data.LinkedJobs == nil {
+		return errors.New("at least one of application_group_id and linked_jobs is required")
+	}
+	if !hasGroup && isConfigured(data.KeepApplicationGroupRunning) && data.KeepApplicationGroupRunning.ValueBool() {
+		return errors.New("keep_application_group_running requires application_group_id")
+	}
+
+	if lj := data.LinkedJobs; lj != nil {
+		if isConfigured(lj.JobIDs) && len(lj.JobIDs.Elements()) == 0 {
+			return errors.New("linked_jobs.job_ids must list at least one job")
+		}
+		seen := map[string]bool{}
+		for i, e := range lj.JobIDs.Elements() {
+			v, ok := e.(types.String)
+			if !ok || !isConfigured(v) {
+				continue
+			}
+			id := v.ValueString()
+			if seen[id] {
+				return fmt.Errorf("linked_jobs.job_ids[%d]: job %s is listed more than once", i, id)
+			}
+			seen[id] = true
+		}
+		if err := validateApplicationGroupRoles("linked_jobs.roles", lj.Roles); err != nil {
+			return err
+		}
+		if isConfigured(lj.MaxConcurrentVMs) && lj.MaxConcurrentVMs.ValueInt64() < 1 {
+			return errors.New("linked_jobs.max_concurrent_vms must be at least 1")
+		}
+	}
+
+	if err := validateBackupWindow(data.Schedule); err != nil {
+		return fmt.Errorf("schedule.%w", err)
+	}
+	return nil
+}
+
+// buildSureBackupJobModel converts the plan into the full job model used for
+// PUT; Create derives the POST spec from it. Linked jobs and verification
+// options are always sent, so that removing either block takes effect.
+func buildSureBackupJobModel(data *SureBackupJobResourceModel, isDisabled bool) *models.SureBackupJobModel {
+	m := &models.SureBackupJobModel{
+		JobModel: models.JobModel{
+			ID:         data.ID.ValueString(),
+			Name:       data.Name.ValueString(),
+			Type:       models.JobTypeSureBackup,
+			IsDisabled: isDisabled,
+		},
+		Description:  data.Description.ValueString(),
+		VirtualLabID: data.VirtualLabID.ValueString(),
+		LinkedJobs: &models.SureBackupJobLinkedJobsModel{
+			JobIDs:           []string{},
+			Roles:            []models.EApplicationGroupVMRole{},
+			MaxConcurrentVMs: 3,
+		},
+		Schedule: buildScheduleModel(data.Schedule),
+	}
+
+	if isConfigured(data.ApplicationGroupID) && data.ApplicationGroupID.ValueString() != "" {
+		m.ApplicationGroup = &models.SureBackupJobApplicationGroupModel{
+			ApplicationGroupID: data.ApplicationGroupID.ValueString(),
+			KeepRunning:        data.KeepApplicationGroupRunning.ValueBool(),
+		}
+	}
+
+	if lj := data.LinkedJobs; lj != nil {
+		m.LinkedJobs = &models.SureBackupJobLinkedJobsModel{
+			IsEnabled:        true,
+			JobIDs:           listStrings(lj.JobIDs),
+			Roles:            buildApplicationGroupRoles(lj.Roles),
+			MaxConcurrentVMs: int(lj.MaxConcurrentVMs.ValueInt64()),
+		}
+	}
+
+	verification := defaultSureBackupVerification
+	if v := data.Verification; v != nil {
+		verification = models.SureBackupJobVerificationModel{
+			HeartbeatTest:        v.HeartbeatTest.ValueBool(),
+			PingTest:             v.PingTest.ValueBool(),
+			ScriptTest:           v.ScriptTests.ValueBool(),
+			BackupFileValidation: v.ValidateBackupFiles.ValueBool(),
+			MalwareScan:          v.MalwareScan.ValueBool(),
+		}
+	}
+	m.VerificationOptions = &verification
+	return m
+}
+
+// syncSureBackupJobFromAPI refreshes the state from a job response. The
+// verification block is only set when it is configured or the server no
+// longer uses the defaults, so that omitting it does not cause a diff.
+func syncSureBackupJobFromAPI(data *SureBackupJobResourceModel, api *models.SureBackupJobModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+	data.IsDisabled = types.BoolValue(api.IsDisabled)
+	data.VirtualLabID = types.StringValue(api.VirtualLabID)
+
+	data.ApplicationGroupID = types.StringNull()
+	data.KeepApplicationGroupRunning = types.BoolValue(false)
+	if g := api.ApplicationGroup; g != nil && g.ApplicationGroupID != "" {
+		data.ApplicationGroupID = types.StringValue(g.ApplicationGroupID)
+		data.KeepApplicationGroupRunning = types.BoolValue(g.KeepRunning)
+	}
+
+	data.LinkedJobs = nil
+	if lj := api.LinkedJobs; lj != nil && lj.IsEnabled {
+		data.LinkedJobs = &SureBackupLinkedJobs{
+			JobIDs:           stringListOrNull(lj.JobIDs),
+			Roles:            syncApplicationGroupRoles(lj.Roles),
+			MaxConcurrentVMs: types.Int64Value(int64(lj.MaxConcurrentVMs)),
+		}
+	}
+
+	if v := api.VerificationOptions; v != nil && (data.Verification != nil || *v != defaultSureBackupVerification) {
+		data.Verification = &SureBackupVerification{
+			HeartbeatTest:       types.BoolValue(v.HeartbeatTest),
+			PingTest:            types.BoolValue(v.PingTest),
+			ScriptTests:         types.BoolValue(v.ScriptTest),
+			ValidateBackupFiles: types.BoolValue(v.BackupFileValidation),
+			MalwareScan:         types.BoolValue(v.MalwareScan),
+		}
+	}
+
+	if api.Schedule != nil {
+		data.Schedule = syncScheduleFromAPI(data.Schedule, api.Schedule)
+	}
+}
@@ -0,0 +1,289 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+// linkedJobs verifies the VMs of jobIDs three at a time with the given roles.
+func linkedJobs(jobIDs types.List, roles ...string) *SureBackupLinkedJobs {
+	lj := &SureBackupLinkedJobs{
+		JobIDs:           jobIDs,
+		Roles:            types.ListNull(types.StringType),
+		MaxConcurrentVMs: types.Int64Value(3),
+	}
+	if len(roles) > 0 {
+		lj.Roles = stringList(roles...)
+	}
+	return lj
+}
+
+// ---------------------------------------------------------------------------
+// SureBackupJob — buildSureBackupJobModel
+// ---------------------------------------------------------------------------
+
+func TestSureBackupJob_BuildModel(t *testing.T) {
+	noLinkedJobs := &models.SureBackupJobLinkedJobsModel{
+		JobIDs: []string{}, Roles: []models.EApplicationGroupVMRole{}, MaxConcurrentVMs: 3,
+	}
+	tests := []struct {
+		name             string
+		data             SureBackupJobResourceModel
+		wantGroup        *models.SureBackupJobApplicationGroupModel
+		wantLinkedJobs   *models.SureBackupJobLinkedJobsModel
+		wantVerification models.SureBackupJobVerificationModel
+	}{
+		{
+			name: "web servers tested against a running group",
+			data: SureBackupJobResourceModel{
+				ApplicationGroupID:          types.StringValue("grp-1"),
+				KeepApplicationGroupRunning: types.BoolValue(true),
+				LinkedJobs:                  linkedJobs(stringList("job-a", "job-b"), "WebServer"),
+			},
+			wantGroup: &models.SureBackupJobApplicationGroupModel{ApplicationGroupID: "grp-1", KeepRunning: true},
+			wantLinkedJobs: &models.SureBackupJobLinkedJobsModel{
+				IsEnabled:        true,
+				JobIDs:           []string{"job-a", "job-b"},
+				Roles:            []models.EApplicationGroupVMRole{models.ApplicationGroupRoleWebServer},
+				MaxConcurrentVMs: 3,
+			},
+			wantVerification: defaultSureBackupVerification,
+		},
+		{
+			name: "application group only, with a malware scan",
+			data: SureBackupJobResourceModel{
+				ApplicationGroupID: types.StringValue("grp-1"),
+				Verification: &SureBackupVerification{
+					HeartbeatTest:       types.BoolValue(true),
+					PingTest:            types.BoolValue(true),
+					ScriptTests:         types.BoolValue(false),
+					ValidateBackupFiles: types.BoolValue(true),
+					MalwareScan:         types.BoolValue(true),
+				},
+			},
+			wantGroup:      &models.SureBackupJobApplicationGroupModel{ApplicationGroupID: "grp-1"},
+			wantLinkedJobs: noLinkedJobs,
+			wantVerification: models.SureBackupJobVerificationModel{
+				HeartbeatTest: true, PingTest: true, BackupFileValidation: true, MalwareScan: true,
+			},
+		},
+		{
+			name: "linked jobs only",
+			data: SureBackupJobResourceModel{
+				ApplicationGroupID: types.StringNull(),
+				LinkedJobs:         linkedJobs(stringList("job-a")),
+			},
+			wantLinkedJobs: &models.SureBackupJobLinkedJobsModel{
+				IsEnabled: true, JobIDs: []string{"job-a"}, Roles: []models.EApplicationGroupVMRole{}, MaxConcurrentVMs: 3,
+			},
+			wantVerification: defaultSureBackupVerification,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.data.VirtualLabID = types.StringValue("lab-1")
+			m := buildSureBackupJobModel(&tt.data, false)
+			assert.Equal(t, models.JobTypeSureBackup, m.Type)
+			assert.Equal(t, "lab-1", m.VirtualLabID)
+			assert.Equal(t, tt.wantGroup, m.ApplicationGroup)
+			assert.Equal(t, tt.wantLinkedJobs, m.LinkedJobs)
+			assert.Equal(t, &tt.wantVerification, m.VerificationOptions)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// SureBackupJob — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestSureBackupJob_ValidateConfig(t *testing.T) {
+	unknownIDs := types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown(), types.StringUnknown()})
+	noConcurrency := linkedJobs(stringList("job-a"))
+	noConcurrency.MaxConcurrentVMs = types.Int64Value(0)
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "group and linked jobs",
+			attrs: map[string]interface{}{"application_group_id": "grp-1", "linked_jobs": linkedJobs(stringList("job-a", "job-b"), "WebServer")},
+		},
+		{
+			name:  "group only",
+			attrs: map[string]interface{}{"application_group_id": "grp-1", "keep_application_group_running": true},
+		},
+		{
+			name:  "linked jobs only",
+			attrs: map[string]interface{}{"linked_jobs": linkedJobs(stringList("job-a"))},
+		},
+		{
+			name:    "nothing to verify",
+			attrs:   map[string]interface{}{},
+			wantErr: "at least one of application_group_id and linked_jobs is required",
+		},
+		{
+			name:    "keep running without a group",
+			attrs:   map[string]interface{}{"keep_application_group_running": true, "linked_jobs": linkedJobs(stringList("job-a"))},
+			wantErr: "keep_application_group_running requires application_group_id",
+		},
+		{
+			name:    "no linked jobs",
+			attrs:   map[string]interface{}{"linked_jobs": linkedJobs(stringList())},
+			wantErr: "linked_jobs.job_ids must list at least one job",
+		},
+		{
+			name:    "linked job listed twice",
+			attrs:   map[string]interface{}{"linked_jobs": linkedJobs(stringList("job-a", "job-a"))},
+			wantErr: "linked_jobs.job_ids[1]: job job-a is listed more than once",
+		},
+		{
+			name:    "file server role",
+			attrs:   map[string]interface{}{"linked_jobs": linkedJobs(stringList("job-a"), "FileServer")},
+			wantErr: `linked_jobs.roles[0]: "FileServer" is not supported`,
+		},
+		{
+			name:    "no VMs at a time",
+			attrs:   map[string]interface{}{"linked_jobs": noConcurrency},
+			wantErr: "linked_jobs.max_concurrent_vms must be at least 1",
+		},
+		{
+			name:    "backup window hour outside the day",
+			attrs:   map[string]interface{}{"application_group_id": "grp-1", "schedule": &JobScheduleSettings{BackupWindow: copyWindow(24)}},
+			wantErr: `schedule.day "Monday": hour 24 is outside 0–23`,
+		},
+		{
+			name:  "group from the application group resource",
+			attrs: map[string]interface{}{"application_group_id": types.StringUnknown(), "keep_application_group_running": true},
+		},
+		{
+			name:  "linked jobs from the backup job resources",
+			attrs: map[string]interface{}{"linked_jobs": linkedJobs(unknownIDs)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.attrs["name"] = "Weekly-Recovery-Test"
+			tt.attrs["virtual_lab_id"] = "lab-1"
+			diags := validateResourceConfig(t, &SureBackupJob{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// SureBackupJob — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestSureBackupJob_ManagedPaths checks that a removed application group and
+// linked job roles are cleared instead of merged back, that a removed daily
+// schedule is dropped and that notification settings survive the update.
+func TestSureBackupJob_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"applicationGroup": map[string]interface{}{"applicationGroupId": "grp-1", "keepRunningAfterJobCompletion": false},
+		"linkedJobs": map[string]interface{}{
+			"isEnabled":             true,
+			"jobIds":                []interface{}{"job-a", "job-b"},
+			"roles":                 []interface{}{"WebServer"},
+			"maxConcurrentVmsCount": float64(3),
+		},
+		"schedule": map[string]interface{}{
+			"runAutomatically": true,
+			"daily":            map[string]interface{}{"isEnabled": true, "localTime": "22:00"},
+		},
+		"notification": map[string]interface{}{"sendSnmp": true},
+	}
+	merged, err := mergeManagedPayload(current, buildSureBackupJobModel(&SureBackupJobResourceModel{
+		VirtualLabID:       types.StringValue("lab-1"),
+		ApplicationGroupID: types.StringNull(),
+		LinkedJobs:         linkedJobs(stringList("job-c")),
+		Schedule:           &JobScheduleSettings{},
+	}, false), sureBackupJobManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+	}{
+		{"applicationGroup", nil},
+		{"linkedJobs.jobIds", []interface{}{"job-c"}},
+		{"linkedJobs.roles", []interface{}{}},
+		{"schedule.daily", nil},
+		{"notification", map[string]interface{}{"sendSnmp": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// SureBackupJob — syncSureBackupJobFromAPI
+// ---------------------------------------------------------------------------
+
+// TestSureBackupJob_SyncFromAPI checks that the default verification options
+// do not add a verification block to a job configured without one, while
+// options changed in the console do, and that disabled linked jobs read back
+// as no linked_jobs block.
+func TestSureBackupJob_SyncFromAPI(t *testing.T) {
+	malwareScan := defaultSureBackupVerification
+	malwareScan.MalwareScan = true
+
+	tests := []struct {
+		name             string
+		verification     models.SureBackupJobVerificationModel
+		linkedJobs       *models.SureBackupJobLinkedJobsModel
+		wantVerification *SureBackupVerification
+		wantLinkedJobs   *SureBackupLinkedJobs
+	}{
+		{
+			name:         "defaults and linked web servers",
+			verification: defaultSureBackupVerification,
+			linkedJobs: &models.SureBackupJobLinkedJobsModel{
+				IsEnabled: true, JobIDs: []string{"job-a"}, Roles: []models.EApplicationGroupVMRole{models.ApplicationGroupRoleWebServer}, MaxConcurrentVMs: 3,
+			},
+			wantLinkedJobs: linkedJobs(stringList("job-a"), "WebServer"),
+		},
+		{
+			name:         "malware scan enabled and linked jobs disabled in the console",
+			verification: malwareScan,
+			linkedJobs:   &models.SureBackupJobLinkedJobsModel{JobIDs: []string{"job-a"}, MaxConcurrentVMs: 3},
+			wantVerification: &SureBackupVerification{
+				HeartbeatTest:       types.BoolValue(true),
+				PingTest:            types.BoolValue(true),
+				ScriptTests:         types.BoolValue(true),
+				ValidateBackupFiles: types.BoolValue(false),
+				MalwareScan:         types.BoolValue(true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := SureBackupJobResourceModel{ApplicationGroupID: types.StringValue("grp-1")}
+			syncSureBackupJobFromAPI(&data, &models.SureBackupJobModel{
+				JobModel:            models.JobModel{ID: "sb-1", Name: "Weekly-Recovery-Test", Type: models.JobTypeSureBackup},
+				VirtualLabID:        "lab-1",
+				LinkedJobs:          tt.linkedJobs,
+				VerificationOptions: &tt.verification,
+			})
+			assert.True(t, data.ApplicationGroupID.IsNull(), "a group removed in the console reads back as null")
+			assert.Equal(t, tt.wantLinkedJobs, data.LinkedJobs)
+			assert.Equal(t, tt.wantVerification, data.Verification)
+		})
+	}
+}
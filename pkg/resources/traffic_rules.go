@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &TrafficRules{}
 	_ resource.ResourceWithConfigure   = &TrafficRules{}
 	_ resource.ResourceWithImportState = &TrafficRules{}
+	_ resource.ResourceWithIdentity    = &TrafficRules{}
 )
 
 // TrafficRules manages the Veeam network traffic throttling rules singleton.
@@ -43,6 +43,10 @@ func (r *TrafficRules) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_traffic_rules"
 }
 
+func (r *TrafficRules) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idOnlyIdentity.schema()
+}
+
 func (r *TrafficRules) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Veeam Backup & Replication network traffic throttling rules. " +
@@ -101,6 +105,7 @@ func (r *TrafficRules) Create(ctx context.Context, req resource.CreateRequest, r
 
 	data.ID = types.StringValue("traffic-rules")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *TrafficRules) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -123,6 +128,7 @@ func (r *TrafficRules) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data.ID = types.StringValue("traffic-rules")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *TrafficRules) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -139,6 +145,7 @@ func (r *TrafficRules) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	data.ID = types.StringValue("traffic-rules")
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(idOnlyIdentity.set(ctx, resp.Identity, data.ID, types.StringNull())...)
 }
 
 func (r *TrafficRules) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
@@ -146,7 +153,7 @@ func (r *TrafficRules) Delete(_ context.Context, _ resource.DeleteRequest, _ *re
 }
 
 func (r *TrafficRules) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idOnlyIdentity.importState(ctx, r.client, req, resp)
 }
 
 // putTrafficRules GETs the current server config, merges plan fields, and PUTs the result.
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +19,7 @@ var (
 	_ resource.Resource                = &UnstructuredDataServer{}
 	_ resource.ResourceWithConfigure   = &UnstructuredDataServer{}
 	_ resource.ResourceWithImportState = &UnstructuredDataServer{}
+	_ resource.ResourceWithIdentity    = &UnstructuredDataServer{}
 )
 
 // UnstructuredDataServer implements the veeam_unstructured_data_server resource.
@@ -45,6 +45,18 @@ func NewUnstructuredDataServer() resource.Resource {
 
 func (r *UnstructuredDataServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_unstructured_data_server"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// unstructuredDataServerIdentity keys file servers and shares by UUID and
+// host name / share path.
+var unstructuredDataServerIdentity = resourceIdentity{
+	key:            "host",
+	keyDescription: "File server host name or share path.",
+}
+
+func (r *UnstructuredDataServer) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = unstructuredDataServerIdentity.schema()
 }
 
 func (r *UnstructuredDataServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -74,7 +86,7 @@ func (r *UnstructuredDataServer) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"type": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Server type. Allowed values: `CifsShare`, `NfsShare`, `FileServer`. Changing this forces a destroy and recreate.",
+				MarkdownDescription: "Server type. Allowed values: `CifsShare`, `NfsShare`, `FileServer`, or an object storage source type (`AmazonS3`, `AzureBlob`, `S3Compatible`). Changing this forces a destroy and recreate.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -143,6 +155,7 @@ func (r *UnstructuredDataServer) Create(ctx context.Context, req resource.Create
 	data.ID = types.StringValue(result.ID)
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(unstructuredDataServerIdentity.set(ctx, resp.Identity, data.ID, data.HostName)...)
 }
 
 func (r *UnstructuredDataServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -164,6 +177,7 @@ func (r *UnstructuredDataServer) Read(ctx context.Context, req resource.ReadRequ
 
 	r.syncModelFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(unstructuredDataServerIdentity.set(ctx, resp.Identity, data.ID, data.HostName)...)
 }
 
 func (r *UnstructuredDataServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -185,6 +199,7 @@ func (r *UnstructuredDataServer) Update(ctx context.Context, req resource.Update
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(unstructuredDataServerIdentity.set(ctx, resp.Identity, data.ID, data.HostName)...)
 }
 
 func (r *UnstructuredDataServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -205,7 +220,7 @@ func (r *UnstructuredDataServer) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *UnstructuredDataServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	unstructuredDataServerIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------
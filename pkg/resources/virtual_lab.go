@@ -0,0 +1,587 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/client"
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+var (
+	_ resource.Resource                   = &VirtualLab{}
+	_ resource.ResourceWithConfigure      = &VirtualLab{}
+	_ resource.ResourceWithImportState    = &VirtualLab{}
+	_ resource.ResourceWithIdentity       = &VirtualLab{}
+	_ resource.ResourceWithValidateConfig = &VirtualLab{}
+)
+
+// VirtualLab implements the veeam_virtual_lab resource.
+type VirtualLab struct {
+	client client.APIClient
+}
+
+// VirtualLabResourceModel is the Terraform state model for veeam_virtual_lab.
+type VirtualLabResourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	Name            types.String               `tfsdk:"name"`
+	Description     types.String               `tfsdk:"description"`
+	Host            *VirtualLabHost            `tfsdk:"host"`
+	Datastore       *LabInventoryObject        `tfsdk:"datastore"`
+	ProxyAppliance  *VirtualLabProxyAppliance  `tfsdk:"proxy_appliance"`
+	NetworkMappings []VirtualLabNetworkMapping `tfsdk:"network_mappings"`
+}
+
+// VirtualLabHost is the ESXi host or cluster that runs the lab VMs.
+type VirtualLabHost struct {
+	Type     types.String `tfsdk:"type"`
+	HostName types.String `tfsdk:"host_name"`
+	Name     types.String `tfsdk:"name"`
+	ObjectID types.String `tfsdk:"object_id"`
+}
+
+// LabInventoryObject is a vSphere inventory object used by SureBackup: the
+// lab datastore or an application group VM.
+type LabInventoryObject struct {
+	HostName types.String `tfsdk:"host_name"`
+	Name     types.String `tfsdk:"name"`
+	ObjectID types.String `tfsdk:"object_id"`
+}
+
+// VirtualLabProxyAppliance maps to VirtualLabProxyApplianceModel. A null
+// ip_address means the appliance obtains its address through DHCP.
+type VirtualLabProxyAppliance struct {
+	ProductionNetwork types.String `tfsdk:"production_network"`
+	IPAddress         types.String `tfsdk:"ip_address"`
+	SubnetMask        types.String `tfsdk:"subnet_mask"`
+	DefaultGateway    types.String `tfsdk:"default_gateway"`
+}
+
+// VirtualLabNetworkMapping maps to VirtualLabNetworkMappingModel.
+type VirtualLabNetworkMapping struct {
+	ProductionNetwork   types.String `tfsdk:"production_network"`
+	IsolatedNetwork     types.String `tfsdk:"isolated_network"`
+	VLANID              types.Int64  `tfsdk:"vlan_id"`
+	ApplianceIPAddress  types.String `tfsdk:"appliance_ip_address"`
+	SubnetMask          types.String `tfsdk:"subnet_mask"`
+	MasqueradeIPAddress types.String `tfsdk:"masquerade_ip_address"`
+	DHCPEnabled         types.Bool   `tfsdk:"dhcp_enabled"`
+}
+
+func (r *VirtualLab) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_lab"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// virtualLabImport resolves "name:<lab>" import IDs.
+var virtualLabImport = naturalKeyImport{
+	kind:         "virtual lab",
+	listEndpoint: client.PathVirtualLabs,
+	keys:         map[string]string{"name": "name"},
+}
+
+// virtualLabIdentity pairs the lab UUID with the lab name.
+var virtualLabIdentity = resourceIdentity{
+	key:            "name",
+	keyDescription: "Virtual lab name.",
+	lookup:         &virtualLabImport,
+}
+
+func (r *VirtualLab) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = virtualLabIdentity.schema()
+}
+
+func (r *VirtualLab) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	hostAttrs := labInventoryObjectAttributes("ESXi host or cluster")
+	hostAttrs["type"] = schema.StringAttribute{
+		MarkdownDescription: "Object type: `Host` or `Cluster`. Defaults to `Host`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(string(models.VmwareTypeHost)),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Veeam SureBackup virtual lab on VMware vSphere: the host and datastore " +
+			"that run VMs started from backups, the proxy appliance and the mapping of production " +
+			"networks to isolated networks.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the virtual lab (UUID assigned by Veeam).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the virtual lab.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"host": schema.SingleNestedAttribute{
+				MarkdownDescription: "ESXi host or cluster that runs the lab VMs.",
+				Required:            true,
+				Attributes:          hostAttrs,
+			},
+			"datastore": schema.SingleNestedAttribute{
+				MarkdownDescription: "Datastore that keeps the changes made by VMs running in the lab.",
+				Required:            true,
+				Attributes:          labInventoryObjectAttributes("datastore"),
+			},
+			"proxy_appliance": schema.SingleNestedAttribute{
+				MarkdownDescription: "Appliance VM that connects the lab to the production network and routes " +
+					"traffic to the isolated networks.",
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"production_network": schema.StringAttribute{
+						MarkdownDescription: "Production port group the appliance is connected to.",
+						Required:            true,
+					},
+					"ip_address": schema.StringAttribute{
+						MarkdownDescription: "Static IPv4 address of the appliance in the production network. " +
+							"When omitted, the appliance obtains an address through DHCP.",
+						Optional: true,
+					},
+					"subnet_mask": schema.StringAttribute{
+						MarkdownDescription: "Subnet mask of `ip_address`. Required with `ip_address`.",
+						Optional:            true,
+					},
+					"default_gateway": schema.StringAttribute{
+						MarkdownDescription: "Default gateway of the appliance. Required with `ip_address`.",
+						Optional:            true,
+					},
+				},
+			},
+			"network_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "Isolated copy of each production network used by VMs started in the lab.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"production_network": schema.StringAttribute{
+							MarkdownDescription: "Production port group. Must be unique within the lab.",
+							Required:            true,
+						},
+						"isolated_network": schema.StringAttribute{
+							MarkdownDescription: "Name of the isolated network created for the production network. " +
+								"Must be unique within the lab.",
+							Required: true,
+						},
+						"vlan_id": schema.Int64Attribute{
+							MarkdownDescription: "VLAN ID of the isolated network, `0`–`4094`. `0` (default) " +
+								"leaves the network untagged.",
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+						},
+						"appliance_ip_address": schema.StringAttribute{
+							MarkdownDescription: "Address of the appliance in the isolated network, normally the " +
+								"gateway address of the production network.",
+							Required: true,
+						},
+						"subnet_mask": schema.StringAttribute{
+							MarkdownDescription: "Subnet mask of the isolated network.",
+							Required:            true,
+						},
+						"masquerade_ip_address": schema.StringAttribute{
+							MarkdownDescription: "Production-side network used to reach lab VMs (for example " +
+								"`172.18.0.0`). Assigned by Veeam when omitted.",
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"dhcp_enabled": schema.BoolAttribute{
+							MarkdownDescription: "If `true` (default), the appliance runs a DHCP service in the " +
+								"isolated network.",
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// labInventoryObjectAttributes returns the attributes of a LabInventoryObject.
+func labInventoryObjectAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"host_name": schema.StringAttribute{
+			MarkdownDescription: "vCenter Server or standalone ESXi host that owns the " + what + ".",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the " + what + ".",
+			Required:            true,
+		},
+		"object_id": schema.StringAttribute{
+			MarkdownDescription: "vSphere MoRef ID of the " + what + ".",
+			Required:            true,
+		},
+	}
+}
+
+// ValidateConfig checks the host type, proxy appliance addressing and
+// network mappings while planning.
+func (r *VirtualLab) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VirtualLabResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		// Network mappings from an unknown collection are checked once known.
+		return
+	}
+	if err := validateVirtualLab(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid virtual lab configuration", err.Error())
+	}
+}
+
+func (r *VirtualLab) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(client.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", "Expected client.APIClient from provider, got unexpected type.")
+		return
+	}
+	r.client = c
+}
+
+// ---------------------------------------------------------------------------
+// CRUD
+// ---------------------------------------------------------------------------
+
+func (r *VirtualLab) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualLabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateVirtualLab(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid virtual lab configuration", err.Error())
+		return
+	}
+
+	var result models.VirtualLabModel
+	if err := r.client.PostJSON(ctx, client.PathVirtualLabs, buildVirtualLabSpec(&data), &result); err != nil {
+		resp.Diagnostics.AddError("Failed to create virtual lab",
+			fmt.Sprintf("POST %s: %s", client.PathVirtualLabs, err))
+		return
+	}
+	if result.ID == "" {
+		resp.Diagnostics.AddError("Failed to create virtual lab",
+			fmt.Sprintf("POST %s returned no virtual lab ID.", client.PathVirtualLabs))
+		return
+	}
+
+	syncVirtualLabFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(virtualLabIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *VirtualLab) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtualLabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathVirtualLabByID, data.ID.ValueString())
+	var result models.VirtualLabModel
+	if err := r.client.GetJSON(ctx, endpoint, &result); err != nil {
+		if isSureBackupObjectNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read virtual lab",
+			fmt.Sprintf("GET %s: %s", endpoint, err))
+		return
+	}
+
+	syncVirtualLabFromAPI(&data, &result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(virtualLabIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *VirtualLab) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state VirtualLabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateVirtualLab(&data); err != nil {
+		resp.Diagnostics.AddError("Invalid virtual lab configuration", err.Error())
+		return
+	}
+	data.ID = state.ID
+
+	endpoint := fmt.Sprintf(client.PathVirtualLabByID, data.ID.ValueString())
+	var result models.VirtualLabModel
+	if err := putMergedPayload(ctx, r.client, endpoint, buildVirtualLabSpec(&data), &result, virtualLabManagedPaths...); err != nil {
+		resp.Diagnostics.AddError("Failed to update virtual lab",
+			fmt.Sprintf("PUT %s: %s", endpoint, err))
+		return
+	}
+	if result.ID != "" {
+		syncVirtualLabFromAPI(&data, &result)
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringValue("")
+	}
+	for i := range data.NetworkMappings {
+		if data.NetworkMappings[i].MasqueradeIPAddress.IsUnknown() {
+			data.NetworkMappings[i].MasqueradeIPAddress = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(virtualLabIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
+}
+
+func (r *VirtualLab) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtualLabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf(client.PathVirtualLabByID, data.ID.ValueString())
+	if err := r.client.DeleteJSON(ctx, endpoint); err != nil {
+		resp.Diagnostics.AddError("Failed to delete virtual lab",
+			fmt.Sprintf("DELETE %s (virtual lab %s): %s", endpoint, data.ID.ValueString(), err))
+	}
+}
+
+func (r *VirtualLab) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	virtualLabIdentity.importState(ctx, r.client, req, resp)
+}
+
+// NewVirtualLab returns a new veeam_virtual_lab resource instance.
+func NewVirtualLab() resource.Resource {
+	return &VirtualLab{}
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// virtualLabManagedPaths are cleared on the server when the plan leaves them
+// out, so a proxy appliance switched to DHCP loses its static address and a
+// host or datastore without object_id is looked up by name again.
+var virtualLabManagedPaths = []string{
+	"host.objectId",
+	"datastore.objectId",
+	"proxyAppliance.ipAddress",
+	"proxyAppliance.subnetMask",
+	"proxyAppliance.defaultGateway",
+}
+
+// validateVirtualLab checks the host type, that a static proxy appliance
+// address comes with a subnet mask and gateway, and that each production and
+// isolated network is mapped once with a valid VLAN ID and IPv4 addresses.
+func validateVirtualLab(data *VirtualLabResourceModel) error {
+	if h := data.Host; h != nil && isConfigured(h.Type) {
+		switch models.EVmwareInventoryType(h.Type.ValueString()) {
+		case models.VmwareTypeHost, models.VmwareTypeCluster:
+		default:
+			return fmt.Errorf("host.type %q is not supported; expected Host or Cluster", h.Type.ValueString())
+		}
+	}
+
+	if p := data.ProxyAppliance; p != nil && isConfigured(p.IPAddress) {
+		if err := checkIPv4("proxy_appliance.ip_address", p.IPAddress); err != nil {
+			return err
+		}
+		if p.SubnetMask.IsNull() || p.DefaultGateway.IsNull() {
+			return errors.New("proxy_appliance: subnet_mask and default_gateway are required with ip_address")
+		}
+		if err := checkIPv4("proxy_appliance.subnet_mask", p.SubnetMask); err != nil {
+			return err
+		}
+		if err := checkIPv4("proxy_appliance.default_gateway", p.DefaultGateway); err != nil {
+			return err
+		}
+	} else if p != nil && p.IPAddress.IsNull() && (!p.SubnetMask.IsNull() || !p.DefaultGateway.IsNull()) {
+		return errors.New("proxy_appliance: subnet_mask and default_gateway require ip_address; " +
+			"omit all three to use DHCP")
+	}
+
+	if len(data.NetworkMappings) == 0 {
+		return errors.New("network_mappings must map at least one production network")
+	}
+	production := map[string]bool{}
+	isolated := map[string]bool{}
+	for i, m := range data.NetworkMappings {
+		path := fmt.Sprintf("network_mappings[%d]", i)
+		if isConfigured(m.ProductionNetwork) {
+			key := strings.ToLower(m.ProductionNetwork.ValueString())
+			if production[key] {
+				return fmt.Errorf("%s: production network %q is mapped more than once", path, m.ProductionNetwork.ValueString())
+			}
+			production[key] = true
+		}
+		if isConfigured(m.IsolatedNetwork) {
+			key := strings.ToLower(m.IsolatedNetwork.ValueString())
+			if isolated[key] {
+				return fmt.Errorf("%s: isolated network %q is used more than once", path, m.IsolatedNetwork.ValueString())
+			}
+			isolated[key] = true
+		}
+		if isConfigured(m.VLANID) && (m.VLANID.ValueInt64() < 0 || m.VLANID.ValueInt64() > 4094) {
+			return fmt.Errorf("%s: vlan_id must be between 0 and 4094", path)
+		}
+		if err := checkIPv4(path+".appliance_ip_address", m.ApplianceIPAddress); err != nil {
+			return err
+		}
+		if err := checkIPv4(path+".subnet_mask", m.SubnetMask); err != nil {
+			return err
+		}
+		if err := checkIPv4(path+".masquerade_ip_address", m.MasqueradeIPAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIPv4 rejects a configured value that is not a dotted IPv4 address.
+func checkIPv4(path string, v types.String) error {
+	if !isConfigured(v) {
+		return nil
+	}
+	if ip := net.ParseIP(v.ValueString()); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("%s: %q is not a valid IPv4 address", path, v.ValueString())
+	}
+	return nil
+}
+
+func buildLabObject(o *LabInventoryObject, objType models.EVmwareInventoryType) models.VmwareObjectSpec {
+	if o == nil {
+		return models.VmwareObjectSpec{}
+	}
+	return models.VmwareObjectSpec{
+		Platform: string(models.InventoryPlatformVSphere),
+		HostName: o.HostName.ValueString(),
+		Name:     o.Name.ValueString(),
+		Type:     objType,
+		ObjectID: o.ObjectID.ValueString(),
+	}
+}
+
+func syncLabObjectFromAPI(o models.VmwareObjectSpec) *LabInventoryObject {
+	return &LabInventoryObject{
+		HostName: types.StringValue(o.HostName),
+		Name:     types.StringValue(o.Name),
+		ObjectID: types.StringValue(o.ObjectID),
+	}
+}
+
+// buildVirtualLabSpec converts the plan into the request body.
+func buildVirtualLabSpec(data *VirtualLabResourceModel) *models.VirtualLabSpec {
+	spec := &models.VirtualLabSpec{
+		Name:            data.Name.ValueString(),
+		Description:     data.Description.ValueString(),
+		Type:            models.VirtualLabTypeVSphere,
+		Datastore:       buildLabObject(data.Datastore, models.VmwareTypeDatastore),
+		NetworkMappings: make([]models.VirtualLabNetworkMappingModel, 0, len(data.NetworkMappings)),
+	}
+	if h := data.Host; h != nil {
+		hostType := models.EVmwareInventoryType(h.Type.ValueString())
+		if hostType == "" {
+			hostType = models.VmwareTypeHost
+		}
+		spec.Host = buildLabObject(&LabInventoryObject{HostName: h.HostName, Name: h.Name, ObjectID: h.ObjectID}, hostType)
+	}
+	if p := data.ProxyAppliance; p != nil {
+		spec.ProxyAppliance = &models.VirtualLabProxyApplianceModel{
+			ProductionNetwork:     p.ProductionNetwork.ValueString(),
+			ObtainIPAutomatically: !isConfigured(p.IPAddress),
+			IPAddress:             p.IPAddress.ValueString(),
+			SubnetMask:            p.SubnetMask.ValueString(),
+			DefaultGateway:        p.DefaultGateway.ValueString(),
+		}
+	}
+	for _, m := range data.NetworkMappings {
+		spec.NetworkMappings = append(spec.NetworkMappings, models.VirtualLabNetworkMappingModel{
+			ProductionNetwork:   m.ProductionNetwork.ValueString(),
+			IsolatedNetwork:     m.IsolatedNetwork.ValueString(),
+			VLANID:              int(m.VLANID.ValueInt64()),
+			ApplianceIPAddress:  m.ApplianceIPAddress.ValueString(),
+			SubnetMask:          m.SubnetMask.ValueString(),
+			MasqueradeIPAddress: m.MasqueradeIPAddress.ValueString(),
+			DHCPEnabled:         m.DHCPEnabled.ValueBool(),
+		})
+	}
+	return spec
+}
+
+// syncVirtualLabFromAPI refreshes the state from a lab response.
+func syncVirtualLabFromAPI(data *VirtualLabResourceModel, api *models.VirtualLabModel) {
+	data.ID = types.StringValue(api.ID)
+	data.Name = types.StringValue(api.Name)
+	data.Description = types.StringValue(api.Description)
+
+	data.Host = &VirtualLabHost{
+		Type:     types.StringValue(string(api.Host.Type)),
+		HostName: types.StringValue(api.Host.HostName),
+		Name:     types.StringValue(api.Host.Name),
+		ObjectID: types.StringValue(api.Host.ObjectID),
+	}
+	data.Datastore = syncLabObjectFromAPI(api.Datastore)
+
+	if p := api.ProxyAppliance; p != nil {
+		appliance := &VirtualLabProxyAppliance{
+			ProductionNetwork: types.StringValue(p.ProductionNetwork),
+			IPAddress:         types.StringNull(),
+			SubnetMask:        types.StringNull(),
+			DefaultGateway:    types.StringNull(),
+		}
+		if !p.ObtainIPAutomatically {
+			appliance.IPAddress = stringOrNull(p.IPAddress)
+			appliance.SubnetMask = stringOrNull(p.SubnetMask)
+			appliance.DefaultGateway = stringOrNull(p.DefaultGateway)
+		}
+		data.ProxyAppliance = appliance
+	}
+
+	mappings := make([]VirtualLabNetworkMapping, 0, len(api.NetworkMappings))
+	for _, m := range api.NetworkMappings {
+		mappings = append(mappings, VirtualLabNetworkMapping{
+			ProductionNetwork:   types.StringValue(m.ProductionNetwork),
+			IsolatedNetwork:     types.StringValue(m.IsolatedNetwork),
+			VLANID:              types.Int64Value(int64(m.VLANID)),
+			ApplianceIPAddress:  types.StringValue(m.ApplianceIPAddress),
+			SubnetMask:          types.StringValue(m.SubnetMask),
+			MasqueradeIPAddress: stringOrNull(m.MasqueradeIPAddress),
+			DHCPEnabled:         types.BoolValue(m.DHCPEnabled),
+		})
+	}
+	data.NetworkMappings = mappings
+}
+
+// isSureBackupObjectNotFound reports whether a virtual lab or application
+// group GET failed because the object was deleted outside Terraform.
+func isSureBackupObjectNotFound(err error) bool {
+	var apiErr *models.APIError
+	if errors.As(err, &apiErr) && strings.EqualFold(apiErr.ErrorCode, "NotFound") {
+		return true
+	}
+	errText := strings.ToLower(err.Error())
+	return strings.Contains(errText, "http 404") || strings.Contains(errText, "notfound")
+}
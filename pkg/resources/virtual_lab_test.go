@@ -0,0 +1,355 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrikcze/terraform-provider-veeam/internal/models"
+)
+
+func labMapping(production, isolated string, vlan int64, applianceIP string) VirtualLabNetworkMapping {
+	return VirtualLabNetworkMapping{
+		ProductionNetwork:   types.StringValue(production),
+		IsolatedNetwork:     types.StringValue(isolated),
+		VLANID:              types.Int64Value(vlan),
+		ApplianceIPAddress:  types.StringValue(applianceIP),
+		SubnetMask:          types.StringValue("255.255.255.0"),
+		MasqueradeIPAddress: types.StringNull(),
+		DHCPEnabled:         types.BoolValue(true),
+	}
+}
+
+// labAppliance connects the proxy appliance to the VM Network; an empty ip
+// selects DHCP.
+func labAppliance(ip, mask, gateway string) *VirtualLabProxyAppliance {
+	p := &VirtualLabProxyAppliance{
+		ProductionNetwork: types.StringValue("VM Network"),
+		IPAddress:         types.StringNull(),
+		SubnetMask:        types.StringNull(),
+		DefaultGateway:    types.StringNull(),
+	}
+	if ip != "" {
+		p.IPAddress = types.StringValue(ip)
+	}
+	if mask != "" {
+		p.SubnetMask = types.StringValue(mask)
+	}
+	if gateway != "" {
+		p.DefaultGateway = types.StringValue(gateway)
+	}
+	return p
+}
+
+// ---------------------------------------------------------------------------
+// VirtualLab — buildVirtualLabSpec
+// ---------------------------------------------------------------------------
+
+func TestVirtualLab_BuildSpec(t *testing.T) {
+	tests := []struct {
+		name      string
+		host      *VirtualLabHost
+		appliance *VirtualLabProxyAppliance
+		wantHost  models.VmwareObjectSpec
+		wantProxy *models.VirtualLabProxyApplianceModel
+	}{
+		{
+			name: "cluster with a static appliance address",
+			host: &VirtualLabHost{
+				Type: types.StringValue("Cluster"), HostName: types.StringValue("vcsa01.corp.local"),
+				Name: types.StringValue("Lab-Cluster"), ObjectID: types.StringValue("domain-c42"),
+			},
+			appliance: labAppliance("10.0.0.250", "255.255.255.0", "10.0.0.1"),
+			wantHost: models.VmwareObjectSpec{
+				Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "Lab-Cluster",
+				Type: models.VmwareTypeCluster, ObjectID: "domain-c42",
+			},
+			wantProxy: &models.VirtualLabProxyApplianceModel{
+				ProductionNetwork: "VM Network", IPAddress: "10.0.0.250", SubnetMask: "255.255.255.0", DefaultGateway: "10.0.0.1",
+			},
+		},
+		{
+			name: "host looked up by name with a DHCP appliance",
+			host: &VirtualLabHost{
+				Type: types.StringNull(), HostName: types.StringValue("vcsa01.corp.local"),
+				Name: types.StringValue("esx07.corp.local"), ObjectID: types.StringNull(),
+			},
+			appliance: labAppliance("", "", ""),
+			wantHost: models.VmwareObjectSpec{
+				Platform: "VSphere", HostName: "vcsa01.corp.local", Name: "esx07.corp.local", Type: models.VmwareTypeHost,
+			},
+			wantProxy: &models.VirtualLabProxyApplianceModel{ProductionNetwork: "VM Network", ObtainIPAutomatically: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbNetwork := labMapping("DB Network", "Lab DB Network", 120, "10.0.1.1")
+			dbNetwork.MasqueradeIPAddress = types.StringUnknown()
+			spec := buildVirtualLabSpec(&VirtualLabResourceModel{
+				Name:            types.StringValue("Recovery-Test-Lab"),
+				Host:            tt.host,
+				Datastore:       &LabInventoryObject{HostName: types.StringValue("vcsa01.corp.local"), Name: types.StringValue("ds-lab01")},
+				ProxyAppliance:  tt.appliance,
+				NetworkMappings: []VirtualLabNetworkMapping{dbNetwork},
+			})
+			assert.Equal(t, models.VirtualLabTypeVSphere, spec.Type)
+			assert.Equal(t, tt.wantHost, spec.Host)
+			assert.Equal(t, models.VmwareTypeDatastore, spec.Datastore.Type)
+			assert.Equal(t, tt.wantProxy, spec.ProxyAppliance)
+			assert.Equal(t, []models.VirtualLabNetworkMappingModel{{
+				ProductionNetwork: "DB Network", IsolatedNetwork: "Lab DB Network", VLANID: 120,
+				ApplianceIPAddress: "10.0.1.1", SubnetMask: "255.255.255.0", DHCPEnabled: true,
+			}}, spec.NetworkMappings, "an unknown masquerade address is left to the server")
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// VirtualLab — ValidateConfig
+// ---------------------------------------------------------------------------
+
+func TestVirtualLab_ValidateConfig(t *testing.T) {
+	vmNetwork := labMapping("VM Network", "Lab VM Network", 0, "10.0.0.1")
+	dbNetwork := labMapping("DB Network", "Lab DB Network", 120, "10.0.1.1")
+	lab := func(overrides map[string]interface{}) map[string]interface{} {
+		attrs := map[string]interface{}{
+			"name": "Recovery-Test-Lab",
+			"host": &VirtualLabHost{
+				Type: types.StringValue("Cluster"), HostName: types.StringValue("vcsa01.corp.local"), Name: types.StringValue("Lab-Cluster"),
+			},
+			"datastore":        &LabInventoryObject{HostName: types.StringValue("vcsa01.corp.local"), Name: types.StringValue("ds-lab01")},
+			"proxy_appliance":  labAppliance("10.0.0.250", "255.255.255.0", "10.0.0.1"),
+			"network_mappings": []VirtualLabNetworkMapping{vmNetwork, dbNetwork},
+		}
+		for k, v := range overrides {
+			attrs[k] = v
+		}
+		return attrs
+	}
+	withMapping := func(m VirtualLabNetworkMapping, change func(*VirtualLabNetworkMapping)) VirtualLabNetworkMapping {
+		change(&m)
+		return m
+	}
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:  "static appliance with two networks",
+			attrs: lab(nil),
+		},
+		{
+			name:  "DHCP appliance",
+			attrs: lab(map[string]interface{}{"proxy_appliance": labAppliance("", "", "")}),
+		},
+		{
+			name: "lab on a folder",
+			attrs: lab(map[string]interface{}{"host": &VirtualLabHost{
+				Type: types.StringValue("Folder"), HostName: types.StringValue("vcsa01.corp.local"), Name: types.StringValue("Lab"),
+			}}),
+			wantErr: `host.type "Folder" is not supported; expected Host or Cluster`,
+		},
+		{
+			name:    "static address without a gateway",
+			attrs:   lab(map[string]interface{}{"proxy_appliance": labAppliance("10.0.0.250", "255.255.255.0", "")}),
+			wantErr: "proxy_appliance: subnet_mask and default_gateway are required with ip_address",
+		},
+		{
+			name:    "gateway without an address",
+			attrs:   lab(map[string]interface{}{"proxy_appliance": labAppliance("", "255.255.255.0", "10.0.0.1")}),
+			wantErr: "proxy_appliance: subnet_mask and default_gateway require ip_address",
+		},
+		{
+			name:    "appliance address out of range",
+			attrs:   lab(map[string]interface{}{"proxy_appliance": labAppliance("10.0.0.300", "255.255.255.0", "10.0.0.1")}),
+			wantErr: `proxy_appliance.ip_address: "10.0.0.300" is not a valid IPv4 address`,
+		},
+		{
+			name:    "no network mappings",
+			attrs:   lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{}}),
+			wantErr: "network_mappings must map at least one production network",
+		},
+		{
+			name: "production network mapped twice",
+			attrs: lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{vmNetwork, withMapping(dbNetwork, func(m *VirtualLabNetworkMapping) {
+				m.ProductionNetwork = types.StringValue("vm network")
+			})}}),
+			wantErr: `network_mappings[1]: production network "vm network" is mapped more than once`,
+		},
+		{
+			name: "isolated network used twice",
+			attrs: lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{vmNetwork, withMapping(dbNetwork, func(m *VirtualLabNetworkMapping) {
+				m.IsolatedNetwork = types.StringValue("Lab VM Network")
+			})}}),
+			wantErr: `network_mappings[1]: isolated network "Lab VM Network" is used more than once`,
+		},
+		{
+			name: "VLAN ID out of range",
+			attrs: lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{withMapping(vmNetwork, func(m *VirtualLabNetworkMapping) {
+				m.VLANID = types.Int64Value(4095)
+			})}}),
+			wantErr: "network_mappings[0]: vlan_id must be between 0 and 4094",
+		},
+		{
+			name: "IPv6 subnet mask",
+			attrs: lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{vmNetwork, withMapping(dbNetwork, func(m *VirtualLabNetworkMapping) {
+				m.SubnetMask = types.StringValue("ffff::")
+			})}}),
+			wantErr: `network_mappings[1].subnet_mask: "ffff::" is not a valid IPv4 address`,
+		},
+		{
+			name: "appliance addressing from variables",
+			attrs: lab(map[string]interface{}{"proxy_appliance": &VirtualLabProxyAppliance{
+				ProductionNetwork: types.StringValue("VM Network"),
+				IPAddress:         types.StringUnknown(),
+				SubnetMask:        types.StringUnknown(),
+				DefaultGateway:    types.StringUnknown(),
+			}}),
+		},
+		{
+			name: "network names from the vSphere data source",
+			attrs: lab(map[string]interface{}{"network_mappings": []VirtualLabNetworkMapping{
+				withMapping(vmNetwork, func(m *VirtualLabNetworkMapping) { m.ProductionNetwork = types.StringUnknown() }),
+				withMapping(dbNetwork, func(m *VirtualLabNetworkMapping) { m.ProductionNetwork = types.StringUnknown() }),
+			}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, &VirtualLab{}, tt.attrs)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// VirtualLab — merged PUT
+// ---------------------------------------------------------------------------
+
+// TestVirtualLab_ManagedPaths checks that an appliance switched to DHCP loses
+// its static address, that a host without object_id is looked up by name
+// again, and that appliance settings the provider does not model are kept.
+func TestVirtualLab_ManagedPaths(t *testing.T) {
+	current := map[string]interface{}{
+		"host": map[string]interface{}{"hostName": "vcsa01.corp.local", "name": "esx07.corp.local", "type": "Host", "objectId": "host-12"},
+		"proxyAppliance": map[string]interface{}{
+			"productionNetwork":     "VM Network",
+			"obtainIpAutomatically": false,
+			"ipAddress":             "10.0.0.250",
+			"subnetMask":            "255.255.255.0",
+			"defaultGateway":        "10.0.0.1",
+			"memoryMB":              float64(2048),
+		},
+	}
+	merged, err := mergeManagedPayload(current, buildVirtualLabSpec(&VirtualLabResourceModel{
+		Host: &VirtualLabHost{
+			Type: types.StringValue("Host"), HostName: types.StringValue("vcsa01.corp.local"),
+			Name: types.StringValue("esx08.corp.local"), ObjectID: types.StringNull(),
+		},
+		ProxyAppliance: labAppliance("", "", ""),
+	}), virtualLabManagedPaths...)
+	require.NoError(t, err)
+
+	tests := []struct {
+		section string
+		want    interface{}
+	}{
+		{"host", map[string]interface{}{"platform": "VSphere", "hostName": "vcsa01.corp.local", "name": "esx08.corp.local", "type": "Host"}},
+		{"proxyAppliance", map[string]interface{}{"productionNetwork": "VM Network", "obtainIpAutomatically": true, "memoryMB": float64(2048)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			got, _ := lookupJSONPath(merged, strings.Split(tt.section, "."))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// VirtualLab — syncVirtualLabFromAPI
+// ---------------------------------------------------------------------------
+
+func TestVirtualLab_SyncFromAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		api  *models.VirtualLabProxyApplianceModel
+		want *VirtualLabProxyAppliance
+	}{
+		{
+			name: "DHCP lease is not written to ip_address",
+			api:  &models.VirtualLabProxyApplianceModel{ProductionNetwork: "VM Network", ObtainIPAutomatically: true, IPAddress: "10.0.0.87"},
+			want: labAppliance("", "", ""),
+		},
+		{
+			name: "static address",
+			api: &models.VirtualLabProxyApplianceModel{
+				ProductionNetwork: "VM Network", IPAddress: "10.0.0.250", SubnetMask: "255.255.255.0", DefaultGateway: "10.0.0.1",
+			},
+			want: labAppliance("10.0.0.250", "255.255.255.0", "10.0.0.1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data VirtualLabResourceModel
+			syncVirtualLabFromAPI(&data, &models.VirtualLabModel{
+				ID:             "lab-1",
+				Name:           "Recovery-Test-Lab",
+				Host:           models.VmwareObjectSpec{HostName: "vcsa01.corp.local", Name: "Lab-Cluster", Type: models.VmwareTypeCluster, ObjectID: "domain-c42"},
+				ProxyAppliance: tt.api,
+				NetworkMappings: []models.VirtualLabNetworkMappingModel{{
+					ProductionNetwork: "DB Network", IsolatedNetwork: "Lab DB Network", VLANID: 120,
+					ApplianceIPAddress: "10.0.1.1", SubnetMask: "255.255.255.0", MasqueradeIPAddress: "172.19.0.0", DHCPEnabled: true,
+				}},
+			})
+			assert.Equal(t, tt.want, data.ProxyAppliance)
+			assert.Equal(t, "Cluster", data.Host.Type.ValueString())
+			require.Len(t, data.NetworkMappings, 1)
+			assert.Equal(t, "172.19.0.0", data.NetworkMappings[0].MasqueradeIPAddress.ValueString())
+		})
+	}
+}
+
+// ---------------------------------------------------------------------------
+// VirtualLab — Read
+// ---------------------------------------------------------------------------
+
+func TestVirtualLab_Read_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantRemoved bool
+	}{
+		{name: "API error code", err: &models.APIError{ErrorCode: "NotFound", Message: "Virtual lab not found"}, wantRemoved: true},
+		{name: "plain 404", err: errors.New("HTTP 404: NotFound"), wantRemoved: true},
+		{name: "server error", err: errors.New("HTTP 500: Internal Server Error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockVeeamClient)
+			mockClient.On("GetJSON", mock.Anything, "/api/v1/virtualLabs/lab-1", mock.Anything).Return(tt.err)
+			r := &VirtualLab{client: mockClient}
+
+			state := buildNullResourceState(r)
+			require.False(t, state.SetAttribute(context.Background(), path.Root("id"), "lab-1").HasError())
+			resp := &resource.ReadResponse{State: state}
+			r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+			assert.Equal(t, !tt.wantRemoved, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,6 +24,7 @@ var (
 	_ resource.Resource                = &VSphereServer{}
 	_ resource.ResourceWithConfigure   = &VSphereServer{}
 	_ resource.ResourceWithImportState = &VSphereServer{}
+	_ resource.ResourceWithIdentity    = &VSphereServer{}
 )
 
 // VSphereServer implements the veeam_vsphere_server resource.
@@ -52,6 +52,18 @@ func NewVSphereServer() resource.Resource {
 
 func (r *VSphereServer) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_vsphere_server"
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// vsphereServerIdentity pairs the ViHost UUID with the vCenter/ESXi host name.
+var vsphereServerIdentity = resourceIdentity{
+	key:            "host",
+	keyDescription: "vCenter Server or ESXi host name.",
+	lookup:         &vsphereServerImport,
+}
+
+func (r *VSphereServer) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vsphereServerIdentity.schema()
 }
 
 func (r *VSphereServer) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -181,6 +193,7 @@ func (r *VSphereServer) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Info(ctx, "Created vSphere server", map[string]interface{}{"id": data.ID.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(vsphereServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *VSphereServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -199,6 +212,7 @@ func (r *VSphereServer) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	r.syncFromAPI(&data, &result)
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(vsphereServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *VSphereServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,6 +230,7 @@ func (r *VSphereServer) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	resp.Diagnostics.Append(vsphereServerIdentity.set(ctx, resp.Identity, data.ID, data.Name)...)
 }
 
 func (r *VSphereServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -237,8 +252,16 @@ func (r *VSphereServer) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// vsphereServerImport resolves "host:<vCenter/ESXi name>" import IDs in addition to UUIDs.
+var vsphereServerImport = naturalKeyImport{
+	kind:         "vSphere server",
+	listEndpoint: client.PathManagedServers,
+	keys:         map[string]string{"host": "name"},
+	entryType:    string(models.ManagedServerTypeViHost),
+}
+
 func (r *VSphereServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	vsphereServerIdentity.importState(ctx, r.client, req, resp)
 }
 
 // ---------------------------------------------------------------------------